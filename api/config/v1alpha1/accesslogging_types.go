@@ -0,0 +1,211 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ProxyAccessLog defines the access logs of the managed proxies.
+type ProxyAccessLog struct {
+	// Disable disables the access logs of the proxies.
+	//
+	// +optional
+	Disable bool `json:"disable,omitempty"`
+
+	// Settings defines the access logs written by the proxies. Each setting
+	// writes the entries it selects to all of its sinks. If unspecified, the
+	// proxies log every request and connection to stdout in the default Envoy
+	// format.
+	//
+	// +kubebuilder:validation:MaxItems=16
+	// +optional
+	Settings []ProxyAccessLogSetting `json:"settings,omitempty"`
+}
+
+// ProxyAccessLogSetting defines an access log and the sinks it is written to.
+type ProxyAccessLogSetting struct {
+	// Format defines the format of the entries.
+	Format ProxyAccessLogFormat `json:"format"`
+
+	// Filter selects the requests and connections that are logged. All of them
+	// are logged if unspecified.
+	//
+	// +optional
+	Filter *ProxyAccessLogFilter `json:"filter,omitempty"`
+
+	// Sinks defines where the entries are written.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=8
+	Sinks []ProxyAccessLogSink `json:"sinks"`
+}
+
+// ProxyAccessLogFormatType is the type of the format of the access log entries.
+//
+// +kubebuilder:validation:Enum=Text;JSON
+type ProxyAccessLogFormatType string
+
+const (
+	// ProxyAccessLogFormatTypeText formats the entries as lines of text.
+	ProxyAccessLogFormatTypeText ProxyAccessLogFormatType = "Text"
+	// ProxyAccessLogFormatTypeJSON formats the entries as JSON objects.
+	ProxyAccessLogFormatTypeJSON ProxyAccessLogFormatType = "JSON"
+)
+
+// ProxyAccessLogFormat defines the format of the access log entries. The
+// entries are built from command operators, such as %START_TIME% or
+// %RESPONSE_CODE%, see the following for the supported operators:
+//
+// https://www.envoyproxy.io/docs/envoy/latest/configuration/observability/access_log/usage#command-operators
+//
+// +union
+type ProxyAccessLogFormat struct {
+	// Type defines the type of the format. Supported types are:
+	//
+	//   * Text: Formats the entries as lines of text.
+	//   * JSON: Formats the entries as JSON objects.
+	//
+	// +unionDiscriminator
+	Type ProxyAccessLogFormatType `json:"type"`
+
+	// Text defines the format of the text entries, such as
+	// "[%START_TIME%] %REQ(:METHOD)% %RESPONSE_CODE%\n". If unspecified, the
+	// default Envoy format is used.
+	//
+	// +optional
+	Text *string `json:"text,omitempty"`
+
+	// JSON defines the fields of the JSON entries, mapping each field name to
+	// the command operators of its value.
+	//
+	// +optional
+	JSON map[string]string `json:"json,omitempty"`
+}
+
+// ProxyAccessLogFilter selects the requests and connections that are logged.
+// The conditions are ANDed, an entry must meet all of them to be written.
+type ProxyAccessLogFilter struct {
+	// MinStatusCode logs the requests whose response status code is at least
+	// the given one. TCP and UDP connections have no status code, they are not
+	// logged when it is set.
+	//
+	// +kubebuilder:validation:Minimum=100
+	// +kubebuilder:validation:Maximum=599
+	// +optional
+	MinStatusCode *int32 `json:"minStatusCode,omitempty"`
+
+	// MinDuration logs the requests and connections lasting at least the given
+	// duration.
+	//
+	// +optional
+	MinDuration *metav1.Duration `json:"minDuration,omitempty"`
+
+	// SamplingPercent is the percentage of the requests and connections that
+	// are logged, such as 10 to log one in ten.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	SamplingPercent *int32 `json:"samplingPercent,omitempty"`
+}
+
+// ProxyAccessLogSinkType is the type of an access log sink.
+//
+// +kubebuilder:validation:Enum=File;ALS;OpenTelemetry
+type ProxyAccessLogSinkType string
+
+const (
+	// ProxyAccessLogSinkTypeFile writes the entries to a file.
+	ProxyAccessLogSinkTypeFile ProxyAccessLogSinkType = "File"
+	// ProxyAccessLogSinkTypeALS sends the entries to a gRPC Access Log Service.
+	ProxyAccessLogSinkTypeALS ProxyAccessLogSinkType = "ALS"
+	// ProxyAccessLogSinkTypeOpenTelemetry sends the entries to an OpenTelemetry collector.
+	ProxyAccessLogSinkTypeOpenTelemetry ProxyAccessLogSinkType = "OpenTelemetry"
+)
+
+// ProxyAccessLogSink defines where the access log entries are written.
+//
+// +union
+type ProxyAccessLogSink struct {
+	// Type defines the type of the sink. Supported types are:
+	//
+	//   * File: Writes the entries to a file.
+	//   * ALS: Sends the entries to a gRPC Access Log Service.
+	//   * OpenTelemetry: Sends the entries to an OpenTelemetry collector.
+	//
+	// +unionDiscriminator
+	Type ProxyAccessLogSinkType `json:"type"`
+
+	// File defines the file the entries are written to.
+	//
+	// +optional
+	File *FileAccessLogSink `json:"file,omitempty"`
+
+	// ALS defines the gRPC Access Log Service the entries are sent to. The
+	// service receives the request and connection properties rather than the
+	// formatted entries.
+	//
+	// +optional
+	ALS *ALSAccessLogSink `json:"als,omitempty"`
+
+	// OpenTelemetry defines the OpenTelemetry collector the entries are sent to.
+	// The formatted text entries are the bodies of the log records, the fields
+	// of the JSON entries are their attributes.
+	//
+	// +optional
+	OpenTelemetry *OpenTelemetryAccessLogSink `json:"openTelemetry,omitempty"`
+}
+
+// FileAccessLogSink defines a file the access log entries are written to.
+type FileAccessLogSink struct {
+	// Path of the file, such as "/dev/stdout".
+	//
+	// +kubebuilder:validation:MinLength=1
+	Path string `json:"path"`
+}
+
+// ALSAccessLogSink defines a gRPC Access Log Service.
+type ALSAccessLogSink struct {
+	// Host of the service.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Host string `json:"host"`
+
+	// Port of the gRPC endpoint of the service.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port"`
+
+	// LogName identifies the log in the service. If unspecified, the name of
+	// the Gateway, in the namespace/name form, is used.
+	//
+	// +optional
+	LogName *string `json:"logName,omitempty"`
+}
+
+// OpenTelemetryAccessLogSink defines an OpenTelemetry collector.
+type OpenTelemetryAccessLogSink struct {
+	// Host of the collector.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Host string `json:"host"`
+
+	// Port of the OTLP gRPC endpoint of the collector. Defaults to 4317.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +kubebuilder:default=4317
+	// +optional
+	Port int32 `json:"port,omitempty"`
+
+	// Resources defines the attributes of the resource emitting the log
+	// records, such as "k8s.cluster.name".
+	//
+	// +optional
+	Resources map[string]string `json:"resources,omitempty"`
+}
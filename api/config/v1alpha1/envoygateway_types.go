@@ -40,6 +40,13 @@ type EnvoyGatewaySpec struct {
 	//
 	// +optional
 	Provider *Provider `json:"provider,omitempty"`
+
+	// RateLimit defines the configuration of the rate limit service managed by
+	// Envoy Gateway to enforce global rate limits. If unset, global rate limits
+	// are not supported.
+	//
+	// +optional
+	RateLimit *RateLimit `json:"rateLimit,omitempty"`
 }
 
 // Gateway defines the desired Gateway API configuration of Envoy Gateway.
@@ -76,6 +83,44 @@ type Provider struct {
 	File *FileProvider `json:"file,omitempty"`
 }
 
+// RateLimit defines the configuration of the managed rate limit service.
+type RateLimit struct {
+	// Backend holds the configuration of the database storing the rate limit counters.
+	Backend RateLimitDatabaseBackend `json:"backend"`
+}
+
+// RateLimitDatabaseBackendType is the type of rate limit database backend.
+//
+// +kubebuilder:validation:Enum=Redis
+type RateLimitDatabaseBackendType string
+
+const (
+	// RedisBackendType stores the rate limit counters in Redis.
+	RedisBackendType RateLimitDatabaseBackendType = "Redis"
+)
+
+// RateLimitDatabaseBackend defines the database storing the rate limit counters.
+// +union
+type RateLimitDatabaseBackend struct {
+	// Type is the type of database backend. Supported types are:
+	//
+	//   * Redis: Stores the counters in a Redis database.
+	//
+	// +unionDiscriminator
+	Type RateLimitDatabaseBackendType `json:"type"`
+
+	// Redis defines the settings of the Redis database.
+	//
+	// +optional
+	Redis *RateLimitRedisSettings `json:"redis,omitempty"`
+}
+
+// RateLimitRedisSettings defines the settings of the Redis database.
+type RateLimitRedisSettings struct {
+	// URL of the Redis database, in the host:port form.
+	URL string `json:"url"`
+}
+
 // KubernetesProvider defines configuration for the Kubernetes provider.
 type KubernetesProvider struct {
 	// TODO: Add config as use cases are better understood.
@@ -9,6 +9,11 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+const (
+	// KindEnvoyProxy is the name of the EnvoyProxy kind.
+	KindEnvoyProxy = "EnvoyProxy"
+)
+
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
 
@@ -29,6 +34,41 @@ type EnvoyProxySpec struct {
 	//
 	// +optional
 	Provider *ResourceProvider `json:"provider,omitempty"`
+
+	// Telemetry defines telemetry parameters for managed proxies.
+	//
+	// +optional
+	Telemetry *ProxyTelemetry `json:"telemetry,omitempty"`
+}
+
+// ProxyTelemetry defines the telemetry of the managed proxies.
+type ProxyTelemetry struct {
+	// AccessLog defines the access logs of the managed proxies. If unspecified,
+	// the proxies log every request and connection to stdout in the default
+	// Envoy format.
+	//
+	// +optional
+	AccessLog *ProxyAccessLog `json:"accessLog,omitempty"`
+
+	// Tracing defines the tracing of the requests by the managed proxies. The
+	// requests are not traced if unspecified.
+	//
+	// +optional
+	Tracing *ProxyTracing `json:"tracing,omitempty"`
+
+	// RequestID defines the handling of the x-request-id header identifying
+	// the requests in the access logs and traces. If unspecified, the proxies
+	// generate a request ID for every request from external clients.
+	//
+	// +optional
+	RequestID *RequestIDSettings `json:"requestID,omitempty"`
+
+	// Metrics defines the metrics of the managed proxies. If unspecified, the
+	// metrics are only available through the admin interface of the proxies,
+	// which is not reachable from outside of their pods.
+	//
+	// +optional
+	Metrics *ProxyMetrics `json:"metrics,omitempty"`
 }
 
 // ResourceProvider defines the desired state of a resource provider.
@@ -74,10 +114,45 @@ type EnvoyDeployment struct {
 	// TODO: Expose config as use cases are better understood, e.g. labels.
 }
 
+// EnvoyProxyConditionType is a type of condition for an EnvoyProxy.
+type EnvoyProxyConditionType string
+
+// EnvoyProxyConditionReason is a reason for an EnvoyProxy condition.
+type EnvoyProxyConditionReason string
+
+const (
+	// EnvoyProxyConditionAccepted indicates whether the configuration of the
+	// EnvoyProxy is valid and applied to the managed proxies, and why.
+	//
+	// Possible reasons for this condition to be True are:
+	//
+	// * "Accepted"
+	//
+	// Possible reasons for this condition to be False are:
+	//
+	// * "Invalid"
+	//
+	EnvoyProxyConditionAccepted EnvoyProxyConditionType = "Accepted"
+
+	// EnvoyProxyReasonAccepted is used with the "Accepted" condition when the
+	// configuration of the EnvoyProxy is valid.
+	EnvoyProxyReasonAccepted EnvoyProxyConditionReason = "Accepted"
+
+	// EnvoyProxyReasonInvalid is used with the "Accepted" condition when some
+	// settings of the EnvoyProxy are invalid. The invalid settings are not
+	// applied to the managed proxies, the others are.
+	EnvoyProxyReasonInvalid EnvoyProxyConditionReason = "Invalid"
+)
+
 // EnvoyProxyStatus defines the observed state of EnvoyProxy
 type EnvoyProxyStatus struct {
-	// INSERT ADDITIONAL STATUS FIELDS - define observed state of cluster.
-	// Important: Run "make" to regenerate code after modifying this file.
+	// Conditions describe the current conditions of the EnvoyProxy.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MaxItems=8
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 //+kubebuilder:object:root=true
@@ -0,0 +1,183 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package v1alpha1
+
+// ProxyMetrics defines the metrics of the managed proxies.
+type ProxyMetrics struct {
+	// Prometheus defines the Prometheus endpoint of the proxies. The endpoint
+	// is not exposed if unspecified.
+	//
+	// +optional
+	Prometheus *PrometheusMetrics `json:"prometheus,omitempty"`
+
+	// Sinks defines the sinks the proxies push their metrics to.
+	//
+	// +kubebuilder:validation:MaxItems=8
+	// +optional
+	Sinks []ProxyMetricSink `json:"sinks,omitempty"`
+
+	// Matcher selects the stats created by the proxies, by their name. All the
+	// stats are created if unspecified. Excluding stats lowers the memory and
+	// CPU usage of the proxies.
+	//
+	// +optional
+	Matcher *ProxyStatsMatcher `json:"matcher,omitempty"`
+}
+
+// PrometheusMetrics defines the Prometheus endpoint of the proxies.
+type PrometheusMetrics struct {
+	// Port of the dedicated listener serving the metrics at /stats/prometheus.
+	// The pods of the proxies are annotated with the prometheus.io annotations
+	// for the endpoint to be discovered. Defaults to 19001.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +kubebuilder:default=19001
+	// +optional
+	Port int32 `json:"port,omitempty"`
+}
+
+// ProxyMetricSinkType is the type of a metric sink.
+//
+// +kubebuilder:validation:Enum=StatsD;OpenTelemetry
+type ProxyMetricSinkType string
+
+const (
+	// ProxyMetricSinkTypeStatsD pushes the metrics to a StatsD server over UDP.
+	ProxyMetricSinkTypeStatsD ProxyMetricSinkType = "StatsD"
+	// ProxyMetricSinkTypeOpenTelemetry pushes the metrics to an OpenTelemetry collector.
+	ProxyMetricSinkTypeOpenTelemetry ProxyMetricSinkType = "OpenTelemetry"
+)
+
+// ProxyMetricSink defines a sink the metrics are pushed to.
+//
+// +union
+type ProxyMetricSink struct {
+	// Type defines the type of the sink. Supported types are:
+	//
+	//   * StatsD: Pushes the metrics to a StatsD server over UDP.
+	//   * OpenTelemetry: Pushes the metrics to an OpenTelemetry collector.
+	//
+	// +unionDiscriminator
+	Type ProxyMetricSinkType `json:"type"`
+
+	// StatsD defines the StatsD server the metrics are pushed to.
+	//
+	// +optional
+	StatsD *StatsDMetricSink `json:"statsD,omitempty"`
+
+	// OpenTelemetry defines the OpenTelemetry collector the metrics are pushed to.
+	//
+	// +optional
+	OpenTelemetry *OpenTelemetryMetricSink `json:"openTelemetry,omitempty"`
+}
+
+// StatsDMetricSink defines a StatsD server.
+type StatsDMetricSink struct {
+	// Address is the IP address of the server. Host names are not supported,
+	// as the proxies do not resolve the address of the StatsD sinks.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Address string `json:"address"`
+
+	// Port of the server.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port"`
+
+	// Prefix is prepended to the names of the metrics, separated by a dot.
+	// Defaults to "envoy".
+	//
+	// +optional
+	Prefix *string `json:"prefix,omitempty"`
+}
+
+// OpenTelemetryMetricSink defines an OpenTelemetry collector.
+type OpenTelemetryMetricSink struct {
+	// Host of the collector.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Host string `json:"host"`
+
+	// Port of the OTLP gRPC endpoint of the collector. Defaults to 4317.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +kubebuilder:default=4317
+	// +optional
+	Port int32 `json:"port,omitempty"`
+}
+
+// ProxyStatsMatcherType is the type of a stats matcher.
+//
+// +kubebuilder:validation:Enum=Inclusion;Exclusion
+type ProxyStatsMatcherType string
+
+const (
+	// ProxyStatsMatcherTypeInclusion creates only the stats matching one of
+	// the matches.
+	ProxyStatsMatcherTypeInclusion ProxyStatsMatcherType = "Inclusion"
+	// ProxyStatsMatcherTypeExclusion creates only the stats matching none of
+	// the matches.
+	ProxyStatsMatcherTypeExclusion ProxyStatsMatcherType = "Exclusion"
+)
+
+// ProxyStatsMatcher selects the stats created by the proxies.
+type ProxyStatsMatcher struct {
+	// Type defines whether the matching stats are included or excluded.
+	// Supported types are:
+	//
+	//   * Inclusion: Creates only the stats matching one of the matches.
+	//   * Exclusion: Creates only the stats matching none of the matches.
+	//
+	Type ProxyStatsMatcherType `json:"type"`
+
+	// Matches defines the matches of the stat names, such as a Prefix match
+	// of "cluster.".
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	Matches []StatNameMatch `json:"matches"`
+}
+
+// StatNameMatchType is the type of a stat name match.
+//
+// +kubebuilder:validation:Enum=Exact;Prefix;Suffix;RegularExpression
+type StatNameMatchType string
+
+const (
+	// StatNameMatchTypeExact matches the names equal to the value.
+	StatNameMatchTypeExact StatNameMatchType = "Exact"
+	// StatNameMatchTypePrefix matches the names starting with the value.
+	StatNameMatchTypePrefix StatNameMatchType = "Prefix"
+	// StatNameMatchTypeSuffix matches the names ending with the value.
+	StatNameMatchTypeSuffix StatNameMatchType = "Suffix"
+	// StatNameMatchTypeRegularExpression matches the names matching the value
+	// as an RE2 regular expression.
+	StatNameMatchTypeRegularExpression StatNameMatchType = "RegularExpression"
+)
+
+// StatNameMatch defines a match of the stat names.
+type StatNameMatch struct {
+	// Type defines how the names are matched against the value. Supported
+	// types are:
+	//
+	//   * Exact: Matches the names equal to the value.
+	//   * Prefix: Matches the names starting with the value.
+	//   * Suffix: Matches the names ending with the value.
+	//   * RegularExpression: Matches the names matching the value as an RE2
+	//     regular expression.
+	//
+	// +kubebuilder:default=Exact
+	// +optional
+	Type StatNameMatchType `json:"type,omitempty"`
+
+	// Value to match the names against.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Value string `json:"value"`
+}
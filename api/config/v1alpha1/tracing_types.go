@@ -0,0 +1,159 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package v1alpha1
+
+import (
+	gwapiv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+// ProxyTracing defines the tracing of the requests by the managed proxies.
+type ProxyTracing struct {
+	// SamplingRate is the percentage of the requests that are traced, such as
+	// 10 to trace one in ten. Defaults to 100.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	SamplingRate *int32 `json:"samplingRate,omitempty"`
+
+	// CustomTags defines the tags added to the spans, keyed by tag name.
+	//
+	// +optional
+	CustomTags map[string]CustomTag `json:"customTags,omitempty"`
+
+	// Provider defines the tracing provider the spans are sent to.
+	Provider TracingProvider `json:"provider"`
+}
+
+// TracingProviderType is the type of a tracing provider.
+//
+// +kubebuilder:validation:Enum=OpenTelemetry;Zipkin;Datadog
+type TracingProviderType string
+
+const (
+	// TracingProviderTypeOpenTelemetry sends the spans to an OpenTelemetry
+	// collector over OTLP gRPC.
+	TracingProviderTypeOpenTelemetry TracingProviderType = "OpenTelemetry"
+	// TracingProviderTypeZipkin sends the spans to a Zipkin collector.
+	TracingProviderTypeZipkin TracingProviderType = "Zipkin"
+	// TracingProviderTypeDatadog sends the spans to a Datadog agent.
+	TracingProviderTypeDatadog TracingProviderType = "Datadog"
+)
+
+// TracingProvider defines the tracing provider the spans are sent to.
+type TracingProvider struct {
+	// Type defines the type of the provider. Supported types are:
+	//
+	//   * OpenTelemetry: Sends the spans to an OpenTelemetry collector over OTLP gRPC.
+	//   * Zipkin: Sends the spans to a Zipkin collector.
+	//   * Datadog: Sends the spans to a Datadog agent.
+	//
+	Type TracingProviderType `json:"type"`
+
+	// BackendRef references the Service of the collector. Only Services of
+	// the core API group are supported, and the port must be specified. A
+	// Service in another namespace than the EnvoyProxy must be allowed by a
+	// ReferenceGrant.
+	BackendRef gwapiv1b1.BackendObjectReference `json:"backendRef"`
+}
+
+// CustomTagType is the type of the value of a custom tag.
+//
+// +kubebuilder:validation:Enum=Literal;Environment;RequestHeader
+type CustomTagType string
+
+const (
+	// CustomTagTypeLiteral sets the tag to a literal value.
+	CustomTagTypeLiteral CustomTagType = "Literal"
+	// CustomTagTypeEnvironment sets the tag to an environment variable of the proxy.
+	CustomTagTypeEnvironment CustomTagType = "Environment"
+	// CustomTagTypeRequestHeader sets the tag to a header of the request.
+	CustomTagTypeRequestHeader CustomTagType = "RequestHeader"
+)
+
+// CustomTag defines the value of a tag added to the spans.
+//
+// +union
+type CustomTag struct {
+	// Type defines the type of the value of the tag. Supported types are:
+	//
+	//   * Literal: Sets the tag to a literal value.
+	//   * Environment: Sets the tag to an environment variable of the proxy.
+	//   * RequestHeader: Sets the tag to a header of the request.
+	//
+	// +unionDiscriminator
+	Type CustomTagType `json:"type"`
+
+	// Literal defines the literal value of the tag.
+	//
+	// +optional
+	Literal *LiteralCustomTag `json:"literal,omitempty"`
+
+	// Environment defines the environment variable the tag is set to.
+	//
+	// +optional
+	Environment *EnvironmentCustomTag `json:"environment,omitempty"`
+
+	// RequestHeader defines the request header the tag is set to.
+	//
+	// +optional
+	RequestHeader *RequestHeaderCustomTag `json:"requestHeader,omitempty"`
+}
+
+// LiteralCustomTag defines a literal tag value.
+type LiteralCustomTag struct {
+	// Value of the tag.
+	Value string `json:"value"`
+}
+
+// EnvironmentCustomTag defines a tag set to an environment variable of the proxy.
+type EnvironmentCustomTag struct {
+	// Name of the environment variable.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// DefaultValue is the value of the tag when the variable is not set. The
+	// tag is not added if unspecified.
+	//
+	// +optional
+	DefaultValue *string `json:"defaultValue,omitempty"`
+}
+
+// RequestHeaderCustomTag defines a tag set to a header of the request.
+type RequestHeaderCustomTag struct {
+	// Name of the request header.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// DefaultValue is the value of the tag when the request has no such
+	// header. The tag is not added if unspecified.
+	//
+	// +optional
+	DefaultValue *string `json:"defaultValue,omitempty"`
+}
+
+// RequestIDSettings defines the handling of the x-request-id header.
+type RequestIDSettings struct {
+	// Disable disables the generation of request IDs. The requests without an
+	// x-request-id header are then not traced.
+	//
+	// +optional
+	Disable bool `json:"disable,omitempty"`
+
+	// PreserveExternal keeps the x-request-id header of the requests from
+	// external clients, instead of replacing it with a generated one.
+	//
+	// +optional
+	PreserveExternal bool `json:"preserveExternal,omitempty"`
+
+	// SetInResponse adds the x-request-id header of the requests to their
+	// responses.
+	//
+	// +optional
+	SetInResponse bool `json:"setInResponse,omitempty"`
+}
@@ -7,6 +7,12 @@ package v1alpha1
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwapiv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+const (
+	// KindAuthenticationFilter is the name of the AuthenticationFilter kind.
+	KindAuthenticationFilter = "AuthenticationFilter"
 )
 
 //+kubebuilder:object:root=true
@@ -27,6 +33,10 @@ type AuthenticationFilterSpec struct {
 	// Type defines the type of authentication provider to use. Supported provider types are:
 	//
 	//   * JWT: A provider that uses JSON Web Token (JWT) for authenticating requests.
+	//   * OIDC: A provider that logs the users in through the OpenID Connect
+	//     authorization code flow.
+	//   * BasicAuth: A provider that authenticates the users with the HTTP basic
+	//     authentication scheme.
 	//
 	// +unionDiscriminator
 	Type AuthenticationFilterType `json:"type"`
@@ -40,15 +50,37 @@ type AuthenticationFilterSpec struct {
 	// +kubebuilder:validation:MaxItems=4
 	// +optional
 	JwtProviders []JwtAuthenticationFilterProvider `json:"jwtProviders,omitempty"`
+
+	// OIDC defines the OpenID Connect (OIDC) authentication provider type. Unauthenticated
+	// requests are redirected to the login page of the provider, and the user is sent back
+	// to the redirect URL once logged in. For additional details, see:
+	//
+	//   https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_filters/oauth2_filter.html
+	//
+	// +optional
+	OIDC *OIDCAuthenticationFilterProvider `json:"oidc,omitempty"`
+
+	// BasicAuth defines the HTTP basic authentication provider type. Requests without
+	// the credentials of one of the users are rejected with a 401. For additional
+	// details, see:
+	//
+	//   https://datatracker.ietf.org/doc/html/rfc7617
+	//
+	// +optional
+	BasicAuth *BasicAuthAuthenticationFilterProvider `json:"basicAuth,omitempty"`
 }
 
 // AuthenticationFilterType is a type of authentication provider.
-// +kubebuilder:validation:Enum=JWT
+// +kubebuilder:validation:Enum=JWT;OIDC;BasicAuth
 type AuthenticationFilterType string
 
 const (
 	// JwtAuthenticationFilterProviderType is the JWT authentication provider type.
 	JwtAuthenticationFilterProviderType AuthenticationFilterType = "JWT"
+	// OIDCAuthenticationFilterProviderType is the OIDC authentication provider type.
+	OIDCAuthenticationFilterProviderType AuthenticationFilterType = "OIDC"
+	// BasicAuthAuthenticationFilterProviderType is the HTTP basic authentication provider type.
+	BasicAuthAuthenticationFilterProviderType AuthenticationFilterType = "BasicAuth"
 )
 
 // JwtAuthenticationFilterProvider defines the JSON Web Token (JWT) authentication provider type
@@ -90,10 +122,49 @@ type JwtAuthenticationFilterProvider struct {
 	Audiences []string `json:"audiences,omitempty"`
 
 	// RemoteJWKS defines how to fetch and cache JSON Web Key Sets (JWKS) from a remote
-	// HTTP/HTTPS endpoint.
-	RemoteJWKS RemoteJWKS `json:"remoteJWKS"`
+	// HTTP/HTTPS endpoint. Exactly one of remoteJWKS and localJWKS must be specified.
+	//
+	// +optional
+	RemoteJWKS *RemoteJWKS `json:"remoteJWKS,omitempty"`
 
-	// TODO: Add TBD JWT fields based on defined use cases.
+	// LocalJWKS defines the JSON Web Key Set (JWKS) held in the cluster, which
+	// is useful when Envoy cannot reach the JWKS endpoint of the issuer. Exactly
+	// one of remoteJWKS and localJWKS must be specified.
+	//
+	// +optional
+	LocalJWKS *LocalJWKS `json:"localJWKS,omitempty"`
+
+	// ForwardToken forwards the JWT to the backends. By default, the header
+	// holding the JWT is removed once the JWT is verified.
+	//
+	// +optional
+	ForwardToken *bool `json:"forwardToken,omitempty"`
+
+	// ClaimToHeaders copies claims of the verified JWT into request headers,
+	// so that the backends don't need to decode the JWT.
+	//
+	// Example:
+	//   claimToHeaders:
+	//   - header: x-user-email
+	//     claim: email
+	//
+	// +kubebuilder:validation:MaxItems=16
+	// +optional
+	ClaimToHeaders []ClaimToHeader `json:"claimToHeaders,omitempty"`
+
+	// ExtractFrom defines where the JWT is extracted from the requests. If not
+	// provided, the JWT is extracted from the Authorization header with the
+	// "Bearer " prefix, or else from the access_token query parameter.
+	//
+	// +optional
+	ExtractFrom *JwtExtractor `json:"extractFrom,omitempty"`
+
+	// ClockSkew is the tolerance applied when checking the expiration and the
+	// not-before times of the JWT. It is rounded down to whole seconds. If not
+	// provided, a clock skew of 60 seconds is tolerated.
+	//
+	// +optional
+	ClockSkew *metav1.Duration `json:"clockSkew,omitempty"`
 }
 
 // RemoteJWKS defines how to fetch and cache JSON Web Key Sets (JWKS) from a remote
@@ -112,15 +183,197 @@ type RemoteJWKS struct {
 	// TODO: Add TBD remote JWKS fields based on defined use cases.
 }
 
+// LocalJWKS defines a JSON Web Key Set (JWKS) held in the cluster. Exactly one of
+// inline, configMapRef and secretRef must be specified.
+type LocalJWKS struct {
+	// Inline is the JWKS, as a JSON document.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Inline *string `json:"inline,omitempty"`
+
+	// ConfigMapRef references the ConfigMap holding the JWKS under the "jwks" key.
+	// The ConfigMap must be in the namespace of the AuthenticationFilter.
+	//
+	// +optional
+	ConfigMapRef *ConfigMapReference `json:"configMapRef,omitempty"`
+
+	// SecretRef references the Secret holding the JWKS under the "jwks" key. A
+	// ReferenceGrant is required to reference a Secret in another namespace.
+	//
+	// +optional
+	SecretRef *gwapiv1b1.SecretObjectReference `json:"secretRef,omitempty"`
+}
+
+// ConfigMapReference references a ConfigMap in the namespace of the referrer.
+type ConfigMapReference struct {
+	// Name is the name of the ConfigMap.
+	Name gwapiv1b1.ObjectName `json:"name"`
+}
+
+// ClaimToHeader defines a request header set to the value of a claim of the JWT.
+type ClaimToHeader struct {
+	// Header is the name of the request header.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9!#$%&'*+\-.^_\x60|~]+$`
+	Header string `json:"header"`
+
+	// Claim is the name of the claim. Nested claims are separated by dots, such
+	// as "address.country". Only claims holding a string, a number or a boolean
+	// are copied.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Claim string `json:"claim"`
+}
+
+// JwtExtractor defines where the JWT is extracted from the requests. The JWT is
+// extracted from the first location holding one.
+type JwtExtractor struct {
+	// Headers are the request headers holding the JWT.
+	//
+	// +kubebuilder:validation:MaxItems=8
+	// +optional
+	Headers []JwtHeaderExtractor `json:"headers,omitempty"`
+
+	// Params are the query parameters holding the JWT.
+	//
+	// +kubebuilder:validation:MaxItems=8
+	// +optional
+	Params []string `json:"params,omitempty"`
+}
+
+// JwtHeaderExtractor defines a request header holding the JWT.
+type JwtHeaderExtractor struct {
+	// Name is the name of the header.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	Name string `json:"name"`
+
+	// ValuePrefix is the prefix preceding the JWT in the value of the header,
+	// such as "Bearer ". The JWT is the whole value if not provided.
+	//
+	// +kubebuilder:validation:MaxLength=64
+	// +optional
+	ValuePrefix *string `json:"valuePrefix,omitempty"`
+}
+
+// OIDCAuthenticationFilterProvider defines the OpenID Connect (OIDC) authentication
+// provider type and the client registered with it.
+type OIDCAuthenticationFilterProvider struct {
+	// Issuer is the HTTPS URL of the OIDC provider. Unless both the authorization
+	// and token endpoints are specified, they are discovered from the provider
+	// configuration published at <issuer>/.well-known/openid-configuration.
+	//
+	// Example:
+	//  issuer: https://auth.example.com
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^https://`
+	Issuer string `json:"issuer"`
+
+	// AuthorizationEndpoint is the URL of the login page of the OIDC provider.
+	//
+	// +optional
+	AuthorizationEndpoint *string `json:"authorizationEndpoint,omitempty"`
+
+	// TokenEndpoint is the URL from which the tokens are retrieved once the user
+	// is logged in.
+	//
+	// +optional
+	TokenEndpoint *string `json:"tokenEndpoint,omitempty"`
+
+	// ClientID is the ID of the client registered with the OIDC provider.
+	//
+	// +kubebuilder:validation:MinLength=1
+	ClientID string `json:"clientID"`
+
+	// ClientSecret references the Secret holding the secret of the client registered
+	// with the OIDC provider, under the "client-secret" key. A ReferenceGrant is
+	// required to reference a Secret in another namespace.
+	ClientSecret gwapiv1b1.SecretObjectReference `json:"clientSecret"`
+
+	// RedirectURL is the URL the OIDC provider redirects the user to once logged in.
+	// It must be registered with the provider. Its path is handled by Envoy and is
+	// never forwarded to the backends. Defaults to
+	// "%REQ(x-forwarded-proto)%://%REQ(:authority)%/oauth2/callback".
+	//
+	// +optional
+	RedirectURL *string `json:"redirectURL,omitempty"`
+
+	// LogoutPath is the path that logs the user out by clearing the cookies set by Envoy.
+	// Like the path of the redirect URL, it is never forwarded to the backends.
+	// Defaults to "/logout".
+	//
+	// +optional
+	LogoutPath *string `json:"logoutPath,omitempty"`
+
+	// Scopes are the scopes requested to the OIDC provider. The "openid" scope
+	// is always requested.
+	//
+	// +kubebuilder:validation:MaxItems=16
+	// +optional
+	Scopes []string `json:"scopes,omitempty"`
+
+	// ForwardAccessToken forwards the access token to the backends in the
+	// Authorization header.
+	//
+	// +optional
+	ForwardAccessToken *bool `json:"forwardAccessToken,omitempty"`
+
+	// Cookie defines the names of the cookies set by Envoy once the user is logged in.
+	//
+	// +optional
+	Cookie *OIDCCookie `json:"cookie,omitempty"`
+}
+
+// OIDCCookie defines the names of the cookies set by Envoy once the user is logged in.
+type OIDCCookie struct {
+	// AccessTokenName is the name of the cookie holding the access token.
+	// Defaults to "BearerToken".
+	//
+	// +optional
+	AccessTokenName *string `json:"accessTokenName,omitempty"`
+
+	// HMACName is the name of the cookie holding the signature of the other
+	// cookies. Defaults to "OauthHMAC".
+	//
+	// +optional
+	HMACName *string `json:"hmacName,omitempty"`
+
+	// ExpiresName is the name of the cookie holding the expiry of the access
+	// token. Defaults to "OauthExpires".
+	//
+	// +optional
+	ExpiresName *string `json:"expiresName,omitempty"`
+}
+
+// BasicAuthAuthenticationFilterProvider defines the HTTP basic authentication
+// provider type and the users allowed to authenticate.
+type BasicAuthAuthenticationFilterProvider struct {
+	// Users references the Secret holding the users, in the htpasswd format under
+	// the ".htpasswd" key. Only passwords hashed with SHA1 are supported, as generated
+	// by "htpasswd -s". A ReferenceGrant is required to reference a Secret in another
+	// namespace.
+	//
+	// Example:
+	//  user1:{SHA}44rSFJQ9qtHWTBAvrsKd5K/p2j0=
+	Users gwapiv1b1.SecretObjectReference `json:"users"`
+}
+
 //+kubebuilder:object:root=true
 
-// AuthenticationList contains a list of Authentication.
-type AuthenticationList struct {
+// AuthenticationFilterList contains a list of AuthenticationFilter resources.
+type AuthenticationFilterList struct {
 	metav1.TypeMeta `json:",inline"`
 	metav1.ListMeta `json:"metadata,omitempty"`
 	Items           []AuthenticationFilter `json:"items"`
 }
 
 func init() {
-	SchemeBuilder.Register(&AuthenticationFilter{}, &AuthenticationList{})
+	SchemeBuilder.Register(&AuthenticationFilter{}, &AuthenticationFilterList{})
 }
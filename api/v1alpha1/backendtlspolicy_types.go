@@ -0,0 +1,92 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwapiv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwapiv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+const (
+	// KindBackendTLSPolicy is the name of the BackendTLSPolicy kind.
+	KindBackendTLSPolicy = "BackendTLSPolicy"
+)
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+// BackendTLSPolicy allows the user to configure the TLS connections originated
+// by the Envoy proxy to the backends of a Service.
+type BackendTLSPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the BackendTLSPolicy.
+	Spec BackendTLSPolicySpec `json:"spec"`
+
+	// Status defines the current status of the BackendTLSPolicy.
+	Status PolicyStatus `json:"status,omitempty"`
+}
+
+// BackendTLSPolicySpec defines the desired state of the BackendTLSPolicy.
+type BackendTLSPolicySpec struct {
+	// TargetRef is the Service this policy is attached to. The connections to the
+	// Service from the HTTPRoutes referencing it as a backend use TLS. The namespace
+	// of the target must match the namespace of the policy.
+	TargetRef gwapiv1a2.PolicyTargetReference `json:"targetRef"`
+
+	// SNI is the server name sent to the backends in the TLS handshake. When the
+	// CA certificates are specified, the certificates of the backends must also
+	// be valid for this name.
+	//
+	// +optional
+	SNI *gwapiv1b1.PreciseHostname `json:"sni,omitempty"`
+
+	// CACertificate defines the CA certificates validating the certificates of the
+	// backends. The certificates of the backends are not validated when unspecified.
+	//
+	// +optional
+	CACertificate *BackendTLSCACertificate `json:"caCertificate,omitempty"`
+
+	// ClientCertificateRef references the kubernetes.io/tls Secret holding the
+	// client certificate presented to the backends requiring mutual TLS. A
+	// ReferenceGrant is required to reference a Secret in another namespace.
+	//
+	// +optional
+	ClientCertificateRef *gwapiv1b1.SecretObjectReference `json:"clientCertificateRef,omitempty"`
+}
+
+// BackendTLSCACertificate defines where the CA certificates validating the
+// certificates of the backends are stored. Exactly one of ConfigMapRef and
+// SecretRef must be specified.
+type BackendTLSCACertificate struct {
+	// ConfigMapRef references the ConfigMap holding the CA certificates under the
+	// "ca.crt" key. The ConfigMap must be in the namespace of the BackendTLSPolicy.
+	//
+	// +optional
+	ConfigMapRef *ConfigMapReference `json:"configMapRef,omitempty"`
+
+	// SecretRef references the Secret holding the CA certificates under the
+	// "ca.crt" key. A ReferenceGrant is required to reference a Secret in
+	// another namespace.
+	//
+	// +optional
+	SecretRef *gwapiv1b1.SecretObjectReference `json:"secretRef,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// BackendTLSPolicyList contains a list of BackendTLSPolicy resources.
+type BackendTLSPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BackendTLSPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&BackendTLSPolicy{}, &BackendTLSPolicyList{})
+}
@@ -0,0 +1,645 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwapiv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+const (
+	// KindBackendTrafficPolicy is the name of the BackendTrafficPolicy kind.
+	KindBackendTrafficPolicy = "BackendTrafficPolicy"
+)
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+// BackendTrafficPolicy allows the user to configure the behavior of the connection
+// between the Envoy proxy and the upstream backends of a Gateway or HTTPRoute.
+type BackendTrafficPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the BackendTrafficPolicy.
+	Spec BackendTrafficPolicySpec `json:"spec"`
+
+	// Status defines the current status of the BackendTrafficPolicy.
+	Status PolicyStatus `json:"status,omitempty"`
+}
+
+// BackendTrafficPolicySpec defines the desired state of the BackendTrafficPolicy.
+type BackendTrafficPolicySpec struct {
+	// TargetRef is the Gateway, Gateway listener or HTTPRoute this policy is attached
+	// to. When a Gateway is targeted, the policy applies to all routes attached to the
+	// Gateway. A policy targeting an HTTPRoute takes precedence over a policy targeting
+	// a listener of its Gateway, which takes precedence over a policy targeting the
+	// whole Gateway. The namespace of the target must match the namespace of the policy.
+	TargetRef PolicyTargetReferenceWithSectionName `json:"targetRef"`
+
+	// Timeout defines the timeouts applied to requests sent to the backends.
+	//
+	// +optional
+	Timeout *Timeout `json:"timeout,omitempty"`
+
+	// Retry defines the retry policy applied to requests sent to the backends.
+	//
+	// +optional
+	Retry *Retry `json:"retry,omitempty"`
+
+	// HealthCheck defines the active and passive health checks performed
+	// against the backends.
+	//
+	// +optional
+	HealthCheck *HealthCheck `json:"healthCheck,omitempty"`
+
+	// CircuitBreaker defines the limits on the connections and requests sent to
+	// the backends. Requests exceeding the limits fail immediately with a 503.
+	//
+	// +optional
+	CircuitBreaker *CircuitBreaker `json:"circuitBreaker,omitempty"`
+
+	// LoadBalancer defines how requests are balanced across the backends.
+	// Defaults to round robin.
+	//
+	// +optional
+	LoadBalancer *LoadBalancer `json:"loadBalancer,omitempty"`
+
+	// RateLimit defines the rate limits applied to the requests before they are
+	// forwarded to the backends.
+	//
+	// +optional
+	RateLimit *RateLimit `json:"rateLimit,omitempty"`
+
+	// Compression defines the compression of the responses sent to the clients
+	// accepting compressed responses.
+	//
+	// +optional
+	Compression *Compression `json:"compression,omitempty"`
+
+	// FaultInjection defines the delays and aborts injected into the requests,
+	// e.g. to test the resilience of the clients during game days.
+	//
+	// +optional
+	FaultInjection *FaultInjection `json:"faultInjection,omitempty"`
+}
+
+// Compression defines the compression of the responses sent to the clients.
+// Responses already compressed by the backends are left untouched.
+type Compression struct {
+	// Algorithms lists the compression algorithms, in order of preference. The
+	// algorithm with the highest weight in the Accept-Encoding header of the
+	// request is used, the first one of the list in case of a tie.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=2
+	Algorithms []CompressionAlgorithm `json:"algorithms"`
+
+	// MinContentLength is the minimum size in bytes of the responses to compress.
+	// Defaults to 30.
+	//
+	// +optional
+	MinContentLength *uint32 `json:"minContentLength,omitempty"`
+
+	// ContentTypes lists the media types of the responses to compress. Defaults
+	// to the common text types, e.g. application/json, text/html and text/plain.
+	//
+	// +kubebuilder:validation:MaxItems=64
+	// +optional
+	ContentTypes []string `json:"contentTypes,omitempty"`
+}
+
+// CompressionAlgorithm is an algorithm compressing the responses.
+// +kubebuilder:validation:Enum=Gzip;Brotli
+type CompressionAlgorithm string
+
+const (
+	// GzipCompressionAlgorithm compresses the responses with gzip.
+	GzipCompressionAlgorithm CompressionAlgorithm = "Gzip"
+	// BrotliCompressionAlgorithm compresses the responses with brotli.
+	BrotliCompressionAlgorithm CompressionAlgorithm = "Brotli"
+)
+
+// FaultInjection defines the faults injected into the requests. At least one
+// of Delay or Abort must be specified.
+type FaultInjection struct {
+	// Delay injects a fixed delay before the requests are forwarded to the backends.
+	//
+	// +optional
+	Delay *FaultInjectionDelay `json:"delay,omitempty"`
+
+	// Abort aborts the requests with an HTTP or gRPC status instead of forwarding
+	// them to the backends.
+	//
+	// +optional
+	Abort *FaultInjectionAbort `json:"abort,omitempty"`
+
+	// Headers the request must match for the faults to be injected. All headers
+	// must match. When unspecified, faults are injected into all requests.
+	//
+	// +kubebuilder:validation:MaxItems=16
+	// +optional
+	Headers []gwapiv1b1.HTTPHeaderMatch `json:"headers,omitempty"`
+}
+
+// FaultInjectionDelay defines the delay injected into the requests.
+type FaultInjectionDelay struct {
+	// FixedDelay is the delay added before the requests are forwarded.
+	FixedDelay metav1.Duration `json:"fixedDelay"`
+
+	// Percentage of the requests delayed. Defaults to 100.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	Percentage *int32 `json:"percentage,omitempty"`
+}
+
+// FaultInjectionAbort defines the status of the aborted requests. Exactly one
+// of HTTPStatus or GRPCStatus must be specified.
+type FaultInjectionAbort struct {
+	// HTTPStatus is the HTTP status code of the aborted requests.
+	//
+	// +kubebuilder:validation:Minimum=200
+	// +kubebuilder:validation:Maximum=599
+	// +optional
+	HTTPStatus *int32 `json:"httpStatus,omitempty"`
+
+	// GRPCStatus is the gRPC status code of the aborted requests.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=16
+	// +optional
+	GRPCStatus *int32 `json:"grpcStatus,omitempty"`
+
+	// Percentage of the requests aborted. Defaults to 100.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	Percentage *int32 `json:"percentage,omitempty"`
+}
+
+// Timeout defines the timeouts applied to requests sent to the backends.
+type Timeout struct {
+	// Request is the amount of time Envoy waits for the entire response from the
+	// backend, including all retries. Defaults to 15s when unspecified, and a value
+	// of 0s disables the timeout.
+	//
+	// +optional
+	Request *metav1.Duration `json:"request,omitempty"`
+
+	// Idle is the amount of time a request stream can remain without any upstream
+	// or downstream activity before it is reset.
+	//
+	// +optional
+	Idle *metav1.Duration `json:"idle,omitempty"`
+
+	// Connect is the amount of time Envoy waits for a connection to the backend
+	// to be established. Defaults to 5s when unspecified.
+	//
+	// +optional
+	Connect *metav1.Duration `json:"connect,omitempty"`
+}
+
+// Retry defines the retry policy applied to requests sent to the backends.
+type Retry struct {
+	// NumRetries is the number of retries to be attempted. Defaults to 2.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	NumRetries *int32 `json:"numRetries,omitempty"`
+
+	// RetryOn specifies the conditions under which a request is retried.
+	// If unspecified, requests are retried on 5xx responses, which include
+	// connection failures and resets.
+	//
+	// +optional
+	RetryOn *RetryOn `json:"retryOn,omitempty"`
+
+	// PerRetry defines the timeout and backoff applied to each retry attempt.
+	//
+	// +optional
+	PerRetry *PerRetryPolicy `json:"perRetry,omitempty"`
+
+	// Budget limits the number of concurrent retries to a backend as a percentage
+	// of its active requests. For additional details, see:
+	//
+	//   https://www.envoyproxy.io/docs/envoy/latest/api-v3/config/cluster/v3/circuit_breaker.proto#config-cluster-v3-circuitbreakers-thresholds-retrybudget
+	//
+	// +optional
+	Budget *RetryBudget `json:"budget,omitempty"`
+}
+
+// RetryOn specifies the conditions under which a request is retried.
+type RetryOn struct {
+	// Triggers specifies the retry conditions.
+	//
+	// +kubebuilder:validation:MaxItems=8
+	// +optional
+	Triggers []TriggerEnum `json:"triggers,omitempty"`
+
+	// HTTPStatusCodes specifies the response status codes that trigger a retry.
+	// Setting this field implies the "retriable-status-codes" trigger.
+	//
+	// +kubebuilder:validation:MaxItems=16
+	// +optional
+	HTTPStatusCodes []HTTPStatus `json:"httpStatusCodes,omitempty"`
+}
+
+// TriggerEnum specifies the conditions that trigger retries.
+//
+// +kubebuilder:validation:Enum={"5xx","gateway-error","reset","connect-failure","retriable-4xx","refused-stream","retriable-status-codes"}
+type TriggerEnum string
+
+const (
+	// Error5XX retries on any 5xx response or when the backend does not respond,
+	// e.g. on a disconnect, reset or read timeout.
+	Error5XX TriggerEnum = "5xx"
+	// GatewayError retries on 502, 503 and 504 responses.
+	GatewayError TriggerEnum = "gateway-error"
+	// Reset retries when the backend does not respond at all.
+	Reset TriggerEnum = "reset"
+	// ConnectFailure retries when a connection to the backend cannot be established.
+	ConnectFailure TriggerEnum = "connect-failure"
+	// Retriable4XX retries on 409 responses.
+	Retriable4XX TriggerEnum = "retriable-4xx"
+	// RefusedStream retries when the backend resets the stream with a REFUSED_STREAM error code.
+	RefusedStream TriggerEnum = "refused-stream"
+	// RetriableStatusCodes retries on the status codes listed in httpStatusCodes.
+	RetriableStatusCodes TriggerEnum = "retriable-status-codes"
+)
+
+// HTTPStatus defines an HTTP response status code.
+//
+// +kubebuilder:validation:Minimum=100
+// +kubebuilder:validation:Maximum=599
+type HTTPStatus int
+
+// PerRetryPolicy defines the timeout and backoff applied to each retry attempt.
+type PerRetryPolicy struct {
+	// Timeout is the timeout for each retry attempt, including the initial request.
+	//
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// BackOff defines the exponential backoff between retry attempts.
+	//
+	// +optional
+	BackOff *BackOffPolicy `json:"backOff,omitempty"`
+}
+
+// BackOffPolicy defines the exponential backoff between retry attempts.
+type BackOffPolicy struct {
+	// BaseInterval is the base interval between retries. Defaults to 25ms.
+	//
+	// +optional
+	BaseInterval *metav1.Duration `json:"baseInterval,omitempty"`
+
+	// MaxInterval is the maximum interval between retries. Defaults to ten times
+	// the base interval and must not be lower than it.
+	//
+	// +optional
+	MaxInterval *metav1.Duration `json:"maxInterval,omitempty"`
+}
+
+// RetryBudget limits the number of concurrent retries to a backend.
+type RetryBudget struct {
+	// Percent is the maximum number of concurrent retries allowed, as a percentage
+	// of the active requests to the backend. Defaults to 20.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	Percent *int32 `json:"percent,omitempty"`
+
+	// MinRetryConcurrency is the number of concurrent retries that are always
+	// allowed, regardless of the percentage. Defaults to 3.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MinRetryConcurrency *int32 `json:"minRetryConcurrency,omitempty"`
+}
+
+// HealthCheck defines the active and passive health checks performed against the backends.
+type HealthCheck struct {
+	// Active defines the active health check, where Envoy periodically probes the backends.
+	//
+	// +optional
+	Active *ActiveHealthCheck `json:"active,omitempty"`
+
+	// Passive defines the passive health check, also known as outlier detection,
+	// where Envoy ejects backends based on the responses to regular requests.
+	//
+	// +optional
+	Passive *PassiveHealthCheck `json:"passive,omitempty"`
+}
+
+// ActiveHealthCheckerType is the type of the active health checker.
+//
+// +kubebuilder:validation:Enum=HTTP;TCP;GRPC
+type ActiveHealthCheckerType string
+
+const (
+	// ActiveHealthCheckerTypeHTTP defines the HTTP type of health checking.
+	ActiveHealthCheckerTypeHTTP ActiveHealthCheckerType = "HTTP"
+	// ActiveHealthCheckerTypeTCP defines the TCP type of health checking.
+	ActiveHealthCheckerTypeTCP ActiveHealthCheckerType = "TCP"
+	// ActiveHealthCheckerTypeGRPC defines the gRPC type of health checking.
+	ActiveHealthCheckerTypeGRPC ActiveHealthCheckerType = "GRPC"
+)
+
+// ActiveHealthCheck defines the active health check, where Envoy periodically probes the backends.
+type ActiveHealthCheck struct {
+	// Type defines the type of health checker.
+	Type ActiveHealthCheckerType `json:"type"`
+
+	// Timeout is the time to wait for a health check response. Defaults to 1s.
+	//
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// Interval is the time between health checks. Defaults to 3s.
+	//
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+
+	// UnhealthyThreshold is the number of failed health checks before a backend
+	// is marked unhealthy. Defaults to 3.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	UnhealthyThreshold *int32 `json:"unhealthyThreshold,omitempty"`
+
+	// HealthyThreshold is the number of successful health checks before an unhealthy
+	// backend is marked healthy again. Defaults to 1.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	HealthyThreshold *int32 `json:"healthyThreshold,omitempty"`
+
+	// HTTP defines the configuration of the HTTP health checker.
+	// It is required when the health checker type is HTTP.
+	//
+	// +optional
+	HTTP *HTTPActiveHealthChecker `json:"http,omitempty"`
+
+	// TCP defines the configuration of the TCP health checker. When unspecified,
+	// a backend is considered healthy as soon as a connection can be established.
+	//
+	// +optional
+	TCP *TCPActiveHealthChecker `json:"tcp,omitempty"`
+
+	// GRPC defines the configuration of the gRPC health checker, which uses the
+	// grpc.health.v1.Health service and requires the backends to serve HTTP/2.
+	// The requests of the routes are then also forwarded to the backends over HTTP/2.
+	//
+	// +optional
+	GRPC *GRPCActiveHealthChecker `json:"grpc,omitempty"`
+}
+
+// HTTPActiveHealthChecker defines the configuration of the HTTP health checker.
+type HTTPActiveHealthChecker struct {
+	// Path is the path requested by the health checker.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=1024
+	Path string `json:"path"`
+
+	// ExpectedStatuses are the response status codes considered healthy.
+	// Defaults to 200 only.
+	//
+	// +kubebuilder:validation:MaxItems=16
+	// +optional
+	ExpectedStatuses []HTTPStatus `json:"expectedStatuses,omitempty"`
+}
+
+// TCPActiveHealthChecker defines the configuration of the TCP health checker.
+type TCPActiveHealthChecker struct {
+	// Send is the text payload sent to the backend once connected.
+	//
+	// +optional
+	Send *string `json:"send,omitempty"`
+
+	// Receive is the text payload expected in the response. The backend is
+	// considered healthy when the response contains it.
+	//
+	// +optional
+	Receive *string `json:"receive,omitempty"`
+}
+
+// GRPCActiveHealthChecker defines the configuration of the gRPC health checker.
+type GRPCActiveHealthChecker struct {
+	// Service is the name of the service to check. When unspecified, the overall
+	// health of the backend is checked.
+	//
+	// +optional
+	Service *string `json:"service,omitempty"`
+}
+
+// PassiveHealthCheck defines the passive health check, also known as outlier detection.
+type PassiveHealthCheck struct {
+	// Interval is the time between ejection analysis sweeps. Defaults to 10s.
+	//
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+
+	// Consecutive5XXErrors is the number of consecutive 5xx errors, including
+	// connection failures, that eject a backend. Defaults to 5.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Consecutive5XXErrors *int32 `json:"consecutive5XXErrors,omitempty"`
+
+	// ConsecutiveGatewayErrors is the number of consecutive 502, 503 and 504
+	// errors that eject a backend. Disabled when unspecified.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	ConsecutiveGatewayErrors *int32 `json:"consecutiveGatewayErrors,omitempty"`
+
+	// BaseEjectionTime is the base duration a backend is ejected for. The actual
+	// duration is the base multiplied by the number of times the backend has been
+	// ejected. Defaults to 30s.
+	//
+	// +optional
+	BaseEjectionTime *metav1.Duration `json:"baseEjectionTime,omitempty"`
+
+	// MaxEjectionPercent is the maximum percentage of backends that can be
+	// ejected at the same time. Defaults to 10.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	MaxEjectionPercent *int32 `json:"maxEjectionPercent,omitempty"`
+}
+
+// LoadBalancerType is the type of load balancer.
+//
+// +kubebuilder:validation:Enum=RoundRobin;LeastRequest;Random;RingHash;Maglev
+type LoadBalancerType string
+
+const (
+	// RoundRobinLoadBalancerType picks the backends in turn.
+	RoundRobinLoadBalancerType LoadBalancerType = "RoundRobin"
+	// LeastRequestLoadBalancerType picks the backend with the fewest active requests.
+	LeastRequestLoadBalancerType LoadBalancerType = "LeastRequest"
+	// RandomLoadBalancerType picks a random backend.
+	RandomLoadBalancerType LoadBalancerType = "Random"
+	// RingHashLoadBalancerType picks the backend from a hash of the request, using a Ketama ring.
+	RingHashLoadBalancerType LoadBalancerType = "RingHash"
+	// MaglevLoadBalancerType picks the backend from a hash of the request, using a Maglev table.
+	MaglevLoadBalancerType LoadBalancerType = "Maglev"
+)
+
+// LoadBalancer defines how requests are balanced across the backends.
+type LoadBalancer struct {
+	// Type defines the type of load balancer.
+	Type LoadBalancerType `json:"type"`
+
+	// ConsistentHash defines the request attribute hashed by the RingHash and
+	// Maglev load balancers. It must be set for these types and only for them.
+	//
+	// +optional
+	ConsistentHash *ConsistentHash `json:"consistentHash,omitempty"`
+
+	// LeastRequest defines the settings of the LeastRequest load balancer. It
+	// can only be set for this type.
+	//
+	// +optional
+	LeastRequest *LeastRequest `json:"leastRequest,omitempty"`
+}
+
+// LeastRequest defines the settings of the LeastRequest load balancer.
+type LeastRequest struct {
+	// ChoiceCount is the number of random backends compared to pick the one
+	// with the fewest active requests. Defaults to 2.
+	//
+	// +kubebuilder:validation:Minimum=2
+	// +optional
+	ChoiceCount *int32 `json:"choiceCount,omitempty"`
+}
+
+// ConsistentHashType is the request attribute used for consistent hashing.
+//
+// +kubebuilder:validation:Enum=SourceIP;Header;Cookie;QueryParam
+type ConsistentHashType string
+
+const (
+	// SourceIPConsistentHashType hashes on the IP address of the client.
+	SourceIPConsistentHashType ConsistentHashType = "SourceIP"
+	// HeaderConsistentHashType hashes on the value of a request header.
+	HeaderConsistentHashType ConsistentHashType = "Header"
+	// CookieConsistentHashType hashes on the value of a cookie.
+	CookieConsistentHashType ConsistentHashType = "Cookie"
+	// QueryParamConsistentHashType hashes on the value of a query parameter.
+	QueryParamConsistentHashType ConsistentHashType = "QueryParam"
+)
+
+// ConsistentHash defines the request attribute hashed to pick a backend, so
+// that requests sharing the attribute are sent to the same backend.
+type ConsistentHash struct {
+	// Type defines the request attribute to hash.
+	Type ConsistentHashType `json:"type"`
+
+	// Header defines the header to hash. Required for the Header type.
+	//
+	// +optional
+	Header *HeaderHash `json:"header,omitempty"`
+
+	// Cookie defines the cookie to hash. Required for the Cookie type.
+	//
+	// +optional
+	Cookie *CookieHash `json:"cookie,omitempty"`
+
+	// QueryParam defines the query parameter to hash. Required for the QueryParam type.
+	//
+	// +optional
+	QueryParam *QueryParamHash `json:"queryParam,omitempty"`
+}
+
+// HeaderHash defines the header to hash.
+type HeaderHash struct {
+	// Name of the header.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+}
+
+// CookieHash defines the cookie to hash.
+type CookieHash struct {
+	// Name of the cookie.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// TTL of the cookie generated by Envoy when the request does not carry one.
+	// No cookie is generated when unset.
+	//
+	// +optional
+	TTL *metav1.Duration `json:"ttl,omitempty"`
+
+	// Path of the generated cookie.
+	//
+	// +optional
+	Path *string `json:"path,omitempty"`
+}
+
+// QueryParamHash defines the query parameter to hash.
+type QueryParamHash struct {
+	// Name of the query parameter.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+}
+
+// CircuitBreaker defines the limits on the connections and requests sent to the backends.
+type CircuitBreaker struct {
+	// MaxConnections is the maximum number of connections Envoy establishes to
+	// the backends. Defaults to 1024.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=4294967295
+	// +optional
+	MaxConnections *int64 `json:"maxConnections,omitempty"`
+
+	// MaxPendingRequests is the maximum number of requests waiting for a connection
+	// to the backends. Defaults to 1024.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=4294967295
+	// +optional
+	MaxPendingRequests *int64 `json:"maxPendingRequests,omitempty"`
+
+	// MaxParallelRequests is the maximum number of requests in flight to the
+	// backends. Defaults to 1024.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=4294967295
+	// +optional
+	MaxParallelRequests *int64 `json:"maxParallelRequests,omitempty"`
+
+	// MaxParallelRetries is the maximum number of retries in flight to the backends.
+	// Defaults to 3. It is ignored when a retry budget is configured.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=4294967295
+	// +optional
+	MaxParallelRetries *int64 `json:"maxParallelRetries,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// BackendTrafficPolicyList contains a list of BackendTrafficPolicy resources.
+type BackendTrafficPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BackendTrafficPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&BackendTrafficPolicy{}, &BackendTrafficPolicyList{})
+}
@@ -0,0 +1,349 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// KindClientTrafficPolicy is the name of the ClientTrafficPolicy kind.
+	KindClientTrafficPolicy = "ClientTrafficPolicy"
+)
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+// ClientTrafficPolicy allows the user to configure the behavior of the connection
+// between the downstream clients and the Envoy proxy listeners of a Gateway.
+type ClientTrafficPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the ClientTrafficPolicy.
+	Spec ClientTrafficPolicySpec `json:"spec"`
+
+	// Status defines the current status of the ClientTrafficPolicy.
+	Status PolicyStatus `json:"status,omitempty"`
+}
+
+// ClientTrafficPolicySpec defines the desired state of the ClientTrafficPolicy.
+type ClientTrafficPolicySpec struct {
+	// TargetRef is the Gateway or Gateway listener this policy is attached to. A
+	// policy targeting a listener takes precedence over a policy targeting the
+	// whole Gateway. The namespace of the target must match the namespace of the
+	// policy. The HTTP listeners sharing a port without TLS serve the requests
+	// with the same settings, so a policy cannot target one of them: the Gateway
+	// must be targeted instead.
+	TargetRef PolicyTargetReferenceWithSectionName `json:"targetRef"`
+
+	// EnableProxyProtocol expects the clients, typically a load balancer in front
+	// of the Envoy proxy, to send the PROXY protocol header on the connections.
+	// The addresses of the header replace the ones of the connections, so that the
+	// original client is seen instead of the load balancer. Versions 1 and 2 of the
+	// protocol are supported. As the header is read before TLS, it applies to all
+	// the listeners of the Gateway sharing a port: a policy targeting a listener
+	// sharing its port with other listeners cannot enable it, the Gateway must be
+	// targeted instead.
+	//
+	// +optional
+	EnableProxyProtocol *bool `json:"enableProxyProtocol,omitempty"`
+
+	// ClientIPDetection configures how the IP address of the clients is determined
+	// for the HTTP requests, e.g. for the access logs, rate limits and IP filters.
+	// The peer address of the downstream connection is used when unset, and the
+	// X-Forwarded-For header is then only trusted for the address of the peer.
+	// It does not apply to the TLS passthrough listeners.
+	//
+	// +optional
+	ClientIPDetection *ClientIPDetectionSettings `json:"clientIPDetection,omitempty"`
+
+	// Timeouts defines the timeouts of the downstream connections and of the
+	// HTTP requests received on them.
+	//
+	// +optional
+	Timeouts *ClientTimeouts `json:"timeouts,omitempty"`
+
+	// Headers defines the limits and transformations of the HTTP headers.
+	//
+	// +optional
+	Headers *HeaderSettings `json:"headers,omitempty"`
+
+	// Path defines the normalization of the request paths, applied before the
+	// routes are matched and the requests are forwarded to the backends.
+	//
+	// +optional
+	Path *PathSettings `json:"path,omitempty"`
+
+	// HTTP1 defines the settings specific to HTTP/1 clients.
+	//
+	// +optional
+	HTTP1 *HTTP1Settings `json:"http1,omitempty"`
+
+	// LocalReplies rewrite the responses generated by Envoy instead of the backends,
+	// e.g. the 404 responses when no route matches, the 503 responses when no
+	// backend is healthy, and the 500 responses of the routes without any valid
+	// backend. The first mapper matching the status code of a response applies.
+	//
+	// +kubebuilder:validation:MaxItems=16
+	// +optional
+	LocalReplies []LocalReplyMapper `json:"localReplies,omitempty"`
+
+	// DirectResponseBody replaces the body of the 500 responses sent by the routes
+	// without any valid backend or with an invalid filter, which describes the
+	// error otherwise. The local replies rewriting the 500 responses apply to the
+	// replaced body.
+	//
+	// +optional
+	DirectResponseBody *DirectResponseBody `json:"directResponseBody,omitempty"`
+}
+
+// DirectResponseBody defines the body of the direct responses of the routes.
+// Exactly one of Inline or ConfigMapRef must be specified.
+type DirectResponseBody struct {
+	// Inline body.
+	//
+	// +kubebuilder:validation:MaxLength=4096
+	// +optional
+	Inline *string `json:"inline,omitempty"`
+
+	// ConfigMapRef references the ConfigMap holding the body under the "body" key.
+	// The ConfigMap must be in the namespace of the ClientTrafficPolicy, and the
+	// body must not exceed 4096 bytes.
+	//
+	// +optional
+	ConfigMapRef *ConfigMapReference `json:"configMapRef,omitempty"`
+}
+
+// LocalReplyMapper rewrites the responses generated by Envoy with matching status
+// codes. At least one of StatusCode or Body must be specified.
+type LocalReplyMapper struct {
+	// StatusCodes of the rewritten responses.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=32
+	StatusCodes []int32 `json:"statusCodes"`
+
+	// StatusCode replaces the status code of the responses.
+	//
+	// +kubebuilder:validation:Minimum=100
+	// +kubebuilder:validation:Maximum=599
+	// +optional
+	StatusCode *int32 `json:"statusCode,omitempty"`
+
+	// Body replaces the body of the responses.
+	//
+	// +optional
+	Body *LocalReplyBody `json:"body,omitempty"`
+}
+
+// LocalReplyBodyType is the type of the body of rewritten responses.
+//
+// +kubebuilder:validation:Enum=Text;JSON
+type LocalReplyBodyType string
+
+const (
+	// LocalReplyBodyTypeText formats the body as text.
+	LocalReplyBodyTypeText LocalReplyBodyType = "Text"
+	// LocalReplyBodyTypeJSON formats the body as a JSON object.
+	LocalReplyBodyTypeJSON LocalReplyBodyType = "JSON"
+)
+
+// LocalReplyBody defines the template of the body of rewritten responses. The
+// template may contain the command operators of the Envoy access logs, e.g.
+// %RESPONSE_CODE% or %LOCAL_REPLY_BODY% for the original body. Exactly one of
+// Inline or ConfigMapRef must be specified.
+type LocalReplyBody struct {
+	// Type of the template. The template of a JSON body must be a JSON object,
+	// whose string values are formatted. Defaults to Text.
+	//
+	// +kubebuilder:default=Text
+	// +optional
+	Type *LocalReplyBodyType `json:"type,omitempty"`
+
+	// Inline is the template of the body.
+	//
+	// +optional
+	Inline *string `json:"inline,omitempty"`
+
+	// ConfigMapRef references the ConfigMap holding the template under the "body"
+	// key. The ConfigMap must be in the namespace of the ClientTrafficPolicy.
+	//
+	// +optional
+	ConfigMapRef *ConfigMapReference `json:"configMapRef,omitempty"`
+
+	// ContentType of the body. Defaults to text/plain for Text bodies and to
+	// application/json for JSON bodies.
+	//
+	// +optional
+	ContentType *string `json:"contentType,omitempty"`
+}
+
+// ClientTimeouts defines the timeouts of the downstream connections and requests.
+type ClientTimeouts struct {
+	// Request is the amount of time Envoy waits for the entire request to be
+	// received from the client, and the response to be sent. The timeout is
+	// disabled by default.
+	//
+	// +optional
+	Request *metav1.Duration `json:"request,omitempty"`
+
+	// StreamIdle is the amount of time a request stream can remain without any
+	// downstream or upstream activity before it is reset. Defaults to 5m, and a
+	// value of 0s disables the timeout.
+	//
+	// +optional
+	StreamIdle *metav1.Duration `json:"streamIdle,omitempty"`
+
+	// Idle is the amount of time a downstream connection can remain without any
+	// active request before it is closed. Defaults to 1h, and a value of 0s
+	// disables the timeout.
+	//
+	// +optional
+	Idle *metav1.Duration `json:"idle,omitempty"`
+}
+
+// HeaderSettings defines the limits and transformations of the HTTP headers.
+type HeaderSettings struct {
+	// MaxRequestHeadersKiB is the maximum size of the request headers in KiB.
+	// Requests with larger headers are rejected with a 431 response. Defaults
+	// to 60 KiB.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=8192
+	// +optional
+	MaxRequestHeadersKiB *uint32 `json:"maxRequestHeadersKiB,omitempty"`
+
+	// ServerHeaderTransformation defines how the Server header of the responses
+	// is set. Defaults to Overwrite.
+	//
+	// +optional
+	ServerHeaderTransformation *ServerHeaderTransformation `json:"serverHeaderTransformation,omitempty"`
+}
+
+// ServerHeaderTransformation defines how the Server header of the responses is set.
+// +kubebuilder:validation:Enum=Overwrite;AppendIfAbsent;PassThrough
+type ServerHeaderTransformation string
+
+const (
+	// ServerHeaderTransformationOverwrite sets the Server header to envoy,
+	// overwriting the one of the backend.
+	ServerHeaderTransformationOverwrite ServerHeaderTransformation = "Overwrite"
+	// ServerHeaderTransformationAppendIfAbsent sets the Server header to envoy
+	// if the backend did not set one.
+	ServerHeaderTransformationAppendIfAbsent ServerHeaderTransformation = "AppendIfAbsent"
+	// ServerHeaderTransformationPassThrough keeps the Server header of the
+	// backend, if any.
+	ServerHeaderTransformationPassThrough ServerHeaderTransformation = "PassThrough"
+)
+
+// PathSettings defines the normalization of the request paths.
+type PathSettings struct {
+	// Normalize normalizes the request paths according to RFC 3986, e.g. by
+	// resolving the /./ and /../ segments. Defaults to false.
+	//
+	// +optional
+	Normalize *bool `json:"normalize,omitempty"`
+
+	// MergeSlashes merges the adjacent slashes of the request paths into one,
+	// e.g. /a//b becomes /a/b. Defaults to false.
+	//
+	// +optional
+	MergeSlashes *bool `json:"mergeSlashes,omitempty"`
+}
+
+// HTTP1Settings defines the settings specific to HTTP/1 clients.
+type HTTP1Settings struct {
+	// PreserveHeaderCase keeps the case of the HTTP/1 header names, instead of
+	// lowercasing them, in the requests forwarded to the HTTP/1 backends and in
+	// their responses. Defaults to false.
+	//
+	// +optional
+	PreserveHeaderCase *bool `json:"preserveHeaderCase,omitempty"`
+
+	// EnableHTTP10 accepts the HTTP/1.0 requests, which are otherwise rejected
+	// with a 426 response. Defaults to false.
+	//
+	// +optional
+	EnableHTTP10 *bool `json:"enableHTTP10,omitempty"`
+
+	// DefaultHostForHTTP10 is the host used to route the HTTP/1.0 requests
+	// without a Host header. It requires EnableHTTP10.
+	//
+	// +optional
+	DefaultHostForHTTP10 *string `json:"defaultHostForHTTP10,omitempty"`
+}
+
+// ClientIPDetectionSettings defines how the IP address of the clients is
+// determined when the Envoy proxy is behind other proxies or load balancers.
+type ClientIPDetectionSettings struct {
+	// XForwardedFor takes the client IP address from the X-Forwarded-For header
+	// set by the trusted proxies in front of the Envoy proxy.
+	//
+	// +optional
+	XForwardedFor *XForwardedForSettings `json:"xForwardedFor,omitempty"`
+
+	// CustomHeader takes the client IP address from a header set by the trusted
+	// proxies in front of the Envoy proxy. It takes precedence over XForwardedFor,
+	// which is used when the header is missing or invalid unless FailClosed is set.
+	//
+	// +optional
+	CustomHeader *CustomHeaderSettings `json:"customHeader,omitempty"`
+
+	// TrustedCIDRs lists the address ranges of the trusted proxies in front of the
+	// Envoy proxy. The client IP address is only taken from the headers of the
+	// connections whose peer address is in these ranges, the peer address of the
+	// other connections is their client IP address. When empty, the headers of
+	// all the connections are used.
+	//
+	// Example:
+	//   trustedCIDRs:
+	//   - 10.0.0.0/8
+	//
+	// +kubebuilder:validation:MaxItems=64
+	// +optional
+	TrustedCIDRs []string `json:"trustedCIDRs,omitempty"`
+}
+
+// XForwardedForSettings defines the client IP detection from the X-Forwarded-For header.
+type XForwardedForSettings struct {
+	// NumTrustedHops is the number of trusted proxies in front of the Envoy proxy,
+	// i.e. the number of rightmost addresses of the X-Forwarded-For header skipped
+	// to find the client IP address. Defaults to 0, for which the peer address of
+	// the downstream connection is the client IP address.
+	//
+	// +optional
+	NumTrustedHops *uint32 `json:"numTrustedHops,omitempty"`
+}
+
+// CustomHeaderSettings defines the client IP detection from a custom header.
+type CustomHeaderSettings struct {
+	// Name of the header holding the client IP address, e.g. X-Real-IP. Only the
+	// first value of the header is used.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// FailClosed rejects with a 403 response the requests without a valid IP
+	// address in the header, instead of falling back to the other detection
+	// methods.
+	//
+	// +optional
+	FailClosed *bool `json:"failClosed,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ClientTrafficPolicyList contains a list of ClientTrafficPolicy resources.
+type ClientTrafficPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClientTrafficPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClientTrafficPolicy{}, &ClientTrafficPolicyList{})
+}
@@ -0,0 +1,75 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwapiv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwapiv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+// PolicyTargetReferenceWithSectionName identifies the resource, or the section
+// of the resource, a policy is attached to.
+type PolicyTargetReferenceWithSectionName struct {
+	gwapiv1a2.PolicyTargetReference `json:",inline"`
+
+	// SectionName is the name of a section within the target resource. When
+	// unspecified, the policy targets the entire resource. Only the listener
+	// names of a Gateway are supported.
+	//
+	// +optional
+	SectionName *gwapiv1b1.SectionName `json:"sectionName,omitempty"`
+}
+
+// PolicyConditionType is a type of condition for a policy.
+type PolicyConditionType string
+
+// PolicyConditionReason is a reason for a policy condition.
+type PolicyConditionReason string
+
+const (
+	// PolicyConditionAccepted indicates whether the policy has been accepted or rejected
+	// by a targeted resource, and why.
+	//
+	// Possible reasons for this condition to be True are:
+	//
+	// * "Accepted"
+	//
+	// Possible reasons for this condition to be False are:
+	//
+	// * "Invalid"
+	// * "TargetNotFound"
+	// * "Conflicted"
+	//
+	PolicyConditionAccepted PolicyConditionType = "Accepted"
+
+	// PolicyReasonAccepted is used with the "Accepted" condition when the policy has been
+	// accepted by the targeted resource.
+	PolicyReasonAccepted PolicyConditionReason = "Accepted"
+
+	// PolicyReasonInvalid is used with the "Accepted" condition when the policy is syntactically
+	// or semantically invalid.
+	PolicyReasonInvalid PolicyConditionReason = "Invalid"
+
+	// PolicyReasonTargetNotFound is used with the "Accepted" condition when the policy is attached to
+	// an invalid target resource.
+	PolicyReasonTargetNotFound PolicyConditionReason = "TargetNotFound"
+
+	// PolicyReasonConflicted is used with the "Accepted" condition when the policy cannot be applied
+	// because another policy of the same kind already targets the same resource.
+	PolicyReasonConflicted PolicyConditionReason = "Conflicted"
+)
+
+// PolicyStatus defines the observed state of a policy.
+type PolicyStatus struct {
+	// Conditions describe the current conditions of the policy.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MaxItems=8
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
@@ -0,0 +1,219 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package v1alpha1
+
+import (
+	gwapiv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+// RateLimitType is the type of rate limit.
+//
+// +kubebuilder:validation:Enum=Local;Global
+type RateLimitType string
+
+const (
+	// LocalRateLimitType enforces the rate limits in each Envoy proxy,
+	// without the need of an external service.
+	LocalRateLimitType RateLimitType = "Local"
+	// GlobalRateLimitType enforces the rate limits across all Envoy proxies,
+	// through the rate limit service managed by Envoy Gateway.
+	GlobalRateLimitType RateLimitType = "Global"
+)
+
+// RateLimit defines the rate limits applied to requests.
+type RateLimit struct {
+	// Type defines the type of rate limit.
+	Type RateLimitType `json:"type"`
+
+	// Local defines the rate limits enforced in each Envoy proxy.
+	// Required when the type is Local.
+	//
+	// +optional
+	Local *LocalRateLimit `json:"local,omitempty"`
+
+	// Global defines the rate limits shared by all Envoy proxies.
+	// Required when the type is Global.
+	//
+	// +optional
+	Global *GlobalRateLimit `json:"global,omitempty"`
+}
+
+// LocalRateLimit defines the rate limits enforced in each Envoy proxy. The limits
+// are not shared between the replicas of the proxy, nor between the routes: a
+// policy targeting a Gateway or a Gateway listener applies a separate token bucket
+// to each route attached to it.
+type LocalRateLimit struct {
+	// Limit is the rate limit applied to all requests.
+	Limit RateLimitValue `json:"limit"`
+
+	// Rules define additional rate limits applied to the requests matching
+	// their headers. A request is rejected when any of the limits it is
+	// subject to is exceeded.
+	//
+	// +kubebuilder:validation:MaxItems=16
+	// +optional
+	Rules []LocalRateLimitRule `json:"rules,omitempty"`
+
+	// Response defines the response sent to the rate limited requests.
+	//
+	// +optional
+	Response *RateLimitResponse `json:"response,omitempty"`
+}
+
+// LocalRateLimitRule defines a rate limit applied to the requests matching its headers.
+type LocalRateLimitRule struct {
+	// Headers the request must match for the rate limit to apply.
+	// All headers must match.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	Headers []gwapiv1b1.HTTPHeaderMatch `json:"headers"`
+
+	// Limit is the rate limit applied to the matching requests. Its unit must be
+	// a multiple of the unit of the rate limit applied to all requests.
+	Limit RateLimitValue `json:"limit"`
+}
+
+// GlobalRateLimit defines the rate limits shared by all Envoy proxies. The
+// limits are enforced by the rate limit service managed by Envoy Gateway,
+// which must be configured in the EnvoyGateway configuration.
+type GlobalRateLimit struct {
+	// Rules define the rate limits. A request is rejected when any of the
+	// limits it is subject to is exceeded.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	Rules []GlobalRateLimitRule `json:"rules"`
+}
+
+// GlobalRateLimitRule defines a rate limit applied to the requests matching its
+// selectors. A rule without selectors applies to all requests.
+type GlobalRateLimitRule struct {
+	// Headers the request must match for the rate limit to apply. A header
+	// with the Distinct type matches any value, and each value gets its own limit.
+	//
+	// +kubebuilder:validation:MaxItems=16
+	// +optional
+	Headers []RateLimitHeaderMatch `json:"headers,omitempty"`
+
+	// ClientIP selects the requests based on the IP address of the client.
+	//
+	// +optional
+	ClientIP *RateLimitClientIPMatch `json:"clientIP,omitempty"`
+
+	// Path selects the requests based on their path.
+	//
+	// +optional
+	Path *RateLimitPathMatch `json:"path,omitempty"`
+
+	// Limit is the rate limit applied to the matching requests.
+	// Burst is not supported for global rate limits.
+	Limit RateLimitValue `json:"limit"`
+}
+
+// RateLimitHeaderMatchType is the type of a header match.
+//
+// +kubebuilder:validation:Enum=Exact;RegularExpression;Distinct
+type RateLimitHeaderMatchType string
+
+const (
+	// RateLimitHeaderMatchExact matches the exact value of the header.
+	RateLimitHeaderMatchExact RateLimitHeaderMatchType = "Exact"
+	// RateLimitHeaderMatchRegularExpression matches the value of the header against a regular expression.
+	RateLimitHeaderMatchRegularExpression RateLimitHeaderMatchType = "RegularExpression"
+	// RateLimitHeaderMatchDistinct matches any value of the header, each value getting its own limit.
+	RateLimitHeaderMatchDistinct RateLimitHeaderMatchType = "Distinct"
+)
+
+// RateLimitHeaderMatch selects the requests based on a header.
+type RateLimitHeaderMatch struct {
+	// Type specifies how to match against the value of the header.
+	//
+	// +kubebuilder:default=Exact
+	// +optional
+	Type *RateLimitHeaderMatchType `json:"type,omitempty"`
+
+	// Name of the header.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Value to match. Required unless the type is Distinct.
+	//
+	// +optional
+	Value *string `json:"value,omitempty"`
+}
+
+// RateLimitClientIPMatch selects the requests based on the IP address of the client.
+type RateLimitClientIPMatch struct {
+	// CIDR the client IP address must belong to. The clients of the CIDR share
+	// the same limit. When unset, each client IP address gets its own limit.
+	//
+	// +optional
+	CIDR *string `json:"cidr,omitempty"`
+}
+
+// RateLimitPathMatch selects the requests based on their path.
+type RateLimitPathMatch struct {
+	// Type specifies how to match against the path.
+	//
+	// +kubebuilder:default=PathPrefix
+	// +optional
+	Type *gwapiv1b1.PathMatchType `json:"type,omitempty"`
+
+	// Value of the path to match.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Value string `json:"value"`
+}
+
+// RateLimitUnit is the unit of time of a rate limit.
+//
+// +kubebuilder:validation:Enum=Second;Minute;Hour
+type RateLimitUnit string
+
+const (
+	// RateLimitUnitSecond limits the number of requests per second.
+	RateLimitUnitSecond RateLimitUnit = "Second"
+	// RateLimitUnitMinute limits the number of requests per minute.
+	RateLimitUnitMinute RateLimitUnit = "Minute"
+	// RateLimitUnitHour limits the number of requests per hour.
+	RateLimitUnitHour RateLimitUnit = "Hour"
+)
+
+// RateLimitValue defines a token bucket, refilled with Requests tokens every Unit.
+type RateLimitValue struct {
+	// Requests is the number of requests allowed per unit of time.
+	//
+	// +kubebuilder:validation:Minimum=1
+	Requests uint32 `json:"requests"`
+
+	// Unit is the unit of time of the rate limit.
+	Unit RateLimitUnit `json:"unit"`
+
+	// Burst is the maximum number of requests allowed at once, when tokens have
+	// accumulated in the bucket. Defaults to Requests.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Burst *uint32 `json:"burst,omitempty"`
+}
+
+// RateLimitResponse defines the response sent to the rate limited requests.
+type RateLimitResponse struct {
+	// StatusCode is the HTTP status code of the response. Defaults to 429.
+	//
+	// +kubebuilder:validation:Minimum=400
+	// +kubebuilder:validation:Maximum=599
+	// +optional
+	StatusCode *int32 `json:"statusCode,omitempty"`
+
+	// Headers are added to the response.
+	//
+	// +kubebuilder:validation:MaxItems=16
+	// +optional
+	Headers []gwapiv1b1.HTTPHeader `json:"headers,omitempty"`
+}
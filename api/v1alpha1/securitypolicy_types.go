@@ -0,0 +1,276 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwapiv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+const (
+	// KindSecurityPolicy is the name of the SecurityPolicy kind.
+	KindSecurityPolicy = "SecurityPolicy"
+)
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+// SecurityPolicy allows the user to configure the access control applied by the
+// Envoy proxy to the requests of a Gateway or HTTPRoute.
+type SecurityPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the SecurityPolicy.
+	Spec SecurityPolicySpec `json:"spec"`
+
+	// Status defines the current status of the SecurityPolicy.
+	Status PolicyStatus `json:"status,omitempty"`
+}
+
+// SecurityPolicySpec defines the desired state of the SecurityPolicy.
+type SecurityPolicySpec struct {
+	// TargetRef is the Gateway, Gateway listener or HTTPRoute this policy is attached
+	// to. When a Gateway is targeted, the policy applies to all routes attached to the
+	// Gateway. A policy targeting an HTTPRoute takes precedence over a policy targeting
+	// a listener of its Gateway, which takes precedence over a policy targeting the
+	// whole Gateway, for the fields it sets only: the other fields are inherited from
+	// the policies of lower precedence. The namespace of the target must match the
+	// namespace of the policy.
+	TargetRef PolicyTargetReferenceWithSectionName `json:"targetRef"`
+
+	// ExtAuth defines the external authorization service that must approve
+	// the requests before they are forwarded to the backends.
+	//
+	// +optional
+	ExtAuth *ExtAuth `json:"extAuth,omitempty"`
+
+	// IPFilter defines the client IP addresses allowed or denied access to the
+	// target. When a Gateway or Gateway listener is targeted, it also applies to
+	// the connections of the TLS passthrough listeners.
+	//
+	// +optional
+	IPFilter *IPFilter `json:"ipFilter,omitempty"`
+
+	// CORS defines the Cross-Origin Resource Sharing policy allowing browsers
+	// to access the target from the pages of other origins.
+	//
+	// +optional
+	CORS *CORS `json:"cors,omitempty"`
+}
+
+// ExtAuth defines the external authorization service that must approve the
+// requests. Exactly one of GRPC or HTTP must be set. For additional details, see:
+//
+//	https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_filters/ext_authz_filter
+type ExtAuth struct {
+	// GRPC defines an authorization service implementing the Envoy
+	// envoy.service.auth.v3.Authorization gRPC service.
+	//
+	// +optional
+	GRPC *GRPCExtAuthService `json:"grpc,omitempty"`
+
+	// HTTP defines an authorization service receiving the request headers
+	// over HTTP, and approving the request with a 2xx response.
+	//
+	// +optional
+	HTTP *HTTPExtAuthService `json:"http,omitempty"`
+
+	// Timeout is the amount of time Envoy waits for the authorization
+	// service to respond. Defaults to 10s.
+	//
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// FailOpen allows the requests when the authorization service cannot be
+	// reached or responds with an error. By default, such requests are denied
+	// with a 403.
+	//
+	// +optional
+	FailOpen *bool `json:"failOpen,omitempty"`
+}
+
+// GRPCExtAuthService defines a gRPC authorization service. The service
+// receives all the headers of the request.
+type GRPCExtAuthService struct {
+	// BackendRef references the Service of the authorization service.
+	// Only the Service kind of the core API group is supported, and a
+	// ReferenceGrant is required to reference a Service in another namespace.
+	BackendRef gwapiv1b1.BackendObjectReference `json:"backendRef"`
+}
+
+// HTTPExtAuthService defines an HTTP authorization service.
+type HTTPExtAuthService struct {
+	// BackendRef references the Service of the authorization service.
+	// Only the Service kind of the core API group is supported, and a
+	// ReferenceGrant is required to reference a Service in another namespace.
+	BackendRef gwapiv1b1.BackendObjectReference `json:"backendRef"`
+
+	// Path is the prefix added to the path of the requests sent to the
+	// authorization service.
+	//
+	// +optional
+	Path *string `json:"path,omitempty"`
+
+	// HeadersToExtAuth are the request headers forwarded to the authorization
+	// service, in addition to the Host, Method, Path, Content-Length and
+	// Authorization headers which are always forwarded.
+	//
+	// +kubebuilder:validation:MaxItems=32
+	// +optional
+	HeadersToExtAuth []string `json:"headersToExtAuth,omitempty"`
+
+	// HeadersToBackend are the headers of an approving response of the
+	// authorization service added to the request forwarded to the backend.
+	//
+	// +kubebuilder:validation:MaxItems=32
+	// +optional
+	HeadersToBackend []string `json:"headersToBackend,omitempty"`
+
+	// HeadersToClient are the headers of a denying response of the
+	// authorization service sent back to the client. When unspecified,
+	// all the headers of the denying response are sent back.
+	//
+	// +kubebuilder:validation:MaxItems=32
+	// +optional
+	HeadersToClient []string `json:"headersToClient,omitempty"`
+}
+
+// IPFilter defines the client IP addresses allowed or denied access. At least
+// one of Allow or Deny must be set. Denied clients get a 403 response, or have
+// their connection closed on TLS passthrough listeners.
+type IPFilter struct {
+	// Allow lists the CIDRs of the clients allowed access. When set, the clients
+	// outside of these CIDRs are denied access.
+	//
+	// Example:
+	//   allow:
+	//   - 10.0.0.0/8
+	//   - 2001:db8::/32
+	//
+	// +kubebuilder:validation:MaxItems=64
+	// +optional
+	Allow []string `json:"allow,omitempty"`
+
+	// Deny lists the CIDRs of the clients denied access. It takes precedence
+	// over Allow.
+	//
+	// +kubebuilder:validation:MaxItems=64
+	// +optional
+	Deny []string `json:"deny,omitempty"`
+
+	// ClientIPSource defines where the IP address of the client is taken from.
+	// Supported sources are:
+	//
+	//   * Connection: The peer address of the downstream connection.
+	//   * XForwardedFor: The address derived from the X-Forwarded-For header
+	//     by Envoy, for clients behind a trusted proxy or load balancer, as
+	//     configured by the clientIPDetection of a ClientTrafficPolicy. It
+	//     is ignored on TLS passthrough listeners.
+	//
+	// Defaults to Connection.
+	//
+	// +optional
+	ClientIPSource *ClientIPSource `json:"clientIPSource,omitempty"`
+}
+
+// ClientIPSource is a source of the IP address of a client.
+// +kubebuilder:validation:Enum=Connection;XForwardedFor
+type ClientIPSource string
+
+const (
+	// ClientIPSourceConnection takes the client IP address from the downstream connection.
+	ClientIPSourceConnection ClientIPSource = "Connection"
+	// ClientIPSourceXForwardedFor takes the client IP address from the X-Forwarded-For header.
+	ClientIPSourceXForwardedFor ClientIPSource = "XForwardedFor"
+)
+
+// CORS defines the Cross-Origin Resource Sharing policy. Envoy answers the
+// preflight requests of the allowed origins, and adds the CORS headers to the
+// responses to their actual requests. For additional details, see:
+//
+//	https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_filters/cors_filter
+type CORS struct {
+	// AllowOrigins lists the origins allowed to make cross-origin requests.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=64
+	AllowOrigins []CORSOrigin `json:"allowOrigins"`
+
+	// AllowMethods lists the methods allowed in cross-origin requests,
+	// sent in the Access-Control-Allow-Methods header.
+	//
+	// +kubebuilder:validation:MaxItems=16
+	// +optional
+	AllowMethods []string `json:"allowMethods,omitempty"`
+
+	// AllowHeaders lists the request headers allowed in cross-origin requests,
+	// sent in the Access-Control-Allow-Headers header.
+	//
+	// +kubebuilder:validation:MaxItems=64
+	// +optional
+	AllowHeaders []string `json:"allowHeaders,omitempty"`
+
+	// ExposeHeaders lists the response headers the browsers may expose to the
+	// pages, sent in the Access-Control-Expose-Headers header.
+	//
+	// +kubebuilder:validation:MaxItems=64
+	// +optional
+	ExposeHeaders []string `json:"exposeHeaders,omitempty"`
+
+	// MaxAge is how long the browsers may cache the result of a preflight
+	// request, sent in the Access-Control-Max-Age header.
+	//
+	// +optional
+	MaxAge *metav1.Duration `json:"maxAge,omitempty"`
+
+	// AllowCredentials allows the cross-origin requests to include credentials,
+	// such as cookies, by sending the Access-Control-Allow-Credentials header.
+	//
+	// +optional
+	AllowCredentials *bool `json:"allowCredentials,omitempty"`
+}
+
+// CORSOriginMatchType is the type of an origin match.
+//
+// +kubebuilder:validation:Enum=Exact;Prefix;RegularExpression
+type CORSOriginMatchType string
+
+const (
+	// CORSOriginMatchExact matches the exact origin.
+	CORSOriginMatchExact CORSOriginMatchType = "Exact"
+	// CORSOriginMatchPrefix matches the origins starting with the value.
+	CORSOriginMatchPrefix CORSOriginMatchType = "Prefix"
+	// CORSOriginMatchRegularExpression matches the origins against a regular expression.
+	CORSOriginMatchRegularExpression CORSOriginMatchType = "RegularExpression"
+)
+
+// CORSOrigin selects the origins allowed to make cross-origin requests.
+type CORSOrigin struct {
+	// Type specifies how to match against the origin.
+	//
+	// +kubebuilder:default=Exact
+	// +optional
+	Type *CORSOriginMatchType `json:"type,omitempty"`
+
+	// Value to match, such as https://www.example.com.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Value string `json:"value"`
+}
+
+//+kubebuilder:object:root=true
+
+// SecurityPolicyList contains a list of SecurityPolicy resources.
+type SecurityPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SecurityPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SecurityPolicy{}, &SecurityPolicyList{})
+}
@@ -37,6 +37,10 @@ const (
 	// keySize sets the RSA key size to 2048 bits. This is minimum recommended size
 	// for RSA keys.
 	keySize = 2048
+
+	// hmacSecretSize is the size in bytes of the HMAC secret signing the cookies
+	// of the OIDC authentication.
+	hmacSecretSize = 32
 )
 
 // Configuration holds config parameters used for generating certificates.
@@ -71,13 +75,16 @@ const (
 )
 
 // Certificates contains a set of Certificates as []byte each holding
-// the CA Cert along with Envoy Gateway & Envoy certificates.
+// the CA Cert along with Envoy Gateway & Envoy certificates, and the HMAC
+// secret shared by the Envoy proxies to sign the cookies of the OIDC
+// authentication.
 type Certificates struct {
 	CACertificate           []byte
 	EnvoyGatewayCertificate []byte
 	EnvoyGatewayPrivateKey  []byte
 	EnvoyCertificate        []byte
 	EnvoyPrivateKey         []byte
+	OIDCHMACSecret          []byte
 }
 
 // certificateRequest defines a certificate request.
@@ -141,12 +148,18 @@ func GenerateCerts(cfg *config.Server) (*Certificates, error) {
 			return nil, err
 		}
 
+		hmacSecret := make([]byte, hmacSecretSize)
+		if _, err := rand.Read(hmacSecret); err != nil {
+			return nil, fmt.Errorf("cannot generate HMAC secret: %v", err)
+		}
+
 		return &Certificates{
 			CACertificate:           caCertPEM,
 			EnvoyGatewayCertificate: egCert,
 			EnvoyGatewayPrivateKey:  egKey,
 			EnvoyCertificate:        envoyCert,
 			EnvoyPrivateKey:         envoyKey,
+			OIDCHMACSecret:          hmacSecret,
 		}, nil
 	default:
 		// Envoy Gateway, e.g. self-signed CA, is the only supported certificate provider.
@@ -48,6 +48,8 @@ func TestGenerateCerts(t *testing.T) {
 
 			err = verifyCert(got.EnvoyCertificate, roots, tc.wantEnvoyDNSName, currentTime)
 			assert.NoErrorf(t, err, "Validating %s failed", name)
+
+			assert.Len(t, got.OIDCHMACSecret, hmacSecretSize)
 		})
 	}
 
@@ -23,6 +23,15 @@ const (
 	EnvoyGatewayServiceName = "envoy-gateway"
 	// EnvoyPrefix is the prefix applied to the Envoy ConfigMap, Service, Deployment, and ServiceAccount.
 	EnvoyPrefix = "envoy"
+	// EnvoyRateLimitPrefix is the prefix applied to the rate limit ConfigMap, Service and Deployment.
+	EnvoyRateLimitPrefix = "envoy-ratelimit"
+	// RateLimitGRPCPort is the port of the gRPC endpoint of the rate limit service.
+	RateLimitGRPCPort = 8081
+	// OIDCHMACSecretName is the name of the Secret holding the HMAC secret signing
+	// the cookies of the OIDC authentication, generated along with the certificates.
+	OIDCHMACSecretName = "envoy-oidc-hmac"
+	// OIDCHMACSecretKey is the key of the HMAC secret in its Secret.
+	OIDCHMACSecretKey = "hmac-secret"
 )
 
 // Server wraps the EnvoyGateway configuration and additional parameters
@@ -66,6 +75,13 @@ func (s *Server) Validate() error {
 		return fmt.Errorf("unsupported provider %v", s.EnvoyGateway.EnvoyGatewaySpec.Provider.Type)
 	case len(s.Namespace) == 0:
 		return errors.New("namespace is empty string")
+	case s.EnvoyGateway.RateLimit != nil:
+		if s.EnvoyGateway.RateLimit.Backend.Type != v1alpha1.RedisBackendType {
+			return fmt.Errorf("unsupported ratelimit backend %v", s.EnvoyGateway.RateLimit.Backend.Type)
+		}
+		if s.EnvoyGateway.RateLimit.Backend.Redis == nil || len(s.EnvoyGateway.RateLimit.Backend.Redis.URL) == 0 {
+			return errors.New("empty ratelimit redis settings")
+		}
 	}
 
 	return nil
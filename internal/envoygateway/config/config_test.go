@@ -99,6 +99,43 @@ func TestValidate(t *testing.T) {
 			},
 			expect: false,
 		},
+		{
+			name: "valid ratelimit redis backend",
+			cfg: &Server{
+				EnvoyGateway: &v1alpha1.EnvoyGateway{
+					EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
+						Gateway:  v1alpha1.DefaultGateway(),
+						Provider: v1alpha1.DefaultProvider(),
+						RateLimit: &v1alpha1.RateLimit{
+							Backend: v1alpha1.RateLimitDatabaseBackend{
+								Type:  v1alpha1.RedisBackendType,
+								Redis: &v1alpha1.RateLimitRedisSettings{URL: "redis.redis.svc:6379"},
+							},
+						},
+					},
+				},
+				Namespace: "test-ns",
+			},
+			expect: true,
+		},
+		{
+			name: "empty ratelimit redis settings",
+			cfg: &Server{
+				EnvoyGateway: &v1alpha1.EnvoyGateway{
+					EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
+						Gateway:  v1alpha1.DefaultGateway(),
+						Provider: v1alpha1.DefaultProvider(),
+						RateLimit: &v1alpha1.RateLimit{
+							Backend: v1alpha1.RateLimitDatabaseBackend{
+								Type: v1alpha1.RedisBackendType,
+							},
+						},
+					},
+				},
+				Namespace: "test-ns",
+			},
+			expect: false,
+		},
 	}
 
 	for _, tc := range testCases {
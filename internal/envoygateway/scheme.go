@@ -12,6 +12,7 @@ import (
 	gwapiv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 
 	"github.com/envoyproxy/gateway/api/config/v1alpha1"
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
 )
 
 var (
@@ -31,6 +32,9 @@ func init() {
 	if err := v1alpha1.AddToScheme(scheme); err != nil {
 		panic(err)
 	}
+	if err := egv1a1.AddToScheme(scheme); err != nil {
+		panic(err)
+	}
 	if err := gwapiv1b1.AddToScheme(scheme); err != nil {
 		panic(err)
 	}
@@ -0,0 +1,387 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package gatewayapi
+
+import (
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/envoygateway/config"
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+const (
+	// oidcClientSecretKey is the key of the client secret in the Secret
+	// referenced by an OIDC authentication provider.
+	oidcClientSecretKey = "client-secret"
+	// oidcDefaultRedirectURL is the redirect URL of an OIDC authentication
+	// provider when unspecified, pointing to the host of the request.
+	oidcDefaultRedirectURL = "%REQ(x-forwarded-proto)%://%REQ(:authority)%/oauth2/callback"
+	// oidcDefaultLogoutPath is the logout path of an OIDC authentication
+	// provider when unspecified.
+	oidcDefaultLogoutPath = "/logout"
+	// basicAuthUsersKey is the key of the users in the Secret referenced by
+	// an HTTP basic authentication provider.
+	basicAuthUsersKey = ".htpasswd"
+	// htpasswdSHAPrefix is the prefix of the SHA1 password hashes of htpasswd files.
+	htpasswdSHAPrefix = "{SHA}"
+	// jwksKey is the key of the JSON Web Key Set in the ConfigMap or Secret
+	// referenced by a JWT authentication provider.
+	jwksKey = "jwks"
+)
+
+// isAuthenticationFilterRef returns true if the extension reference of an
+// HTTPRoute filter references an AuthenticationFilter.
+func isAuthenticationFilterRef(ref *v1beta1.LocalObjectReference) bool {
+	return ref != nil &&
+		string(ref.Group) == egv1a1.GroupVersion.Group &&
+		string(ref.Kind) == egv1a1.KindAuthenticationFilter
+}
+
+// routeAuthentication holds the authentication of the routes of an HTTPRoute
+// rule, translated from the AuthenticationFilter referenced by the rule.
+type routeAuthentication struct {
+	oidc      *ir.OIDC
+	basicAuth *ir.BasicAuth
+	jwt       *ir.JWT
+}
+
+// apply sets the authentication of the IR route.
+func (a *routeAuthentication) apply(irRoute *ir.HTTPRoute) {
+	irRoute.OIDC = a.oidc
+	irRoute.BasicAuth = a.basicAuth
+	irRoute.JWT = a.jwt
+}
+
+// buildRouteAuthentication translates the AuthenticationFilter referenced by an
+// HTTPRoute filter into the authentication of the routes of the rule.
+func (t *Translator) buildRouteAuthentication(namespace string, ref *v1beta1.LocalObjectReference, resources *Resources) (*routeAuthentication, error) {
+	filter := resources.GetAuthenticationFilter(namespace, string(ref.Name))
+	if filter == nil {
+		return nil, errors.New("not found")
+	}
+
+	switch filter.Spec.Type {
+	case egv1a1.OIDCAuthenticationFilterProviderType:
+		oidc, err := buildIROIDC(filter, resources, t.Namespace)
+		if err != nil {
+			return nil, err
+		}
+		return &routeAuthentication{oidc: oidc}, nil
+	case egv1a1.BasicAuthAuthenticationFilterProviderType:
+		basicAuth, err := buildIRBasicAuth(filter, resources)
+		if err != nil {
+			return nil, err
+		}
+		return &routeAuthentication{basicAuth: basicAuth}, nil
+	case egv1a1.JwtAuthenticationFilterProviderType:
+		jwt, err := buildIRJWT(filter, resources)
+		if err != nil {
+			return nil, err
+		}
+		return &routeAuthentication{jwt: jwt}, nil
+	default:
+		return nil, fmt.Errorf("unsupported type %s", filter.Spec.Type)
+	}
+}
+
+// buildIROIDC translates the OIDC provider of the AuthenticationFilter. The
+// cookies are signed with the HMAC secret generated in the namespace of
+// Envoy Gateway, shared by all the Envoy proxies.
+func buildIROIDC(filter *egv1a1.AuthenticationFilter, resources *Resources, egNamespace string) (*ir.OIDC, error) {
+	provider := filter.Spec.OIDC
+	if provider == nil {
+		return nil, errors.New("field oidc must be specified for the OIDC type")
+	}
+
+	clientSecret, err := resolveAuthenticationFilterSecret(filter, provider.ClientSecret, oidcClientSecretKey, resources)
+	if err != nil {
+		return nil, err
+	}
+
+	authorizationEndpoint, tokenEndpoint, err := resolveOIDCEndpoints(provider, resources)
+	if err != nil {
+		return nil, err
+	}
+
+	hmacSecret, err := resolveOIDCHMACSecret(egNamespace, resources)
+	if err != nil {
+		return nil, err
+	}
+
+	name := authenticationFilterIRName(filter)
+	redirectURL := oidcDefaultRedirectURL
+	if provider.RedirectURL != nil {
+		redirectURL = *provider.RedirectURL
+	}
+
+	irOIDC := &ir.OIDC{
+		Name:                  name,
+		AuthorizationEndpoint: authorizationEndpoint,
+		TokenEndpoint:         tokenEndpoint,
+		ClientID:              provider.ClientID,
+		ClientSecret:          clientSecret,
+		HMACSecret:            hmacSecret,
+		RedirectURL:           redirectURL,
+		RedirectPath:          urlPath(redirectURL),
+		LogoutPath:            oidcDefaultLogoutPath,
+		Scopes:                oidcScopes(provider.Scopes),
+		ForwardAccessToken:    provider.ForwardAccessToken != nil && *provider.ForwardAccessToken,
+	}
+	if provider.LogoutPath != nil {
+		irOIDC.LogoutPath = *provider.LogoutPath
+	}
+	if cookie := provider.Cookie; cookie != nil {
+		if cookie.AccessTokenName != nil {
+			irOIDC.AccessTokenCookie = *cookie.AccessTokenName
+		}
+		if cookie.HMACName != nil {
+			irOIDC.HMACCookie = *cookie.HMACName
+		}
+		if cookie.ExpiresName != nil {
+			irOIDC.ExpiresCookie = *cookie.ExpiresName
+		}
+	}
+
+	if err := irOIDC.Validate(); err != nil {
+		return nil, err
+	}
+	return irOIDC, nil
+}
+
+// buildIRBasicAuth translates the HTTP basic authentication provider of the
+// AuthenticationFilter, parsing the users of its htpasswd Secret.
+func buildIRBasicAuth(filter *egv1a1.AuthenticationFilter, resources *Resources) (*ir.BasicAuth, error) {
+	provider := filter.Spec.BasicAuth
+	if provider == nil {
+		return nil, errors.New("field basicAuth must be specified for the BasicAuth type")
+	}
+
+	htpasswd, err := resolveAuthenticationFilterSecret(filter, provider.Users, basicAuthUsersKey, resources)
+	if err != nil {
+		return nil, err
+	}
+
+	irBasicAuth := &ir.BasicAuth{Name: authenticationFilterIRName(filter)}
+	for i, line := range strings.Split(string(htpasswd), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		user, hash, found := strings.Cut(line, ":")
+		if !found || user == "" {
+			return nil, fmt.Errorf("invalid htpasswd entry on line %d", i+1)
+		}
+		if !strings.HasPrefix(hash, htpasswdSHAPrefix) {
+			return nil, fmt.Errorf("unsupported password hash for user %s, only SHA1 hashes are supported", user)
+		}
+		passwordSHA1, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(hash, htpasswdSHAPrefix))
+		if err != nil || len(passwordSHA1) != sha1.Size {
+			return nil, fmt.Errorf("invalid SHA1 password hash for user %s", user)
+		}
+		irBasicAuth.Users = append(irBasicAuth.Users, &ir.BasicAuthUser{Name: user, PasswordSHA1: passwordSHA1})
+	}
+	if len(irBasicAuth.Users) == 0 {
+		return nil, errors.New("no users found in the htpasswd secret")
+	}
+
+	if err := irBasicAuth.Validate(); err != nil {
+		return nil, err
+	}
+	return irBasicAuth, nil
+}
+
+// buildIRJWT translates the JWT providers of the AuthenticationFilter, resolving
+// the JSON Web Key Sets held in ConfigMaps and Secrets.
+func buildIRJWT(filter *egv1a1.AuthenticationFilter, resources *Resources) (*ir.JWT, error) {
+	if len(filter.Spec.JwtProviders) == 0 {
+		return nil, errors.New("field jwtProviders must be specified for the JWT type")
+	}
+
+	irJWT := &ir.JWT{Name: authenticationFilterIRName(filter)}
+	for i := range filter.Spec.JwtProviders {
+		provider := &filter.Spec.JwtProviders[i]
+		irProvider := &ir.JWTProvider{
+			Name:         provider.Name,
+			Issuer:       provider.Issuer,
+			Audiences:    provider.Audiences,
+			ForwardToken: provider.ForwardToken != nil && *provider.ForwardToken,
+			ClockSkew:    provider.ClockSkew,
+		}
+
+		switch {
+		case provider.RemoteJWKS != nil && provider.LocalJWKS == nil:
+			irProvider.RemoteJWKS = &ir.RemoteJWKS{URI: provider.RemoteJWKS.URI}
+		case provider.LocalJWKS != nil && provider.RemoteJWKS == nil:
+			jwks, err := resolveLocalJWKS(filter, provider.LocalJWKS, resources)
+			if err != nil {
+				return nil, fmt.Errorf("provider %s: %w", provider.Name, err)
+			}
+			irProvider.LocalJWKS = jwks
+		default:
+			return nil, fmt.Errorf("provider %s: exactly one of remoteJWKS and localJWKS must be specified", provider.Name)
+		}
+
+		for _, claimToHeader := range provider.ClaimToHeaders {
+			irProvider.ClaimToHeaders = append(irProvider.ClaimToHeaders, ir.ClaimToHeader{
+				Header: claimToHeader.Header,
+				Claim:  claimToHeader.Claim,
+			})
+		}
+		if extractFrom := provider.ExtractFrom; extractFrom != nil {
+			for _, header := range extractFrom.Headers {
+				irHeader := ir.JWTHeaderExtractor{Name: header.Name}
+				if header.ValuePrefix != nil {
+					irHeader.ValuePrefix = *header.ValuePrefix
+				}
+				irProvider.FromHeaders = append(irProvider.FromHeaders, irHeader)
+			}
+			irProvider.FromParams = extractFrom.Params
+		}
+
+		irJWT.Providers = append(irJWT.Providers, irProvider)
+	}
+
+	if err := irJWT.Validate(); err != nil {
+		return nil, err
+	}
+	return irJWT, nil
+}
+
+// resolveLocalJWKS returns the JSON Web Key Set held inline, or in the ConfigMap
+// or Secret referenced by the filter, after checking that it is a valid JWKS.
+func resolveLocalJWKS(filter *egv1a1.AuthenticationFilter, localJWKS *egv1a1.LocalJWKS, resources *Resources) (string, error) {
+	var jwks string
+	switch {
+	case localJWKS.Inline != nil && localJWKS.ConfigMapRef == nil && localJWKS.SecretRef == nil:
+		jwks = *localJWKS.Inline
+	case localJWKS.ConfigMapRef != nil && localJWKS.Inline == nil && localJWKS.SecretRef == nil:
+		configMap := resources.GetConfigMap(filter.Namespace, string(localJWKS.ConfigMapRef.Name))
+		if configMap == nil {
+			return "", fmt.Errorf("configmap %s/%s not found", filter.Namespace, localJWKS.ConfigMapRef.Name)
+		}
+		value, ok := configMap.Data[jwksKey]
+		if !ok || value == "" {
+			return "", fmt.Errorf("key %s not found in configmap %s/%s", jwksKey, filter.Namespace, localJWKS.ConfigMapRef.Name)
+		}
+		jwks = value
+	case localJWKS.SecretRef != nil && localJWKS.Inline == nil && localJWKS.ConfigMapRef == nil:
+		value, err := resolveAuthenticationFilterSecret(filter, *localJWKS.SecretRef, jwksKey, resources)
+		if err != nil {
+			return "", err
+		}
+		jwks = string(value)
+	default:
+		return "", errors.New("exactly one of inline, configMapRef and secretRef must be specified for localJWKS")
+	}
+
+	var keySet struct {
+		Keys []json.RawMessage `json:"keys"`
+	}
+	if err := json.Unmarshal([]byte(jwks), &keySet); err != nil || len(keySet.Keys) == 0 {
+		return "", errors.New("invalid JWKS, a JSON document with at least a single key is expected")
+	}
+	return jwks, nil
+}
+
+// authenticationFilterIRName returns the name of the IR authentication of the filter.
+func authenticationFilterIRName(filter *egv1a1.AuthenticationFilter) string {
+	return fmt.Sprintf("authenticationfilter/%s/%s", filter.Namespace, filter.Name)
+}
+
+// resolveAuthenticationFilterSecret returns the value of the key of the Secret
+// referenced by the filter.
+func resolveAuthenticationFilterSecret(filter *egv1a1.AuthenticationFilter, secretRef v1beta1.SecretObjectReference,
+	key string, resources *Resources) ([]byte, error) {
+	secret, err := resolveSecretRef(crossNamespaceFrom{
+		group:     egv1a1.GroupVersion.Group,
+		kind:      egv1a1.KindAuthenticationFilter,
+		namespace: filter.Namespace,
+	}, secretRef, resources)
+	if err != nil {
+		return nil, err
+	}
+	value, ok := secret.Data[key]
+	if !ok || len(value) == 0 {
+		return nil, fmt.Errorf("key %s not found in secret %s/%s", key, secret.Namespace, secret.Name)
+	}
+	return value, nil
+}
+
+// resolveOIDCEndpoints returns the authorization and token endpoints of the
+// OIDC provider, taking those that are not specified from the configuration
+// discovered by the provider from the issuer.
+func resolveOIDCEndpoints(provider *egv1a1.OIDCAuthenticationFilterProvider, resources *Resources) (string, string, error) {
+	if provider.AuthorizationEndpoint != nil && provider.TokenEndpoint != nil {
+		return *provider.AuthorizationEndpoint, *provider.TokenEndpoint, nil
+	}
+
+	discovered := resources.GetOIDCProvider(provider.Issuer)
+	switch {
+	case discovered == nil:
+		return "", "", fmt.Errorf("configuration of the OIDC provider %s not discovered yet", provider.Issuer)
+	case discovered.Error != "":
+		return "", "", errors.New(discovered.Error)
+	}
+	authorizationEndpoint, tokenEndpoint := discovered.AuthorizationEndpoint, discovered.TokenEndpoint
+	if provider.AuthorizationEndpoint != nil {
+		authorizationEndpoint = *provider.AuthorizationEndpoint
+	}
+	if provider.TokenEndpoint != nil {
+		tokenEndpoint = *provider.TokenEndpoint
+	}
+	return authorizationEndpoint, tokenEndpoint, nil
+}
+
+// resolveOIDCHMACSecret returns the HMAC secret signing the cookies of the
+// OIDC authentication, generated along with the certificates of Envoy Gateway.
+func resolveOIDCHMACSecret(egNamespace string, resources *Resources) ([]byte, error) {
+	secret := resources.GetSecret(egNamespace, config.OIDCHMACSecretName)
+	if secret == nil {
+		return nil, fmt.Errorf("secret %s/%s not found", egNamespace, config.OIDCHMACSecretName)
+	}
+	value, ok := secret.Data[config.OIDCHMACSecretKey]
+	if !ok || len(value) == 0 {
+		return nil, fmt.Errorf("key %s not found in secret %s/%s", config.OIDCHMACSecretKey, egNamespace, config.OIDCHMACSecretName)
+	}
+	return value, nil
+}
+
+// oidcScopes returns the scopes requested to an OIDC provider, starting with
+// the openid scope which is always requested.
+func oidcScopes(scopes []string) []string {
+	ret := []string{"openid"}
+	for _, scope := range scopes {
+		if scope != "openid" {
+			ret = append(ret, scope)
+		}
+	}
+	return ret
+}
+
+// urlPath returns the path of the URL. Unlike url.Parse, it accepts URLs
+// containing Envoy command operators such as %REQ(:authority)%.
+func urlPath(u string) string {
+	if i := strings.Index(u, "://"); i >= 0 {
+		u = u[i+len("://"):]
+	}
+	i := strings.Index(u, "/")
+	if i < 0 {
+		return "/"
+	}
+	u = u[i:]
+	if i := strings.IndexAny(u, "?#"); i >= 0 {
+		u = u[:i]
+	}
+	return u
+}
@@ -0,0 +1,114 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package gatewayapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+func TestBuildIROIDC(t *testing.T) {
+	newFilter := func(name string, provider *egv1a1.OIDCAuthenticationFilterProvider) *egv1a1.AuthenticationFilter {
+		provider.ClientID = "client"
+		provider.ClientSecret = v1beta1.SecretObjectReference{Name: "oidc-client"}
+		return &egv1a1.AuthenticationFilter{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+			Spec: egv1a1.AuthenticationFilterSpec{
+				Type: egv1a1.OIDCAuthenticationFilterProviderType,
+				OIDC: provider,
+			},
+		}
+	}
+
+	resources := &Resources{
+		AuthenticationFilters: []*egv1a1.AuthenticationFilter{
+			newFilter("discovered", &egv1a1.OIDCAuthenticationFilterProvider{
+				Issuer: "https://auth.example.com",
+				Scopes: []string{"email", "openid"},
+			}),
+			newFilter("overridden", &egv1a1.OIDCAuthenticationFilterProvider{
+				Issuer:        "https://auth.example.com",
+				TokenEndpoint: StringPtr("https://token.example.com/token"),
+				RedirectURL:   StringPtr("https://www.example.com/callback?from=oidc"),
+				LogoutPath:    StringPtr("/signout"),
+			}),
+			newFilter("unreachable", &egv1a1.OIDCAuthenticationFilterProvider{
+				Issuer: "https://unreachable.example.com",
+			}),
+			newFilter("undiscovered", &egv1a1.OIDCAuthenticationFilterProvider{
+				Issuer: "https://undiscovered.example.com",
+			}),
+		},
+		OIDCProviders: []*OIDCProviderConfig{
+			{
+				Issuer:                "https://auth.example.com",
+				AuthorizationEndpoint: "https://auth.example.com/auth",
+				TokenEndpoint:         "https://auth.example.com/token",
+			},
+			{
+				Issuer: "https://unreachable.example.com",
+				Error:  "failed to retrieve the configuration of the OIDC provider: status 503",
+			},
+		},
+		Secrets: []*v1.Secret{
+			{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "oidc-client"},
+				Data:       map[string][]byte{"client-secret": []byte("secret")},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "envoy-gateway-system", Name: "envoy-oidc-hmac"},
+				Data:       map[string][]byte{"hmac-secret": []byte("hmac")},
+			},
+		},
+	}
+	translator := &Translator{Namespace: "envoy-gateway-system"}
+
+	buildOIDC := func(name string) (*ir.OIDC, error) {
+		authn, err := translator.buildRouteAuthentication("default", &v1beta1.LocalObjectReference{Name: v1beta1.ObjectName(name)}, resources)
+		if err != nil {
+			return nil, err
+		}
+		return authn.oidc, nil
+	}
+
+	oidc, err := buildOIDC("discovered")
+	require.NoError(t, err)
+	require.Equal(t, "https://auth.example.com/auth", oidc.AuthorizationEndpoint)
+	require.Equal(t, "https://auth.example.com/token", oidc.TokenEndpoint)
+	require.Equal(t, []string{"openid", "email"}, oidc.Scopes)
+	require.Equal(t, oidcDefaultRedirectURL, oidc.RedirectURL)
+	require.Equal(t, "/oauth2/callback", oidc.RedirectPath)
+	require.Equal(t, "/logout", oidc.LogoutPath)
+	require.Equal(t, []byte("secret"), oidc.ClientSecret)
+	require.Equal(t, []byte("hmac"), oidc.HMACSecret)
+
+	oidc, err = buildOIDC("overridden")
+	require.NoError(t, err)
+	require.Equal(t, "https://auth.example.com/auth", oidc.AuthorizationEndpoint)
+	require.Equal(t, "https://token.example.com/token", oidc.TokenEndpoint)
+	require.Equal(t, "/callback", oidc.RedirectPath)
+	require.Equal(t, "/signout", oidc.LogoutPath)
+
+	_, err = buildOIDC("unreachable")
+	require.EqualError(t, err, "failed to retrieve the configuration of the OIDC provider: status 503")
+
+	_, err = buildOIDC("undiscovered")
+	require.EqualError(t, err, "configuration of the OIDC provider https://undiscovered.example.com not discovered yet")
+
+	_, err = buildOIDC("unknown")
+	require.EqualError(t, err, "not found")
+
+	resources.Secrets = resources.Secrets[:1]
+	_, err = buildOIDC("discovered")
+	require.EqualError(t, err, "secret envoy-gateway-system/envoy-oidc-hmac not found")
+}
@@ -0,0 +1,170 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package gatewayapi
+
+import (
+	"errors"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	gwapiv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+// ProcessBackendTLSPolicies validates the BackendTLSPolicies, computes their status
+// and sets the TLS configuration of the accepted ones on the destinations of the IR
+// routes forwarding to the Services they target. When several policies target the
+// same Service, the oldest one is accepted.
+func (t *Translator) ProcessBackendTLSPolicies(backendTLSPolicies []*egv1a1.BackendTLSPolicy,
+	resources *Resources, xdsIR XdsIRMap) []*egv1a1.BackendTLSPolicy {
+	var res []*egv1a1.BackendTLSPolicy
+
+	for _, policy := range backendTLSPolicies {
+		res = append(res, policy.DeepCopy())
+	}
+	sortPolicies(res)
+
+	targeted := map[types.NamespacedName]*egv1a1.BackendTLSPolicy{}
+	// The IR destinations are the cluster IPs of the Services.
+	tlsConfigs := map[string]*ir.TLSUpstreamConfig{}
+	for _, policy := range res {
+		if err := validateBackendTLSPolicyTargetRef(policy.Namespace, policy.Spec.TargetRef); err != nil {
+			setPolicyCondition(&policy.Status, policy.Generation, metav1.ConditionFalse, egv1a1.PolicyReasonInvalid,
+				fmt.Sprintf("Invalid targetRef: %s.", err))
+			continue
+		}
+
+		key := types.NamespacedName{Namespace: policy.Namespace, Name: string(policy.Spec.TargetRef.Name)}
+		service := resources.GetService(key.Namespace, key.Name)
+		if service == nil {
+			setPolicyCondition(&policy.Status, policy.Generation, metav1.ConditionFalse, egv1a1.PolicyReasonTargetNotFound,
+				fmt.Sprintf("%s %s not found.", KindService, key))
+			continue
+		}
+
+		// The TLS configuration is looked up by the cluster IP of the destinations,
+		// it cannot be attached to the Services without one, e.g. headless ones.
+		if service.Spec.ClusterIP == "" || service.Spec.ClusterIP == v1.ClusterIPNone {
+			setPolicyCondition(&policy.Status, policy.Generation, metav1.ConditionFalse, egv1a1.PolicyReasonInvalid,
+				fmt.Sprintf("%s %s has no cluster IP, the Services without a cluster IP are not supported.", KindService, key))
+			continue
+		}
+
+		if existing, ok := targeted[key]; ok {
+			setPolicyCondition(&policy.Status, policy.Generation, metav1.ConditionFalse, egv1a1.PolicyReasonConflicted,
+				fmt.Sprintf("%s %s is already targeted by %s %s/%s.",
+					KindService, key, egv1a1.KindBackendTLSPolicy, existing.Namespace, existing.Name))
+			continue
+		}
+
+		tlsConfig, err := buildIRTLSUpstreamConfig(policy, resources)
+		if err != nil {
+			setPolicyCondition(&policy.Status, policy.Generation, metav1.ConditionFalse, egv1a1.PolicyReasonInvalid,
+				fmt.Sprintf("Invalid %s: %s.", egv1a1.KindBackendTLSPolicy, err))
+			continue
+		}
+
+		targeted[key] = policy
+		tlsConfigs[service.Spec.ClusterIP] = tlsConfig
+		setPolicyCondition(&policy.Status, policy.Generation, metav1.ConditionTrue, egv1a1.PolicyReasonAccepted,
+			fmt.Sprintf("%s has been accepted.", egv1a1.KindBackendTLSPolicy))
+	}
+
+	for _, gwXdsIR := range xdsIR {
+		for _, listener := range gwXdsIR.HTTP {
+			for _, irRoute := range listener.Routes {
+				for _, destination := range irRoute.Destinations {
+					if tlsConfig, ok := tlsConfigs[destination.Host]; ok {
+						destination.TLS = tlsConfig
+					}
+				}
+			}
+		}
+	}
+
+	return res
+}
+
+// validateBackendTLSPolicyTargetRef checks that the target reference of a policy in
+// policyNamespace refers to a Service within the same namespace.
+func validateBackendTLSPolicyTargetRef(policyNamespace string, targetRef gwapiv1a2.PolicyTargetReference) error {
+	if targetRef.Group != "" {
+		return fmt.Errorf("group %q is not supported, only the core API group is supported", targetRef.Group)
+	}
+	if string(targetRef.Kind) != KindService {
+		return fmt.Errorf("kind %q is not supported, must be %s", targetRef.Kind, KindService)
+	}
+	if targetRef.Namespace != nil && string(*targetRef.Namespace) != policyNamespace {
+		return fmt.Errorf("namespace %q does not match the namespace of the policy, cross namespace targets are not supported",
+			*targetRef.Namespace)
+	}
+	return nil
+}
+
+// buildIRTLSUpstreamConfig translates the TLS configuration of the policy,
+// resolving its CA and client certificates.
+func buildIRTLSUpstreamConfig(policy *egv1a1.BackendTLSPolicy, resources *Resources) (*ir.TLSUpstreamConfig, error) {
+	tlsConfig := &ir.TLSUpstreamConfig{
+		Name: fmt.Sprintf("backendtlspolicy/%s/%s", policy.Namespace, policy.Name),
+	}
+	if policy.Spec.SNI != nil {
+		tlsConfig.SNI = string(*policy.Spec.SNI)
+	}
+
+	from := crossNamespaceFrom{
+		group:     egv1a1.GroupVersion.Group,
+		kind:      egv1a1.KindBackendTLSPolicy,
+		namespace: policy.Namespace,
+	}
+
+	if caCertificate := policy.Spec.CACertificate; caCertificate != nil {
+		switch {
+		case caCertificate.ConfigMapRef != nil && caCertificate.SecretRef == nil:
+			configMap := resources.GetConfigMap(policy.Namespace, string(caCertificate.ConfigMapRef.Name))
+			if configMap == nil {
+				return nil, fmt.Errorf("configmap %s/%s not found", policy.Namespace, caCertificate.ConfigMapRef.Name)
+			}
+			tlsConfig.CACertificate = configMapData(configMap)[CACertKey]
+			if len(tlsConfig.CACertificate) == 0 {
+				return nil, fmt.Errorf("key %s not found in configmap %s/%s", CACertKey, configMap.Namespace, configMap.Name)
+			}
+		case caCertificate.SecretRef != nil && caCertificate.ConfigMapRef == nil:
+			secret, err := resolveSecretRef(from, *caCertificate.SecretRef, resources)
+			if err != nil {
+				return nil, err
+			}
+			tlsConfig.CACertificate = secret.Data[CACertKey]
+			if len(tlsConfig.CACertificate) == 0 {
+				return nil, fmt.Errorf("key %s not found in secret %s/%s", CACertKey, secret.Namespace, secret.Name)
+			}
+		default:
+			return nil, errors.New("exactly one of configMapRef and secretRef must be specified for caCertificate")
+		}
+	}
+
+	if policy.Spec.ClientCertificateRef != nil {
+		secret, err := resolveSecretRef(from, *policy.Spec.ClientCertificateRef, resources)
+		if err != nil {
+			return nil, err
+		}
+		if secret.Type != v1.SecretTypeTLS {
+			return nil, fmt.Errorf("secret %s/%s must be of type %s", secret.Namespace, secret.Name, v1.SecretTypeTLS)
+		}
+		tlsConfig.ClientCertificate = &ir.TLSCertificate{
+			ServerCertificate: secret.Data[v1.TLSCertKey],
+			PrivateKey:        secret.Data[v1.TLSPrivateKeyKey],
+		}
+		if err := tlsConfig.ClientCertificate.Validate(); err != nil {
+			return nil, fmt.Errorf("secret %s/%s must contain %s and %s", secret.Namespace, secret.Name, v1.TLSCertKey, v1.TLSPrivateKeyKey)
+		}
+	}
+
+	return tlsConfig, nil
+}
@@ -0,0 +1,493 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package gatewayapi
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+// ProcessBackendTrafficPolicies validates the BackendTrafficPolicies, computes their status
+// and applies the accepted ones to the IR routes of their targets. Policies targeting an
+// HTTPRoute take precedence over policies targeting the Gateway the route is attached to.
+func (t *Translator) ProcessBackendTrafficPolicies(backendTrafficPolicies []*egv1a1.BackendTrafficPolicy,
+	gateways []*GatewayContext, httpRoutes []*HTTPRouteContext, xdsIR XdsIRMap) []*egv1a1.BackendTrafficPolicy {
+	var res []*egv1a1.BackendTrafficPolicy
+
+	for _, policy := range backendTrafficPolicies {
+		res = append(res, policy.DeepCopy())
+	}
+	sortPolicies(res)
+
+	attachments := make([]*policyAttachment, 0, len(res))
+	for _, policy := range res {
+		policy := policy
+		attachments = append(attachments, &policyAttachment{
+			policy:      policy,
+			kind:        egv1a1.KindBackendTrafficPolicy,
+			targetRef:   policy.Spec.TargetRef,
+			targetKinds: []string{KindGateway, KindHTTPRoute},
+			status:      &policy.Status,
+			validate:    func() error { return t.validateBackendTrafficPolicy(policy) },
+			apply:       func(irRoute *ir.HTTPRoute) { applyBackendTrafficPolicy(policy, irRoute) },
+		})
+	}
+	attachPolicies(attachments, gateways, httpRoutes, xdsIR)
+
+	return res
+}
+
+// validateBackendTrafficPolicy validates the semantics of the policy that cannot be
+// expressed through the CRD schema.
+func (t *Translator) validateBackendTrafficPolicy(policy *egv1a1.BackendTrafficPolicy) error {
+	irRoute := &ir.HTTPRoute{}
+	applyBackendTrafficPolicy(policy, irRoute)
+	if irRoute.Timeout != nil {
+		if err := irRoute.Timeout.Validate(); err != nil {
+			return err
+		}
+	}
+	if irRoute.Retry != nil {
+		if err := irRoute.Retry.Validate(); err != nil {
+			return err
+		}
+	}
+	if irRoute.HealthCheck != nil {
+		if err := irRoute.HealthCheck.Validate(); err != nil {
+			return err
+		}
+	}
+	if lb := policy.Spec.LoadBalancer; lb != nil {
+		isHashType := lb.Type == egv1a1.RingHashLoadBalancerType || lb.Type == egv1a1.MaglevLoadBalancerType
+		if isHashType && lb.ConsistentHash == nil {
+			return fmt.Errorf("field consistentHash must be specified for the %s load balancer", lb.Type)
+		}
+		if !isHashType && lb.ConsistentHash != nil {
+			return fmt.Errorf("field consistentHash is not supported for the %s load balancer", lb.Type)
+		}
+		if lb.Type != egv1a1.LeastRequestLoadBalancerType && lb.LeastRequest != nil {
+			return fmt.Errorf("field leastRequest is not supported for the %s load balancer", lb.Type)
+		}
+	}
+	if irRoute.LoadBalancer != nil {
+		if err := irRoute.LoadBalancer.Validate(); err != nil {
+			return err
+		}
+	}
+	if rateLimit := policy.Spec.RateLimit; rateLimit != nil {
+		if rateLimit.Type == egv1a1.LocalRateLimitType && rateLimit.Local == nil {
+			return fmt.Errorf("field local must be specified for the %s rate limit", rateLimit.Type)
+		}
+		if rateLimit.Type == egv1a1.GlobalRateLimitType {
+			if rateLimit.Global == nil {
+				return fmt.Errorf("field global must be specified for the %s rate limit", rateLimit.Type)
+			}
+			if !t.GlobalRateLimitEnabled {
+				return fmt.Errorf("the %s rate limit requires the rate limit service to be enabled in the Envoy Gateway configuration", rateLimit.Type)
+			}
+			for _, rule := range rateLimit.Global.Rules {
+				for _, header := range rule.Headers {
+					matchType := RateLimitHeaderMatchTypeDerefOr(header.Type, egv1a1.RateLimitHeaderMatchExact)
+					if matchType != egv1a1.RateLimitHeaderMatchDistinct && header.Value == nil {
+						return fmt.Errorf("field value must be specified for the %s match of header %s", matchType, header.Name)
+					}
+				}
+			}
+		}
+	}
+	if irRoute.RateLimit != nil {
+		if err := irRoute.RateLimit.Validate(); err != nil {
+			return err
+		}
+	}
+	if irRoute.Compression != nil {
+		if err := irRoute.Compression.Validate(); err != nil {
+			return err
+		}
+	}
+	if irRoute.FaultInjection != nil {
+		if err := irRoute.FaultInjection.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func applyBackendTrafficPolicy(policy *egv1a1.BackendTrafficPolicy, irRoute *ir.HTTPRoute) {
+	irRoute.Timeout = buildIRTimeout(policy.Spec.Timeout)
+	irRoute.Retry = buildIRRetry(policy.Spec.Retry)
+	irRoute.HealthCheck = buildIRHealthCheck(policy.Spec.HealthCheck)
+	irRoute.CircuitBreaker = buildIRCircuitBreaker(policy.Spec.CircuitBreaker)
+	irRoute.LoadBalancer = buildIRLoadBalancer(policy.Spec.LoadBalancer)
+	irRoute.RateLimit = buildIRRateLimit(policy.Spec.RateLimit)
+	irRoute.Compression = buildIRCompression(policy)
+	irRoute.FaultInjection = buildIRFaultInjection(policy.Spec.FaultInjection)
+}
+
+func buildIRTimeout(timeout *egv1a1.Timeout) *ir.Timeout {
+	if timeout == nil {
+		return nil
+	}
+
+	return &ir.Timeout{
+		Request: timeout.Request.DeepCopy(),
+		Idle:    timeout.Idle.DeepCopy(),
+		Connect: timeout.Connect.DeepCopy(),
+	}
+}
+
+func buildIRRetry(retry *egv1a1.Retry) *ir.Retry {
+	if retry == nil {
+		return nil
+	}
+
+	irRetry := &ir.Retry{
+		NumRetries: int32ToUint32Ptr(retry.NumRetries),
+	}
+
+	if retry.RetryOn != nil {
+		irRetry.RetryOn = &ir.RetryOn{}
+		for _, trigger := range retry.RetryOn.Triggers {
+			irRetry.RetryOn.Triggers = append(irRetry.RetryOn.Triggers, ir.TriggerEnum(trigger))
+		}
+		for _, code := range retry.RetryOn.HTTPStatusCodes {
+			irRetry.RetryOn.HTTPStatusCodes = append(irRetry.RetryOn.HTTPStatusCodes, uint32(code))
+		}
+	}
+
+	if retry.PerRetry != nil {
+		irRetry.PerRetry = &ir.PerRetryPolicy{
+			Timeout: retry.PerRetry.Timeout.DeepCopy(),
+		}
+		if retry.PerRetry.BackOff != nil {
+			irRetry.PerRetry.BackOff = &ir.BackOffPolicy{
+				BaseInterval: retry.PerRetry.BackOff.BaseInterval.DeepCopy(),
+				MaxInterval:  retry.PerRetry.BackOff.MaxInterval.DeepCopy(),
+			}
+		}
+	}
+
+	if retry.Budget != nil {
+		irRetry.Budget = &ir.RetryBudget{
+			Percent:             int32ToUint32Ptr(retry.Budget.Percent),
+			MinRetryConcurrency: int32ToUint32Ptr(retry.Budget.MinRetryConcurrency),
+		}
+	}
+
+	return irRetry
+}
+
+func buildIRHealthCheck(healthCheck *egv1a1.HealthCheck) *ir.HealthCheck {
+	if healthCheck == nil {
+		return nil
+	}
+
+	irHealthCheck := &ir.HealthCheck{}
+	if active := healthCheck.Active; active != nil {
+		irHealthCheck.Active = &ir.ActiveHealthCheck{
+			Timeout:            active.Timeout.DeepCopy(),
+			Interval:           active.Interval.DeepCopy(),
+			UnhealthyThreshold: int32ToUint32Ptr(active.UnhealthyThreshold),
+			HealthyThreshold:   int32ToUint32Ptr(active.HealthyThreshold),
+		}
+		switch active.Type {
+		case egv1a1.ActiveHealthCheckerTypeHTTP:
+			irHealthCheck.Active.HTTP = &ir.HTTPHealthChecker{}
+			if active.HTTP != nil {
+				irHealthCheck.Active.HTTP.Path = active.HTTP.Path
+				for _, status := range active.HTTP.ExpectedStatuses {
+					irHealthCheck.Active.HTTP.ExpectedStatuses = append(irHealthCheck.Active.HTTP.ExpectedStatuses, uint32(status))
+				}
+			}
+		case egv1a1.ActiveHealthCheckerTypeTCP:
+			irHealthCheck.Active.TCP = &ir.TCPHealthChecker{}
+			if active.TCP != nil {
+				if active.TCP.Send != nil {
+					irHealthCheck.Active.TCP.Send = []byte(*active.TCP.Send)
+				}
+				if active.TCP.Receive != nil {
+					irHealthCheck.Active.TCP.Receive = []byte(*active.TCP.Receive)
+				}
+			}
+		case egv1a1.ActiveHealthCheckerTypeGRPC:
+			irHealthCheck.Active.GRPC = &ir.GRPCHealthChecker{}
+			if active.GRPC != nil && active.GRPC.Service != nil {
+				irHealthCheck.Active.GRPC.Service = StringPtr(*active.GRPC.Service)
+			}
+		}
+	}
+
+	if passive := healthCheck.Passive; passive != nil {
+		irHealthCheck.Passive = &ir.OutlierDetection{
+			Interval:                 passive.Interval.DeepCopy(),
+			Consecutive5xxErrors:     int32ToUint32Ptr(passive.Consecutive5XXErrors),
+			ConsecutiveGatewayErrors: int32ToUint32Ptr(passive.ConsecutiveGatewayErrors),
+			BaseEjectionTime:         passive.BaseEjectionTime.DeepCopy(),
+			MaxEjectionPercent:       int32ToUint32Ptr(passive.MaxEjectionPercent),
+		}
+	}
+
+	return irHealthCheck
+}
+
+func buildIRCircuitBreaker(circuitBreaker *egv1a1.CircuitBreaker) *ir.CircuitBreaker {
+	if circuitBreaker == nil {
+		return nil
+	}
+
+	return &ir.CircuitBreaker{
+		MaxConnections:      int64ToUint32Ptr(circuitBreaker.MaxConnections),
+		MaxPendingRequests:  int64ToUint32Ptr(circuitBreaker.MaxPendingRequests),
+		MaxParallelRequests: int64ToUint32Ptr(circuitBreaker.MaxParallelRequests),
+		MaxParallelRetries:  int64ToUint32Ptr(circuitBreaker.MaxParallelRetries),
+	}
+}
+
+func buildIRLoadBalancer(loadBalancer *egv1a1.LoadBalancer) *ir.LoadBalancer {
+	if loadBalancer == nil {
+		return nil
+	}
+
+	irLoadBalancer := &ir.LoadBalancer{}
+	switch loadBalancer.Type {
+	case egv1a1.RoundRobinLoadBalancerType:
+		irLoadBalancer.RoundRobin = &ir.RoundRobin{}
+	case egv1a1.LeastRequestLoadBalancerType:
+		irLoadBalancer.LeastRequest = &ir.LeastRequest{}
+		if loadBalancer.LeastRequest != nil {
+			irLoadBalancer.LeastRequest.ChoiceCount = int32ToUint32Ptr(loadBalancer.LeastRequest.ChoiceCount)
+		}
+	case egv1a1.RandomLoadBalancerType:
+		irLoadBalancer.Random = &ir.Random{}
+	case egv1a1.RingHashLoadBalancerType, egv1a1.MaglevLoadBalancerType:
+		irLoadBalancer.ConsistentHash = buildIRConsistentHash(loadBalancer.Type, loadBalancer.ConsistentHash)
+	}
+
+	return irLoadBalancer
+}
+
+func buildIRConsistentHash(lbType egv1a1.LoadBalancerType, consistentHash *egv1a1.ConsistentHash) *ir.ConsistentHash {
+	irConsistentHash := &ir.ConsistentHash{
+		Algorithm: ir.RingHashAlgorithm,
+	}
+	if lbType == egv1a1.MaglevLoadBalancerType {
+		irConsistentHash.Algorithm = ir.MaglevAlgorithm
+	}
+	if consistentHash == nil {
+		return irConsistentHash
+	}
+
+	switch consistentHash.Type {
+	case egv1a1.SourceIPConsistentHashType:
+		irConsistentHash.SourceIP = true
+	case egv1a1.HeaderConsistentHashType:
+		irConsistentHash.Header = &ir.HeaderHashPolicy{}
+		if consistentHash.Header != nil {
+			irConsistentHash.Header.Name = consistentHash.Header.Name
+		}
+	case egv1a1.CookieConsistentHashType:
+		irConsistentHash.Cookie = &ir.CookieHashPolicy{}
+		if cookie := consistentHash.Cookie; cookie != nil {
+			irConsistentHash.Cookie.Name = cookie.Name
+			irConsistentHash.Cookie.TTL = cookie.TTL.DeepCopy()
+			if cookie.Path != nil {
+				irConsistentHash.Cookie.Path = StringPtr(*cookie.Path)
+			}
+		}
+	case egv1a1.QueryParamConsistentHashType:
+		irConsistentHash.QueryParam = &ir.QueryParamHashPolicy{}
+		if consistentHash.QueryParam != nil {
+			irConsistentHash.QueryParam.Name = consistentHash.QueryParam.Name
+		}
+	}
+
+	return irConsistentHash
+}
+
+func buildIRRateLimit(rateLimit *egv1a1.RateLimit) *ir.RateLimit {
+	if rateLimit == nil {
+		return nil
+	}
+
+	irRateLimit := &ir.RateLimit{}
+	if rateLimit.Type == egv1a1.LocalRateLimitType && rateLimit.Local != nil {
+		irRateLimit.Local = buildIRLocalRateLimit(rateLimit.Local)
+	}
+	if rateLimit.Type == egv1a1.GlobalRateLimitType && rateLimit.Global != nil {
+		irRateLimit.Global = buildIRGlobalRateLimit(rateLimit.Global)
+	}
+
+	return irRateLimit
+}
+
+func buildIRLocalRateLimit(local *egv1a1.LocalRateLimit) *ir.LocalRateLimit {
+	irLocal := &ir.LocalRateLimit{
+		Default: buildIRRateLimitValue(local.Limit),
+	}
+
+	for _, rule := range local.Rules {
+		irRule := &ir.LocalRateLimitRule{
+			Limit: buildIRRateLimitValue(rule.Limit),
+		}
+		for _, header := range rule.Headers {
+			irRule.HeaderMatches = append(irRule.HeaderMatches, buildIRHeaderMatch(header))
+		}
+		irLocal.Rules = append(irLocal.Rules, irRule)
+	}
+
+	if response := local.Response; response != nil {
+		irLocal.ResponseStatusCode = int32ToUint32Ptr(response.StatusCode)
+		for _, header := range response.Headers {
+			irLocal.ResponseHeaders = append(irLocal.ResponseHeaders, ir.AddHeader{
+				Name:  string(header.Name),
+				Value: header.Value,
+			})
+		}
+	}
+
+	return irLocal
+}
+
+func buildIRGlobalRateLimit(global *egv1a1.GlobalRateLimit) *ir.GlobalRateLimit {
+	irGlobal := &ir.GlobalRateLimit{}
+
+	for _, rule := range global.Rules {
+		irRule := &ir.GlobalRateLimitRule{
+			Limit: buildIRRateLimitValue(rule.Limit),
+		}
+		for _, header := range rule.Headers {
+			irRule.HeaderMatches = append(irRule.HeaderMatches, buildIRRateLimitHeaderMatch(header))
+		}
+		if rule.ClientIP != nil {
+			irRule.ClientIP = &ir.ClientIPMatch{}
+			if rule.ClientIP.CIDR != nil {
+				irRule.ClientIP.CIDR = StringPtr(*rule.ClientIP.CIDR)
+			}
+		}
+		if rule.Path != nil {
+			irRule.PathMatch = buildIRRateLimitPathMatch(*rule.Path)
+		}
+		irGlobal.Rules = append(irGlobal.Rules, irRule)
+	}
+
+	return irGlobal
+}
+
+func buildIRRateLimitHeaderMatch(headerMatch egv1a1.RateLimitHeaderMatch) *ir.StringMatch {
+	irMatch := &ir.StringMatch{Name: headerMatch.Name}
+	switch RateLimitHeaderMatchTypeDerefOr(headerMatch.Type, egv1a1.RateLimitHeaderMatchExact) {
+	case egv1a1.RateLimitHeaderMatchDistinct:
+		irMatch.Distinct = true
+	case egv1a1.RateLimitHeaderMatchRegularExpression:
+		irMatch.SafeRegex = headerMatch.Value
+	default:
+		irMatch.Exact = headerMatch.Value
+	}
+
+	return irMatch
+}
+
+func buildIRRateLimitPathMatch(pathMatch egv1a1.RateLimitPathMatch) *ir.StringMatch {
+	switch PathMatchTypeDerefOr(pathMatch.Type, v1beta1.PathMatchPathPrefix) {
+	case v1beta1.PathMatchExact:
+		return &ir.StringMatch{Exact: StringPtr(pathMatch.Value)}
+	case v1beta1.PathMatchRegularExpression:
+		return &ir.StringMatch{SafeRegex: StringPtr(pathMatch.Value)}
+	default:
+		return &ir.StringMatch{Prefix: StringPtr(pathMatch.Value)}
+	}
+}
+
+func buildIRRateLimitValue(value egv1a1.RateLimitValue) ir.RateLimitValue {
+	irValue := ir.RateLimitValue{
+		Requests: value.Requests,
+		Unit:     ir.RateLimitUnit(value.Unit),
+	}
+	if value.Burst != nil {
+		burst := *value.Burst
+		irValue.Burst = &burst
+	}
+
+	return irValue
+}
+
+// buildIRCompression translates the compression of the policy. It returns nil if
+// the policy does not define any. The routes of the policy share its compression
+// settings, named after the policy.
+func buildIRCompression(policy *egv1a1.BackendTrafficPolicy) *ir.Compression {
+	compression := policy.Spec.Compression
+	if compression == nil {
+		return nil
+	}
+
+	irCompression := &ir.Compression{
+		Name:         fmt.Sprintf("backendtrafficpolicy/%s/%s", policy.Namespace, policy.Name),
+		ContentTypes: compression.ContentTypes,
+	}
+	for _, algorithm := range compression.Algorithms {
+		irCompression.Algorithms = append(irCompression.Algorithms, ir.CompressionAlgorithm(algorithm))
+	}
+	if compression.MinContentLength != nil {
+		minContentLength := *compression.MinContentLength
+		irCompression.MinContentLength = &minContentLength
+	}
+
+	return irCompression
+}
+
+func buildIRFaultInjection(faultInjection *egv1a1.FaultInjection) *ir.FaultInjection {
+	if faultInjection == nil {
+		return nil
+	}
+
+	irFaultInjection := &ir.FaultInjection{}
+	if delay := faultInjection.Delay; delay != nil {
+		irFaultInjection.Delay = &ir.FaultInjectionDelay{
+			FixedDelay: delay.FixedDelay,
+			Percentage: buildIRFaultPercentage(delay.Percentage),
+		}
+	}
+	if abort := faultInjection.Abort; abort != nil {
+		irFaultInjection.Abort = &ir.FaultInjectionAbort{
+			HTTPStatus: int32ToUint32Ptr(abort.HTTPStatus),
+			GRPCStatus: int32ToUint32Ptr(abort.GRPCStatus),
+			Percentage: buildIRFaultPercentage(abort.Percentage),
+		}
+	}
+	for _, header := range faultInjection.Headers {
+		irFaultInjection.HeaderMatches = append(irFaultInjection.HeaderMatches, buildIRHeaderMatch(header))
+	}
+
+	return irFaultInjection
+}
+
+// buildIRFaultPercentage returns the percentage of the requests affected by a
+// fault, all of them by default.
+func buildIRFaultPercentage(percentage *int32) uint32 {
+	if percentage == nil {
+		return 100
+	}
+	return uint32(*percentage)
+}
+
+func buildIRHeaderMatch(headerMatch v1beta1.HTTPHeaderMatch) *ir.StringMatch {
+	if HeaderMatchTypeDerefOr(headerMatch.Type, v1beta1.HeaderMatchExact) == v1beta1.HeaderMatchRegularExpression {
+		return &ir.StringMatch{
+			Name:      string(headerMatch.Name),
+			SafeRegex: StringPtr(headerMatch.Value),
+		}
+	}
+
+	return &ir.StringMatch{
+		Name:  string(headerMatch.Name),
+		Exact: StringPtr(headerMatch.Value),
+	}
+}
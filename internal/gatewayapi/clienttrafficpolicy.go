@@ -0,0 +1,398 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package gatewayapi
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+const (
+	// localReplyBodyKey is the key of the template of the body of local replies,
+	// and of the body of direct responses, in the ConfigMap referenced by a
+	// ClientTrafficPolicy.
+	localReplyBodyKey = "body"
+	// maxDirectResponseBodySize is the maximum size of the body of direct
+	// responses accepted by Envoy in a route configuration.
+	maxDirectResponseBodySize = 4096
+)
+
+// ProcessClientTrafficPolicies validates the ClientTrafficPolicies, computes their status
+// and applies the accepted ones to the IR HTTP listeners and TLS passthrough listeners of
+// their targets. Policies targeting a Gateway listener take precedence over policies
+// targeting the whole Gateway. The settings of the HTTP connections only apply to the HTTP
+// listeners.
+func (t *Translator) ProcessClientTrafficPolicies(clientTrafficPolicies []*egv1a1.ClientTrafficPolicy,
+	gateways []*GatewayContext, tlsRoutes []*TLSRouteContext, resources *Resources, xdsIR XdsIRMap) []*egv1a1.ClientTrafficPolicy {
+	var res []*egv1a1.ClientTrafficPolicy
+
+	for _, policy := range clientTrafficPolicies {
+		res = append(res, policy.DeepCopy())
+	}
+	sortPolicies(res)
+
+	attachments := make([]*policyAttachment, 0, len(res))
+	for _, policy := range res {
+		policy := policy
+		var settings *clientTrafficSettings
+		attachments = append(attachments, &policyAttachment{
+			policy:      policy,
+			kind:        egv1a1.KindClientTrafficPolicy,
+			targetRef:   policy.Spec.TargetRef,
+			targetKinds: []string{KindGateway},
+			status:      &policy.Status,
+			validate: func() error {
+				if err := validateClientTrafficPolicyListener(policy, gateways); err != nil {
+					return err
+				}
+				var err error
+				settings, err = buildClientTrafficSettings(policy, resources)
+				return err
+			},
+			applyHTTPListener: func(irListener *ir.HTTPListener) {
+				irListener.EnableProxyProtocol = enableProxyProtocol(policy)
+				settings.applyToHTTPListener(irListener)
+			},
+			applyTCP: func(irListener *ir.TCPListener) {
+				irListener.EnableProxyProtocol = enableProxyProtocol(policy)
+			},
+		})
+	}
+	attachPolicies(attachments, gateways, nil, xdsIR)
+	attachPoliciesToTCPListeners(attachments, gateways, tlsRoutes, xdsIR)
+
+	return res
+}
+
+// validateClientTrafficPolicyListener checks that a policy targeting a Gateway
+// listener only affects that listener. The HTTP listeners sharing a port without
+// TLS serve the requests with the same connection manager, and the PROXY protocol
+// is enabled on the port of the listener, for all the listeners sharing it. Such
+// settings can only be changed for all these listeners by targeting the Gateway.
+func validateClientTrafficPolicyListener(policy *egv1a1.ClientTrafficPolicy, gateways []*GatewayContext) error {
+	targetRef := policy.Spec.TargetRef
+	if targetRef.SectionName == nil {
+		return nil
+	}
+
+	gateway := findGatewayContext(gateways, policy.Namespace, string(targetRef.Name))
+	listener := gateway.GetListenerContext(*targetRef.SectionName)
+	var sharing, sharingHTTP []string
+	for _, other := range gateway.Spec.Listeners {
+		if other.Name == listener.Name || other.Port != listener.Port {
+			continue
+		}
+		sharing = append(sharing, string(other.Name))
+		if other.Protocol == v1beta1.HTTPProtocolType {
+			sharingHTTP = append(sharingHTTP, string(other.Name))
+		}
+	}
+	if listener.Protocol == v1beta1.HTTPProtocolType && len(sharingHTTP) > 0 {
+		return &policyConflictError{message: fmt.Sprintf(
+			"%s shares port %d with %s, which serve the requests with the same settings, the Gateway must be targeted instead.",
+			policyTargetString(policy.Namespace, targetRef), listener.Port, listenerNamesString(sharingHTTP))}
+	}
+	if len(sharing) > 0 && enableProxyProtocol(policy) {
+		return &policyConflictError{message: fmt.Sprintf(
+			"%s shares port %d with %s, the PROXY protocol can only be enabled for all of them by targeting the Gateway.",
+			policyTargetString(policy.Namespace, targetRef), listener.Port, listenerNamesString(sharing))}
+	}
+
+	return nil
+}
+
+// listenerNamesString returns a human readable list of the listeners.
+func listenerNamesString(names []string) string {
+	if len(names) == 1 {
+		return "listener " + names[0]
+	}
+	return "listeners " + strings.Join(names, ", ")
+}
+
+// enableProxyProtocol returns true if the policy enables the PROXY protocol.
+func enableProxyProtocol(policy *egv1a1.ClientTrafficPolicy) bool {
+	return policy.Spec.EnableProxyProtocol != nil && *policy.Spec.EnableProxyProtocol
+}
+
+// clientTrafficSettings holds the IR settings of the HTTP listeners translated
+// from a ClientTrafficPolicy.
+type clientTrafficSettings struct {
+	clientIPDetection *ir.ClientIPDetectionSettings
+	timeout           *ir.ClientTimeout
+	headers           *ir.HeaderSettings
+	path              *ir.PathSettings
+	http1             *ir.HTTP1Settings
+	localReplies      []*ir.LocalReplyMapper
+	directResponse    *string
+}
+
+// buildClientTrafficSettings translates the settings of the HTTP listeners of
+// the policy, resolving the ConfigMaps it references.
+func buildClientTrafficSettings(policy *egv1a1.ClientTrafficPolicy, resources *Resources) (*clientTrafficSettings, error) {
+	clientIPDetection, err := buildIRClientIPDetection(policy)
+	if err != nil {
+		return nil, err
+	}
+	timeout, err := buildIRClientTimeout(policy)
+	if err != nil {
+		return nil, err
+	}
+	headers, err := buildIRHeaderSettings(policy)
+	if err != nil {
+		return nil, err
+	}
+	http1, err := buildIRHTTP1Settings(policy)
+	if err != nil {
+		return nil, err
+	}
+	localReplies, err := buildIRLocalReplies(policy, resources)
+	if err != nil {
+		return nil, err
+	}
+	directResponse, err := buildDirectResponseBody(policy, resources)
+	if err != nil {
+		return nil, err
+	}
+	return &clientTrafficSettings{
+		clientIPDetection: clientIPDetection,
+		timeout:           timeout,
+		headers:           headers,
+		path:              buildIRPathSettings(policy),
+		http1:             http1,
+		localReplies:      localReplies,
+		directResponse:    directResponse,
+	}, nil
+}
+
+// applyToHTTPListener sets the settings of the IR HTTP listener.
+func (s *clientTrafficSettings) applyToHTTPListener(irListener *ir.HTTPListener) {
+	irListener.ClientIPDetection = s.clientIPDetection.DeepCopy()
+	irListener.Timeout = s.timeout.DeepCopy()
+	irListener.Headers = s.headers.DeepCopy()
+	irListener.Path = s.path.DeepCopy()
+	irListener.HTTP1 = s.http1.DeepCopy()
+	irListener.LocalReplies = nil
+	for _, mapper := range s.localReplies {
+		irListener.LocalReplies = append(irListener.LocalReplies, mapper.DeepCopy())
+	}
+	if s.directResponse == nil {
+		return
+	}
+	// The direct responses may be shared with the routes of other listeners, so
+	// they are replaced instead of being modified.
+	for _, irRoute := range irListener.Routes {
+		if irRoute.DirectResponse != nil {
+			body := *s.directResponse
+			irRoute.DirectResponse = &ir.DirectResponse{
+				Body:       &body,
+				StatusCode: irRoute.DirectResponse.StatusCode,
+			}
+		}
+	}
+}
+
+// buildIRClientIPDetection translates the client IP detection settings of the
+// policy. It returns nil if the policy does not define any.
+func buildIRClientIPDetection(policy *egv1a1.ClientTrafficPolicy) (*ir.ClientIPDetectionSettings, error) {
+	clientIPDetection := policy.Spec.ClientIPDetection
+	if clientIPDetection == nil {
+		return nil, nil
+	}
+
+	for _, cidr := range clientIPDetection.TrustedCIDRs {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			return nil, fmt.Errorf("invalid CIDR %s in clientIPDetection", cidr)
+		}
+	}
+
+	irClientIPDetection := &ir.ClientIPDetectionSettings{
+		TrustedCIDRs: clientIPDetection.TrustedCIDRs,
+	}
+	if xff := clientIPDetection.XForwardedFor; xff != nil && xff.NumTrustedHops != nil {
+		irClientIPDetection.XForwardedForNumTrustedHops = *xff.NumTrustedHops
+	}
+	if customHeader := clientIPDetection.CustomHeader; customHeader != nil {
+		irClientIPDetection.CustomHeader = &ir.CustomHeaderSettings{
+			Name:       customHeader.Name,
+			FailClosed: customHeader.FailClosed != nil && *customHeader.FailClosed,
+		}
+	}
+	if err := irClientIPDetection.Validate(); err != nil {
+		return nil, err
+	}
+	return irClientIPDetection, nil
+}
+
+// buildIRClientTimeout translates the timeouts of the policy. It returns nil if
+// the policy does not define any.
+func buildIRClientTimeout(policy *egv1a1.ClientTrafficPolicy) (*ir.ClientTimeout, error) {
+	timeouts := policy.Spec.Timeouts
+	if timeouts == nil {
+		return nil, nil
+	}
+
+	irTimeout := &ir.ClientTimeout{
+		Request:    timeouts.Request.DeepCopy(),
+		StreamIdle: timeouts.StreamIdle.DeepCopy(),
+		Idle:       timeouts.Idle.DeepCopy(),
+	}
+	if err := irTimeout.Validate(); err != nil {
+		return nil, err
+	}
+	return irTimeout, nil
+}
+
+// buildIRHeaderSettings translates the header settings of the policy. It returns
+// nil if the policy does not define any.
+func buildIRHeaderSettings(policy *egv1a1.ClientTrafficPolicy) (*ir.HeaderSettings, error) {
+	headers := policy.Spec.Headers
+	if headers == nil {
+		return nil, nil
+	}
+
+	irHeaders := &ir.HeaderSettings{}
+	if headers.MaxRequestHeadersKiB != nil {
+		maxRequestHeadersKiB := *headers.MaxRequestHeadersKiB
+		irHeaders.MaxRequestHeadersKiB = &maxRequestHeadersKiB
+	}
+	if headers.ServerHeaderTransformation != nil {
+		irHeaders.ServerHeaderTransformation = ir.ServerHeaderTransformation(*headers.ServerHeaderTransformation)
+	}
+	if err := irHeaders.Validate(); err != nil {
+		return nil, err
+	}
+	return irHeaders, nil
+}
+
+// buildIRPathSettings translates the path settings of the policy. It returns nil
+// if the policy does not define any.
+func buildIRPathSettings(policy *egv1a1.ClientTrafficPolicy) *ir.PathSettings {
+	path := policy.Spec.Path
+	if path == nil {
+		return nil
+	}
+
+	return &ir.PathSettings{
+		Normalize:    path.Normalize != nil && *path.Normalize,
+		MergeSlashes: path.MergeSlashes != nil && *path.MergeSlashes,
+	}
+}
+
+// buildIRHTTP1Settings translates the HTTP/1 settings of the policy. It returns
+// nil if the policy does not define any.
+func buildIRHTTP1Settings(policy *egv1a1.ClientTrafficPolicy) (*ir.HTTP1Settings, error) {
+	http1 := policy.Spec.HTTP1
+	if http1 == nil {
+		return nil, nil
+	}
+
+	irHTTP1 := &ir.HTTP1Settings{
+		PreserveHeaderCase: http1.PreserveHeaderCase != nil && *http1.PreserveHeaderCase,
+		EnableHTTP10:       http1.EnableHTTP10 != nil && *http1.EnableHTTP10,
+	}
+	if http1.DefaultHostForHTTP10 != nil {
+		irHTTP1.DefaultHostForHTTP10 = *http1.DefaultHostForHTTP10
+	}
+	if err := irHTTP1.Validate(); err != nil {
+		return nil, err
+	}
+	return irHTTP1, nil
+}
+
+// buildIRLocalReplies translates the local reply mappers of the policy, resolving
+// the templates held in ConfigMaps.
+func buildIRLocalReplies(policy *egv1a1.ClientTrafficPolicy, resources *Resources) ([]*ir.LocalReplyMapper, error) {
+	var irMappers []*ir.LocalReplyMapper
+	for i := range policy.Spec.LocalReplies {
+		mapper := &policy.Spec.LocalReplies[i]
+		irMapper := &ir.LocalReplyMapper{
+			StatusCode: int32ToUint32Ptr(mapper.StatusCode),
+		}
+		for _, code := range mapper.StatusCodes {
+			irMapper.StatusCodes = append(irMapper.StatusCodes, uint32(code))
+		}
+		if mapper.Body != nil {
+			body, err := buildIRLocalReplyBody(policy, mapper.Body, resources)
+			if err != nil {
+				return nil, err
+			}
+			irMapper.Body = body
+		}
+		if err := irMapper.Validate(); err != nil {
+			return nil, fmt.Errorf("localReplies[%d]: %w", i, err)
+		}
+		irMappers = append(irMappers, irMapper)
+	}
+	return irMappers, nil
+}
+
+// buildIRLocalReplyBody translates the body of a local reply mapper of the policy.
+func buildIRLocalReplyBody(policy *egv1a1.ClientTrafficPolicy, body *egv1a1.LocalReplyBody,
+	resources *Resources) (*ir.LocalReplyBody, error) {
+	irBody := &ir.LocalReplyBody{Format: ir.LocalReplyBodyFormatText}
+	if body.Type != nil {
+		irBody.Format = ir.LocalReplyBodyFormat(*body.Type)
+	}
+	if body.ContentType != nil {
+		irBody.ContentType = *body.ContentType
+	}
+
+	switch {
+	case body.Inline != nil && body.ConfigMapRef == nil:
+		irBody.Template = *body.Inline
+	case body.ConfigMapRef != nil && body.Inline == nil:
+		configMap := resources.GetConfigMap(policy.Namespace, string(body.ConfigMapRef.Name))
+		if configMap == nil {
+			return nil, fmt.Errorf("configmap %s/%s not found", policy.Namespace, body.ConfigMapRef.Name)
+		}
+		value, ok := configMap.Data[localReplyBodyKey]
+		if !ok {
+			return nil, fmt.Errorf("key %s not found in configmap %s/%s", localReplyBodyKey, policy.Namespace, body.ConfigMapRef.Name)
+		}
+		irBody.Template = value
+	default:
+		return nil, errors.New("exactly one of inline and configMapRef must be specified for the body of local replies")
+	}
+	return irBody, nil
+}
+
+// buildDirectResponseBody returns the body of the direct responses of the policy,
+// resolving the ConfigMap holding it. It returns nil if the policy does not
+// define any.
+func buildDirectResponseBody(policy *egv1a1.ClientTrafficPolicy, resources *Resources) (*string, error) {
+	body := policy.Spec.DirectResponseBody
+	if body == nil {
+		return nil, nil
+	}
+
+	var value string
+	switch {
+	case body.Inline != nil && body.ConfigMapRef == nil:
+		value = *body.Inline
+	case body.ConfigMapRef != nil && body.Inline == nil:
+		configMap := resources.GetConfigMap(policy.Namespace, string(body.ConfigMapRef.Name))
+		if configMap == nil {
+			return nil, fmt.Errorf("configmap %s/%s not found", policy.Namespace, body.ConfigMapRef.Name)
+		}
+		var ok bool
+		if value, ok = configMap.Data[localReplyBodyKey]; !ok {
+			return nil, fmt.Errorf("key %s not found in configmap %s/%s", localReplyBodyKey, policy.Namespace, body.ConfigMapRef.Name)
+		}
+	default:
+		return nil, errors.New("exactly one of inline and configMapRef must be specified for the body of direct responses")
+	}
+	if len(value) > maxDirectResponseBodySize {
+		return nil, fmt.Errorf("the body of direct responses exceeds %d bytes", maxDirectResponseBodySize)
+	}
+	return &value, nil
+}
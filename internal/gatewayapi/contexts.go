@@ -17,6 +17,7 @@ import (
 	"sigs.k8s.io/gateway-api/apis/v1beta1"
 
 	egv1alpha1 "github.com/envoyproxy/gateway/api/config/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/ir"
 )
 
 // GatewayContext wraps a Gateway and provides helper methods for
@@ -83,7 +84,15 @@ type ListenerContext struct {
 	gateway           *v1beta1.Gateway
 	listenerStatusIdx int
 	namespaceSelector labels.Selector
-	tlsSecret         *v1.Secret
+	tlsSecrets        []*v1.Secret
+	// tlsParameters are the TLS parameters configured by the TLS options of
+	// the listener.
+	tlsParameters tlsParameters
+	// tlsClientValidation is the validation of the client certificates
+	// configured by the TLS options of the listener.
+	tlsClientValidation *ir.TLSClientValidation
+	// http3 is set when the TLS options of the listener enable HTTP/3.
+	http3 bool
 }
 
 func (l *ListenerContext) SetCondition(conditionType v1beta1.ListenerConditionType, status metav1.ConditionStatus, reason v1beta1.ListenerConditionReason, message string) {
@@ -180,8 +189,12 @@ func (l *ListenerContext) GetConditions() []metav1.Condition {
 	return l.gateway.Status.Listeners[l.listenerStatusIdx].Conditions
 }
 
-func (l *ListenerContext) SetTLSSecret(tlsSecret *v1.Secret) {
-	l.tlsSecret = tlsSecret
+func (l *ListenerContext) SetTLSSecrets(tlsSecrets []*v1.Secret) {
+	l.tlsSecrets = tlsSecrets
+}
+
+func (l *ListenerContext) SetTLSClientValidation(tlsClientValidation *ir.TLSClientValidation) {
+	l.tlsClientValidation = tlsClientValidation
 }
 
 // RouteContext represents a generic Route object (HTTPRoute, TLSRoute, etc.)
@@ -214,6 +227,9 @@ type HTTPRouteContext struct {
 	*v1beta1.HTTPRoute
 
 	parentRefs map[v1beta1.ParentReference]*RouteParentContext
+
+	// irRoutes are the IR routes generated for the HTTPRoute, across all gateways.
+	irRoutes []*ir.HTTPRoute
 }
 
 func (h *HTTPRouteContext) GetRouteType() string {
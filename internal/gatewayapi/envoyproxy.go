@@ -0,0 +1,268 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package gatewayapi
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	egv1alpha1 "github.com/envoyproxy/gateway/api/config/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+// defaultOTLPGRPCPort is the default port of the OTLP gRPC endpoint of the
+// OpenTelemetry collectors.
+const defaultOTLPGRPCPort = 4317
+
+// ProcessEnvoyProxy applies the telemetry configured by the EnvoyProxy to the
+// xds IR of the gateways and computes the status of the EnvoyProxy. Invalid
+// settings are not applied to the IR and are reported on the Accepted condition.
+func (t *Translator) ProcessEnvoyProxy(gateways []*GatewayContext, resources *Resources,
+	xdsIR XdsIRMap) *egv1alpha1.EnvoyProxy {
+	envoyProxy := resources.EnvoyProxy
+	if envoyProxy == nil {
+		return nil
+	}
+	res := envoyProxy.DeepCopy()
+
+	// The settings are the same for all the gateways, only report the errors once.
+	var errs []string
+	seen := map[string]bool{}
+	addError := func(setting string, err error) {
+		msg := fmt.Sprintf("%s: %s", setting, err)
+		if !seen[msg] {
+			seen[msg] = true
+			errs = append(errs, msg)
+		}
+	}
+
+	for _, gateway := range gateways {
+		gwXdsIR := xdsIR[irStringKey(gateway.Gateway)]
+
+		accessLog, err := buildIRAccessLog(envoyProxy, gateway.Gateway)
+		if err != nil {
+			addError("access log", err)
+		}
+		gwXdsIR.AccessLog = accessLog
+
+		tracing, err := buildIRTracing(envoyProxy, gateway.Gateway, resources)
+		if err != nil {
+			addError("tracing", err)
+		}
+		gwXdsIR.Tracing = tracing
+		gwXdsIR.RequestID = buildIRRequestID(envoyProxy)
+	}
+
+	if len(errs) > 0 {
+		setEnvoyProxyCondition(res, metav1.ConditionFalse, egv1alpha1.EnvoyProxyReasonInvalid,
+			fmt.Sprintf("Invalid EnvoyProxy: %s.", strings.Join(errs, "; ")))
+	} else {
+		setEnvoyProxyCondition(res, metav1.ConditionTrue, egv1alpha1.EnvoyProxyReasonAccepted,
+			"EnvoyProxy has been accepted.")
+	}
+	return res
+}
+
+// setEnvoyProxyCondition sets the Accepted condition on the status of the EnvoyProxy.
+func setEnvoyProxyCondition(envoyProxy *egv1alpha1.EnvoyProxy, status metav1.ConditionStatus,
+	reason egv1alpha1.EnvoyProxyConditionReason, message string) {
+	meta.SetStatusCondition(&envoyProxy.Status.Conditions, metav1.Condition{
+		Type:               string(egv1alpha1.EnvoyProxyConditionAccepted),
+		Status:             status,
+		Reason:             string(reason),
+		Message:            message,
+		ObservedGeneration: envoyProxy.Generation,
+		LastTransitionTime: metav1.Now(),
+	})
+}
+
+// buildIRAccessLog returns the access logs of the listeners of the gateway
+// configured by the EnvoyProxy. It returns nil, which keeps the default access
+// log of the listeners, if the EnvoyProxy configures no access log, and an
+// error if it configures an invalid one.
+func buildIRAccessLog(envoyProxy *egv1alpha1.EnvoyProxy, gateway *v1beta1.Gateway) (*ir.AccessLog, error) {
+	telemetry := envoyProxy.Spec.Telemetry
+	if telemetry == nil || telemetry.AccessLog == nil {
+		return nil, nil
+	}
+
+	accessLog := &ir.AccessLog{}
+	if telemetry.AccessLog.Disable {
+		return accessLog, nil
+	}
+	if len(telemetry.AccessLog.Settings) == 0 {
+		return nil, nil
+	}
+
+	for _, setting := range telemetry.AccessLog.Settings {
+		irSetting := &ir.AccessLogSetting{
+			Format: ir.AccessLogFormat(setting.Format.Type),
+			JSON:   setting.Format.JSON,
+			Filter: buildIRAccessLogFilter(setting.Filter),
+		}
+		if setting.Format.Text != nil {
+			irSetting.Text = *setting.Format.Text
+		}
+		for _, sink := range setting.Sinks {
+			irSetting.Sinks = append(irSetting.Sinks, buildIRAccessLogSink(sink, gateway))
+		}
+		accessLog.Settings = append(accessLog.Settings, irSetting)
+	}
+
+	if err := accessLog.Validate(); err != nil {
+		return nil, err
+	}
+	return accessLog, nil
+}
+
+func buildIRAccessLogFilter(filter *egv1alpha1.ProxyAccessLogFilter) *ir.AccessLogFilter {
+	if filter == nil {
+		return nil
+	}
+
+	irFilter := &ir.AccessLogFilter{MinDuration: filter.MinDuration}
+	if filter.MinStatusCode != nil {
+		minStatusCode := uint32(*filter.MinStatusCode)
+		irFilter.MinStatusCode = &minStatusCode
+	}
+	if filter.SamplingPercent != nil {
+		samplingPercent := uint32(*filter.SamplingPercent)
+		irFilter.SamplingPercent = &samplingPercent
+	}
+	return irFilter
+}
+
+// buildIRAccessLogSink returns the sink of the given type, which is left empty
+// if the field of its type is unset.
+func buildIRAccessLogSink(sink egv1alpha1.ProxyAccessLogSink, gateway *v1beta1.Gateway) *ir.AccessLogSink {
+	irSink := &ir.AccessLogSink{}
+	switch sink.Type {
+	case egv1alpha1.ProxyAccessLogSinkTypeFile:
+		if sink.File != nil {
+			irSink.File = &ir.FileAccessLogSink{Path: sink.File.Path}
+		}
+	case egv1alpha1.ProxyAccessLogSinkTypeALS:
+		if sink.ALS != nil {
+			irSink.ALS = &ir.ALSAccessLogSink{
+				Host:    sink.ALS.Host,
+				Port:    uint32(sink.ALS.Port),
+				LogName: fmt.Sprintf("%s/%s", gateway.Namespace, gateway.Name),
+			}
+			if sink.ALS.LogName != nil {
+				irSink.ALS.LogName = *sink.ALS.LogName
+			}
+		}
+	case egv1alpha1.ProxyAccessLogSinkTypeOpenTelemetry:
+		if sink.OpenTelemetry != nil {
+			irSink.OpenTelemetry = &ir.OpenTelemetryAccessLogSink{
+				Host:      sink.OpenTelemetry.Host,
+				Port:      uint32(sink.OpenTelemetry.Port),
+				Resources: sink.OpenTelemetry.Resources,
+			}
+			if irSink.OpenTelemetry.Port == 0 {
+				irSink.OpenTelemetry.Port = defaultOTLPGRPCPort
+			}
+		}
+	}
+	return irSink
+}
+
+// buildIRTracing returns the tracing of the requests of the gateway configured
+// by the EnvoyProxy. It returns nil, which disables the tracing, if the
+// EnvoyProxy configures no tracing, and an error if its collector cannot be
+// resolved or the tracing is invalid.
+func buildIRTracing(envoyProxy *egv1alpha1.EnvoyProxy, gateway *v1beta1.Gateway, resources *Resources) (*ir.Tracing, error) {
+	telemetry := envoyProxy.Spec.Telemetry
+	if telemetry == nil || telemetry.Tracing == nil {
+		return nil, nil
+	}
+	tracing := telemetry.Tracing
+
+	destination, err := resolveServiceBackendRef(tracing.Provider.BackendRef,
+		crossNamespaceFrom{
+			group:     egv1alpha1.GroupVersion.Group,
+			kind:      egv1alpha1.KindEnvoyProxy,
+			namespace: envoyProxy.Namespace,
+		},
+		resources,
+	)
+	if err != nil {
+		return nil, err
+	}
+	destination.Weight = 1
+
+	irTracing := &ir.Tracing{
+		ServiceName:  fmt.Sprintf("%s/%s", gateway.Namespace, gateway.Name),
+		Provider:     ir.TracingProvider(tracing.Provider.Type),
+		Destination:  destination,
+		SamplingRate: 100,
+	}
+	if tracing.SamplingRate != nil {
+		irTracing.SamplingRate = uint32(*tracing.SamplingRate)
+	}
+	// Sort the tags so that the IR does not change between translations.
+	names := make([]string, 0, len(tracing.CustomTags))
+	for name := range tracing.CustomTags {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		irTracing.CustomTags = append(irTracing.CustomTags, buildIRCustomTag(name, tracing.CustomTags[name]))
+	}
+
+	if err := irTracing.Validate(); err != nil {
+		return nil, err
+	}
+	return irTracing, nil
+}
+
+// buildIRCustomTag returns the custom tag of the given type, which has no value
+// if the field of its type is unset.
+func buildIRCustomTag(name string, tag egv1alpha1.CustomTag) *ir.CustomTag {
+	irTag := &ir.CustomTag{Name: name}
+	switch tag.Type {
+	case egv1alpha1.CustomTagTypeLiteral:
+		if tag.Literal != nil {
+			value := tag.Literal.Value
+			irTag.Literal = &value
+		}
+	case egv1alpha1.CustomTagTypeEnvironment:
+		if tag.Environment != nil {
+			irTag.Environment = &ir.CustomTagSource{Name: tag.Environment.Name}
+			if tag.Environment.DefaultValue != nil {
+				irTag.Environment.DefaultValue = *tag.Environment.DefaultValue
+			}
+		}
+	case egv1alpha1.CustomTagTypeRequestHeader:
+		if tag.RequestHeader != nil {
+			irTag.RequestHeader = &ir.CustomTagSource{Name: tag.RequestHeader.Name}
+			if tag.RequestHeader.DefaultValue != nil {
+				irTag.RequestHeader.DefaultValue = *tag.RequestHeader.DefaultValue
+			}
+		}
+	}
+	return irTag
+}
+
+// buildIRRequestID returns the handling of the request IDs configured by the
+// EnvoyProxy.
+func buildIRRequestID(envoyProxy *egv1alpha1.EnvoyProxy) *ir.RequestIDSettings {
+	telemetry := envoyProxy.Spec.Telemetry
+	if telemetry == nil || telemetry.RequestID == nil {
+		return nil
+	}
+
+	return &ir.RequestIDSettings{
+		Disable:          telemetry.RequestID.Disable,
+		PreserveExternal: telemetry.RequestID.PreserveExternal,
+		SetInResponse:    telemetry.RequestID.SetInResponse,
+	}
+}
@@ -11,6 +11,8 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/gateway-api/apis/v1alpha2"
 	"sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
 )
 
 const (
@@ -54,6 +56,24 @@ func Int32Ptr(val int32) *int32 {
 	return &val
 }
 
+// int32ToUint32Ptr converts an optional int32 into an optional uint32.
+func int32ToUint32Ptr(val *int32) *uint32 {
+	if val == nil {
+		return nil
+	}
+	ret := uint32(*val)
+	return &ret
+}
+
+// int64ToUint32Ptr converts an optional int64 into an optional uint32.
+func int64ToUint32Ptr(val *int64) *uint32 {
+	if val == nil {
+		return nil
+	}
+	ret := uint32(*val)
+	return &ret
+}
+
 func PortNumPtr(val int32) *v1beta1.PortNumber {
 	portNum := v1beta1.PortNumber(val)
 	return &portNum
@@ -79,6 +99,20 @@ func PathMatchTypeDerefOr(matchType *v1beta1.PathMatchType, defaultType v1beta1.
 	return defaultType
 }
 
+func RateLimitHeaderMatchTypeDerefOr(matchType *egv1a1.RateLimitHeaderMatchType, defaultType egv1a1.RateLimitHeaderMatchType) egv1a1.RateLimitHeaderMatchType {
+	if matchType != nil {
+		return *matchType
+	}
+	return defaultType
+}
+
+func CORSOriginMatchTypeDerefOr(matchType *egv1a1.CORSOriginMatchType, defaultType egv1a1.CORSOriginMatchType) egv1a1.CORSOriginMatchType {
+	if matchType != nil {
+		return *matchType
+	}
+	return defaultType
+}
+
 func HeaderMatchTypeDerefOr(matchType *v1beta1.HeaderMatchType, defaultType v1beta1.HeaderMatchType) v1beta1.HeaderMatchType {
 	if matchType != nil {
 		return *matchType
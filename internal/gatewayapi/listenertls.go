@@ -0,0 +1,336 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package gatewayapi
+
+import (
+	"fmt"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+// The TLS options of the HTTPS listeners of a Gateway configuring the TLS parameters.
+// The lists are comma separated, in order of preference.
+const (
+	// TLSOptionMinVersion is the minimum TLS protocol version, one of 1.0, 1.1, 1.2 or 1.3.
+	TLSOptionMinVersion v1beta1.AnnotationKey = "gateway.envoyproxy.io/tls-min-version"
+	// TLSOptionMaxVersion is the maximum TLS protocol version, one of 1.0, 1.1, 1.2 or 1.3.
+	TLSOptionMaxVersion v1beta1.AnnotationKey = "gateway.envoyproxy.io/tls-max-version"
+	// TLSOptionCipherSuites lists the cipher suites supported for TLS 1.2 and lower,
+	// e.g. "ECDHE-ECDSA-AES128-GCM-SHA256,ECDHE-RSA-AES128-GCM-SHA256".
+	TLSOptionCipherSuites v1beta1.AnnotationKey = "gateway.envoyproxy.io/tls-cipher-suites"
+	// TLSOptionECDHCurves lists the elliptic curves supported for the key exchange,
+	// e.g. "X25519,P-256".
+	TLSOptionECDHCurves v1beta1.AnnotationKey = "gateway.envoyproxy.io/tls-ecdh-curves"
+	// TLSOptionALPNProtocols lists the application protocols negotiated with the
+	// clients, e.g. "h2,http/1.1" to serve gRPC clients.
+	TLSOptionALPNProtocols v1beta1.AnnotationKey = "gateway.envoyproxy.io/tls-alpn-protocols"
+)
+
+// TLSOptionHTTP3 serves HTTP/3 over QUIC on the UDP port with the number of the
+// HTTPS listener when set to "true". The clients are told about it in the alt-svc
+// header of the HTTP/1.1 and HTTP/2 responses.
+const TLSOptionHTTP3 v1beta1.AnnotationKey = "gateway.envoyproxy.io/http3"
+
+// The TLS options of the HTTPS listeners of a Gateway configuring the validation
+// of the client certificates.
+const (
+	// TLSOptionClientCASecret names the Secret, in the namespace of the Gateway,
+	// holding the CA certificates validating the client certificates.
+	TLSOptionClientCASecret v1beta1.AnnotationKey = "gateway.envoyproxy.io/client-ca-secret"
+	// TLSOptionClientCAConfigMap names the ConfigMap, in the namespace of the
+	// Gateway, holding the CA certificates validating the client certificates.
+	TLSOptionClientCAConfigMap v1beta1.AnnotationKey = "gateway.envoyproxy.io/client-ca-configmap"
+	// TLSOptionClientValidation is either Required, the default, or Optional
+	// to accept the connections of the clients presenting no certificate.
+	TLSOptionClientValidation v1beta1.AnnotationKey = "gateway.envoyproxy.io/client-validation"
+	// TLSOptionClientSubjectAltNames is the comma separated list of the subject
+	// alternative names accepted in the client certificates, each prefixed with
+	// its type, e.g. "DNS:client.example.com,URI:spiffe://example.com/client".
+	TLSOptionClientSubjectAltNames v1beta1.AnnotationKey = "gateway.envoyproxy.io/client-subject-alt-names"
+	// TLSOptionForwardClientCert forwards the details of the client certificates
+	// to the backends in the x-forwarded-client-cert header when set to "true".
+	TLSOptionForwardClientCert v1beta1.AnnotationKey = "gateway.envoyproxy.io/forward-client-cert"
+
+	// CACertKey is the key of the CA certificates in the Secret or ConfigMap.
+	CACertKey = "ca.crt"
+	// CACRLKey is the key of the optional certificate revocation list in the
+	// Secret or ConfigMap.
+	CACRLKey = "ca.crl"
+
+	clientValidationRequired = "Required"
+	clientValidationOptional = "Optional"
+)
+
+// tlsParameters holds the TLS parameters configured by the TLS options of a listener.
+type tlsParameters struct {
+	minVersion    *ir.TLSVersion
+	maxVersion    *ir.TLSVersion
+	ciphers       []string
+	ecdhCurves    []string
+	alpnProtocols []string
+}
+
+// checkTLSParameters resolves the TLS parameters configured by the TLS options
+// of the listener. It returns false after setting the listener conditions if
+// they are invalid.
+func (t *Translator) checkTLSParameters(listener *ListenerContext) bool {
+	options := listener.TLS.Options
+	var parameters tlsParameters
+
+	versions := []struct {
+		key     v1beta1.AnnotationKey
+		version **ir.TLSVersion
+	}{
+		{key: TLSOptionMinVersion, version: &parameters.minVersion},
+		{key: TLSOptionMaxVersion, version: &parameters.maxVersion},
+	}
+	for _, option := range versions {
+		value, ok := options[option.key]
+		if !ok {
+			continue
+		}
+		switch tlsVersion := ir.TLSVersion(strings.TrimSpace(string(value))); tlsVersion {
+		case ir.TLSv10, ir.TLSv11, ir.TLSv12, ir.TLSv13:
+			*option.version = &tlsVersion
+		default:
+			listener.SetCondition(
+				v1beta1.ListenerConditionProgrammed,
+				metav1.ConditionFalse,
+				v1beta1.ListenerReasonInvalid,
+				fmt.Sprintf("TLS option %s must be one of %s, %s, %s or %s.", option.key, ir.TLSv10, ir.TLSv11, ir.TLSv12, ir.TLSv13),
+			)
+			return false
+		}
+	}
+	if parameters.minVersion != nil && parameters.maxVersion != nil && *parameters.maxVersion < *parameters.minVersion {
+		listener.SetCondition(
+			v1beta1.ListenerConditionProgrammed,
+			metav1.ConditionFalse,
+			v1beta1.ListenerReasonInvalid,
+			fmt.Sprintf("TLS option %s must not be lower than %s.", TLSOptionMaxVersion, TLSOptionMinVersion),
+		)
+		return false
+	}
+
+	lists := []struct {
+		key  v1beta1.AnnotationKey
+		list *[]string
+	}{
+		{key: TLSOptionCipherSuites, list: &parameters.ciphers},
+		{key: TLSOptionECDHCurves, list: &parameters.ecdhCurves},
+		{key: TLSOptionALPNProtocols, list: &parameters.alpnProtocols},
+	}
+	for _, option := range lists {
+		value, ok := options[option.key]
+		if !ok {
+			continue
+		}
+		for _, item := range strings.Split(string(value), ",") {
+			item = strings.TrimSpace(item)
+			if item == "" {
+				listener.SetCondition(
+					v1beta1.ListenerConditionProgrammed,
+					metav1.ConditionFalse,
+					v1beta1.ListenerReasonInvalid,
+					fmt.Sprintf("TLS option %s must be a comma separated list without empty items.", option.key),
+				)
+				return false
+			}
+			*option.list = append(*option.list, item)
+		}
+	}
+
+	listener.tlsParameters = parameters
+	return true
+}
+
+// checkHTTP3 resolves the HTTP/3 TLS option of the listener, which requires the
+// UDP port with the number of the listener to be free.
+func (t *Translator) checkHTTP3(listener *ListenerContext) {
+	switch value := listener.TLS.Options[TLSOptionHTTP3]; value {
+	case "", "false":
+		return
+	case "true":
+	default:
+		listener.SetCondition(
+			v1beta1.ListenerConditionProgrammed,
+			metav1.ConditionFalse,
+			v1beta1.ListenerReasonInvalid,
+			fmt.Sprintf("TLS option %s must be true or false.", TLSOptionHTTP3),
+		)
+		return
+	}
+
+	for _, other := range listener.gateway.Spec.Listeners {
+		if other.Protocol == v1beta1.UDPProtocolType && other.Port == listener.Port {
+			listener.SetCondition(
+				v1beta1.ListenerConditionConflicted,
+				metav1.ConditionTrue,
+				v1beta1.ListenerReasonProtocolConflict,
+				fmt.Sprintf("HTTP/3 requires UDP port %d, which is used by listener %s.", listener.Port, other.Name),
+			)
+			return
+		}
+	}
+
+	listener.http3 = true
+}
+
+// checkTLSClientValidation resolves the validation of the client certificates
+// configured by the TLS options of the listener, if any.
+func (t *Translator) checkTLSClientValidation(listener *ListenerContext, resources *Resources) {
+	options := listener.TLS.Options
+	secretName, hasSecret := options[TLSOptionClientCASecret]
+	configMapName, hasConfigMap := options[TLSOptionClientCAConfigMap]
+	if !hasSecret && !hasConfigMap {
+		for _, key := range []v1beta1.AnnotationKey{TLSOptionClientValidation, TLSOptionClientSubjectAltNames, TLSOptionForwardClientCert} {
+			if _, ok := options[key]; ok {
+				listener.SetCondition(
+					v1beta1.ListenerConditionProgrammed,
+					metav1.ConditionFalse,
+					v1beta1.ListenerReasonInvalid,
+					fmt.Sprintf("TLS option %s requires the %s or %s option.", key, TLSOptionClientCASecret, TLSOptionClientCAConfigMap),
+				)
+				return
+			}
+		}
+		return
+	}
+	if hasSecret && hasConfigMap {
+		listener.SetCondition(
+			v1beta1.ListenerConditionProgrammed,
+			metav1.ConditionFalse,
+			v1beta1.ListenerReasonInvalid,
+			fmt.Sprintf("Only one of the TLS options %s or %s must be set.", TLSOptionClientCASecret, TLSOptionClientCAConfigMap),
+		)
+		return
+	}
+
+	validation := &ir.TLSClientValidation{}
+	switch mode := options[TLSOptionClientValidation]; mode {
+	case "", clientValidationRequired:
+	case clientValidationOptional:
+		validation.Optional = true
+	default:
+		listener.SetCondition(
+			v1beta1.ListenerConditionProgrammed,
+			metav1.ConditionFalse,
+			v1beta1.ListenerReasonInvalid,
+			fmt.Sprintf("TLS option %s must be %s or %s.", TLSOptionClientValidation, clientValidationRequired, clientValidationOptional),
+		)
+		return
+	}
+
+	if names := strings.TrimSpace(string(options[TLSOptionClientSubjectAltNames])); names != "" {
+		for _, name := range strings.Split(names, ",") {
+			san, ok := parseSubjectAltName(strings.TrimSpace(name))
+			if !ok {
+				listener.SetCondition(
+					v1beta1.ListenerConditionProgrammed,
+					metav1.ConditionFalse,
+					v1beta1.ListenerReasonInvalid,
+					fmt.Sprintf("Invalid subject alternative name %q in TLS option %s, must be prefixed with DNS:, URI:, EMAIL: or IP:.",
+						strings.TrimSpace(name), TLSOptionClientSubjectAltNames),
+				)
+				return
+			}
+			validation.SubjectAltNames = append(validation.SubjectAltNames, san)
+		}
+	}
+
+	switch forward := options[TLSOptionForwardClientCert]; forward {
+	case "", "false":
+	case "true":
+		validation.ForwardClientCert = true
+	default:
+		listener.SetCondition(
+			v1beta1.ListenerConditionProgrammed,
+			metav1.ConditionFalse,
+			v1beta1.ListenerReasonInvalid,
+			fmt.Sprintf("TLS option %s must be true or false.", TLSOptionForwardClientCert),
+		)
+		return
+	}
+
+	var data map[string][]byte
+	if hasSecret {
+		secret := resources.GetSecret(listener.gateway.Namespace, string(secretName))
+		if secret == nil {
+			listener.SetCondition(
+				v1beta1.ListenerConditionResolvedRefs,
+				metav1.ConditionFalse,
+				v1beta1.ListenerReasonInvalidCertificateRef,
+				fmt.Sprintf("Secret %s/%s does not exist.", listener.gateway.Namespace, secretName),
+			)
+			return
+		}
+		data = secret.Data
+	} else {
+		configMap := resources.GetConfigMap(listener.gateway.Namespace, string(configMapName))
+		if configMap == nil {
+			listener.SetCondition(
+				v1beta1.ListenerConditionResolvedRefs,
+				metav1.ConditionFalse,
+				v1beta1.ListenerReasonInvalidCertificateRef,
+				fmt.Sprintf("ConfigMap %s/%s does not exist.", listener.gateway.Namespace, configMapName),
+			)
+			return
+		}
+		data = configMapData(configMap)
+	}
+
+	validation.CACertificate = data[CACertKey]
+	validation.CRL = data[CACRLKey]
+	if len(validation.CACertificate) == 0 {
+		name := secretName
+		if !hasSecret {
+			name = configMapName
+		}
+		listener.SetCondition(
+			v1beta1.ListenerConditionResolvedRefs,
+			metav1.ConditionFalse,
+			v1beta1.ListenerReasonInvalidCertificateRef,
+			fmt.Sprintf("%s/%s must contain %s.", listener.gateway.Namespace, name, CACertKey),
+		)
+		return
+	}
+
+	listener.SetTLSClientValidation(validation)
+}
+
+// parseSubjectAltName parses a subject alternative name prefixed with its type,
+// e.g. "DNS:client.example.com". It returns false if the name is invalid.
+func parseSubjectAltName(name string) (ir.SubjectAltName, bool) {
+	sanType, value, found := strings.Cut(name, ":")
+	if !found || value == "" {
+		return ir.SubjectAltName{}, false
+	}
+	san := ir.SubjectAltName{Type: ir.SubjectAltNameType(strings.ToUpper(sanType)), Value: value}
+	switch san.Type {
+	case ir.SubjectAltNameDNS, ir.SubjectAltNameURI, ir.SubjectAltNameEmail, ir.SubjectAltNameIP:
+		return san, true
+	default:
+		return ir.SubjectAltName{}, false
+	}
+}
+
+// configMapData returns the data of the ConfigMap, binary or not, keyed the
+// same way as the data of a Secret.
+func configMapData(configMap *v1.ConfigMap) map[string][]byte {
+	data := make(map[string][]byte, len(configMap.Data)+len(configMap.BinaryData))
+	for key, value := range configMap.Data {
+		data[key] = []byte(value)
+	}
+	for key, value := range configMap.BinaryData {
+		data[key] = value
+	}
+	return data
+}
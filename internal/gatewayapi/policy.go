@@ -0,0 +1,343 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package gatewayapi
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+// policyAttachment holds what is needed to attach a policy to the IR routes and listeners of its target.
+type policyAttachment struct {
+	policy    metav1.Object
+	kind      string
+	targetRef egv1a1.PolicyTargetReferenceWithSectionName
+	// targetKinds are the kinds of the resources the policy can target.
+	targetKinds []string
+	status      *egv1a1.PolicyStatus
+	// validate checks the semantics of the policy that cannot be expressed through the CRD schema.
+	// It returns a policyConflictError if the policy conflicts with the other resources of its target.
+	validate func() error
+	// apply applies the policy to an IR route. It is nil for the policies that only apply to listeners.
+	apply func(*ir.HTTPRoute)
+	// applyHTTPListener applies the policy to an IR HTTP listener of the Gateway or Gateway listener
+	// it targets. It is nil for the policies that only apply to routes.
+	applyHTTPListener func(*ir.HTTPListener)
+	// applyTCP applies the policy to an IR TCP listener, see attachPoliciesToTCPListeners.
+	// It is nil for the policies that only apply to routes.
+	applyTCP func(*ir.TCPListener)
+	// merge is true if the policy is also applied to the IR routes and listeners a
+	// policy of higher precedence has been applied to. Its apply functions must then
+	// only set the settings left unset by the policies of higher precedence.
+	merge bool
+	// accepted is set once the policy has been accepted by its target.
+	accepted bool
+}
+
+// policyConflictError reports a policy that cannot apply to its target without
+// affecting other resources, e.g. the other listeners sharing the port of the
+// targeted listener.
+type policyConflictError struct {
+	message string
+}
+
+func (e *policyConflictError) Error() string {
+	return e.message
+}
+
+// attachPolicies validates the policies, computes their status and applies the accepted ones
+// to the IR routes and HTTP listeners of their targets. The policies must be sorted by precedence,
+// see sortPolicies. Policies targeting an HTTPRoute take precedence over policies targeting a
+// listener of the Gateway the route is attached to, which take precedence over policies targeting
+// the Gateway. Only the policy of highest precedence is applied to an IR route or listener, unless
+// the policies merge.
+func attachPolicies(attachments []*policyAttachment, gateways []*GatewayContext, httpRoutes []*HTTPRouteContext, xdsIR XdsIRMap) {
+	targeted := map[string]*policyAttachment{}
+	var gatewayPolicies, listenerPolicies, routePolicies []*policyAttachment
+	for _, a := range attachments {
+		namespace, generation := a.policy.GetNamespace(), a.policy.GetGeneration()
+		if err := validatePolicyTargetRef(namespace, a.targetRef, a.targetKinds...); err != nil {
+			setPolicyCondition(a.status, generation, metav1.ConditionFalse, egv1a1.PolicyReasonInvalid,
+				fmt.Sprintf("Invalid targetRef: %s.", err))
+			continue
+		}
+
+		var found bool
+		switch string(a.targetRef.Kind) {
+		case KindGateway:
+			gateway := findGatewayContext(gateways, namespace, string(a.targetRef.Name))
+			found = gateway != nil && (a.targetRef.SectionName == nil || gatewayHasListener(gateway, *a.targetRef.SectionName))
+		case KindHTTPRoute:
+			found = findHTTPRouteContext(httpRoutes, namespace, string(a.targetRef.Name)) != nil
+		}
+		if !found {
+			setPolicyCondition(a.status, generation, metav1.ConditionFalse, egv1a1.PolicyReasonTargetNotFound,
+				fmt.Sprintf("%s not found.", policyTargetString(namespace, a.targetRef)))
+			continue
+		}
+
+		key := policyTargetKey(namespace, a.targetRef)
+		if existing, ok := targeted[key]; ok {
+			setPolicyCondition(a.status, generation, metav1.ConditionFalse, egv1a1.PolicyReasonConflicted,
+				fmt.Sprintf("%s is already targeted by %s %s/%s.",
+					policyTargetString(namespace, a.targetRef), a.kind, existing.policy.GetNamespace(), existing.policy.GetName()))
+			continue
+		}
+
+		if err := a.validate(); err != nil {
+			var conflict *policyConflictError
+			if errors.As(err, &conflict) {
+				setPolicyCondition(a.status, generation, metav1.ConditionFalse, egv1a1.PolicyReasonConflicted, conflict.message)
+			} else {
+				setPolicyCondition(a.status, generation, metav1.ConditionFalse, egv1a1.PolicyReasonInvalid,
+					fmt.Sprintf("Invalid %s: %s.", a.kind, err))
+			}
+			continue
+		}
+
+		targeted[key] = a
+		a.accepted = true
+		switch {
+		case string(a.targetRef.Kind) == KindHTTPRoute:
+			routePolicies = append(routePolicies, a)
+		case a.targetRef.SectionName != nil:
+			listenerPolicies = append(listenerPolicies, a)
+		default:
+			gatewayPolicies = append(gatewayPolicies, a)
+		}
+		setPolicyCondition(a.status, generation, metav1.ConditionTrue, egv1a1.PolicyReasonAccepted,
+			fmt.Sprintf("%s has been accepted.", a.kind))
+	}
+
+	// Apply the policies targeting HTTPRoutes first, then the ones targeting Gateway
+	// listeners, so that they are not overridden by the ones targeting the Gateways
+	// the routes are attached to.
+	routesWithPolicy := map[*ir.HTTPRoute]bool{}
+	listenersWithPolicy := map[*ir.HTTPListener]bool{}
+	for _, a := range routePolicies {
+		route := findHTTPRouteContext(httpRoutes, a.policy.GetNamespace(), string(a.targetRef.Name))
+		for _, irRoute := range route.irRoutes {
+			a.apply(irRoute)
+			routesWithPolicy[irRoute] = true
+		}
+	}
+	for _, policies := range [][]*policyAttachment{listenerPolicies, gatewayPolicies} {
+		for _, a := range policies {
+			gateway := findGatewayContext(gateways, a.policy.GetNamespace(), string(a.targetRef.Name))
+			if a.apply != nil {
+				for _, irRoute := range irHTTPRoutesForGateway(xdsIR, gateway, a.targetRef.SectionName) {
+					if !routesWithPolicy[irRoute] || a.merge {
+						a.apply(irRoute)
+						routesWithPolicy[irRoute] = true
+					}
+				}
+			}
+			if a.applyHTTPListener != nil {
+				for _, irListener := range irHTTPListenersForGateway(xdsIR, gateway, a.targetRef.SectionName) {
+					if !listenersWithPolicy[irListener] || a.merge {
+						a.applyHTTPListener(irListener)
+						listenersWithPolicy[irListener] = true
+					}
+				}
+			}
+		}
+	}
+}
+
+// attachPoliciesToTCPListeners applies the accepted policies targeting Gateways or Gateway
+// listeners to the IR TCP listeners of the TLSRoutes attached to them, see attachPolicies.
+// Policies targeting a listener take precedence over policies targeting the Gateway.
+func attachPoliciesToTCPListeners(attachments []*policyAttachment, gateways []*GatewayContext, tlsRoutes []*TLSRouteContext, xdsIR XdsIRMap) {
+	listenersWithPolicy := map[*ir.TCPListener]bool{}
+	for _, listenerTarget := range []bool{true, false} {
+		for _, a := range attachments {
+			if !a.accepted || a.applyTCP == nil || string(a.targetRef.Kind) != KindGateway ||
+				(a.targetRef.SectionName != nil) != listenerTarget {
+				continue
+			}
+			gateway := findGatewayContext(gateways, a.policy.GetNamespace(), string(a.targetRef.Name))
+			for _, irListener := range irTCPListenersForGateway(xdsIR, gateway, a.targetRef.SectionName, tlsRoutes) {
+				if !listenersWithPolicy[irListener] || a.merge {
+					a.applyTCP(irListener)
+					listenersWithPolicy[irListener] = true
+				}
+			}
+		}
+	}
+}
+
+// setPolicyCondition sets the Accepted condition on the provided policy status.
+func setPolicyCondition(policyStatus *egv1a1.PolicyStatus, generation int64, status metav1.ConditionStatus,
+	reason egv1a1.PolicyConditionReason, message string) {
+	meta.SetStatusCondition(&policyStatus.Conditions, metav1.Condition{
+		Type:               string(egv1a1.PolicyConditionAccepted),
+		Status:             status,
+		Reason:             string(reason),
+		Message:            message,
+		ObservedGeneration: generation,
+		LastTransitionTime: metav1.Now(),
+	})
+}
+
+// sortPolicies orders policies by creation timestamp, then by namespace/name,
+// so that the oldest policy wins when several policies target the same resource.
+func sortPolicies[T metav1.Object](policies []T) {
+	sort.SliceStable(policies, func(i, j int) bool {
+		ti, tj := policies[i].GetCreationTimestamp(), policies[j].GetCreationTimestamp()
+		if !ti.Equal(&tj) {
+			return ti.Before(&tj)
+		}
+		return types.NamespacedName{Namespace: policies[i].GetNamespace(), Name: policies[i].GetName()}.String() <
+			types.NamespacedName{Namespace: policies[j].GetNamespace(), Name: policies[j].GetName()}.String()
+	})
+}
+
+// validatePolicyTargetRef checks that the target reference of a policy in policyNamespace
+// refers to one of the supported Gateway API kinds within the same namespace, and that
+// a section name is only set for Gateways.
+func validatePolicyTargetRef(policyNamespace string, targetRef egv1a1.PolicyTargetReferenceWithSectionName, kinds ...string) error {
+	if string(targetRef.Group) != v1beta1.GroupName {
+		return fmt.Errorf("group %q is not supported, must be %s", targetRef.Group, v1beta1.GroupName)
+	}
+
+	supported := false
+	for _, kind := range kinds {
+		if string(targetRef.Kind) == kind {
+			supported = true
+			break
+		}
+	}
+	if !supported {
+		return fmt.Errorf("kind %q is not supported, must be one of %s", targetRef.Kind, strings.Join(kinds, ", "))
+	}
+
+	if targetRef.Namespace != nil && string(*targetRef.Namespace) != policyNamespace {
+		return fmt.Errorf("namespace %q does not match the namespace of the policy, cross namespace targets are not supported",
+			*targetRef.Namespace)
+	}
+
+	if targetRef.SectionName != nil && string(targetRef.Kind) != KindGateway {
+		return fmt.Errorf("sectionName is only supported for kind %s", KindGateway)
+	}
+
+	return nil
+}
+
+// policyTargetKey returns a key uniquely identifying the target of a policy in policyNamespace.
+func policyTargetKey(policyNamespace string, targetRef egv1a1.PolicyTargetReferenceWithSectionName) string {
+	key := fmt.Sprintf("%s/%s/%s", targetRef.Kind, policyNamespace, targetRef.Name)
+	if targetRef.SectionName != nil {
+		key += "/" + string(*targetRef.SectionName)
+	}
+	return key
+}
+
+// policyTargetString returns a human readable description of the target of a policy in policyNamespace.
+func policyTargetString(policyNamespace string, targetRef egv1a1.PolicyTargetReferenceWithSectionName) string {
+	if targetRef.SectionName != nil {
+		return fmt.Sprintf("Listener %s of %s %s/%s", *targetRef.SectionName, targetRef.Kind, policyNamespace, targetRef.Name)
+	}
+	return fmt.Sprintf("%s %s/%s", targetRef.Kind, policyNamespace, targetRef.Name)
+}
+
+// gatewayHasListener returns true if the gateway has a listener named sectionName.
+func gatewayHasListener(gateway *GatewayContext, sectionName v1beta1.SectionName) bool {
+	for _, listener := range gateway.Spec.Listeners {
+		if listener.Name == sectionName {
+			return true
+		}
+	}
+
+	return false
+}
+
+// findGatewayContext returns the GatewayContext with the given namespace and name, or nil if not found.
+func findGatewayContext(gateways []*GatewayContext, namespace, name string) *GatewayContext {
+	for _, gateway := range gateways {
+		if gateway.Namespace == namespace && gateway.Name == name {
+			return gateway
+		}
+	}
+
+	return nil
+}
+
+// findHTTPRouteContext returns the HTTPRouteContext with the given namespace and name, or nil if not found.
+func findHTTPRouteContext(routes []*HTTPRouteContext, namespace, name string) *HTTPRouteContext {
+	for _, route := range routes {
+		if route.Namespace == namespace && route.Name == name {
+			return route
+		}
+	}
+
+	return nil
+}
+
+// irHTTPRoutesForGateway returns all IR routes attached to the HTTP listeners of the gateway,
+// or only to the listener named sectionName when it is set.
+func irHTTPRoutesForGateway(xdsIR XdsIRMap, gateway *GatewayContext, sectionName *v1beta1.SectionName) []*ir.HTTPRoute {
+	var routes []*ir.HTTPRoute
+	for _, listener := range irHTTPListenersForGateway(xdsIR, gateway, sectionName) {
+		routes = append(routes, listener.Routes...)
+	}
+
+	return routes
+}
+
+// irHTTPListenersForGateway returns all IR HTTP listeners of the gateway, or only the
+// listener named sectionName when it is set.
+func irHTTPListenersForGateway(xdsIR XdsIRMap, gateway *GatewayContext, sectionName *v1beta1.SectionName) []*ir.HTTPListener {
+	gwXdsIR, ok := xdsIR[irStringKey(gateway.Gateway)]
+	if !ok {
+		return nil
+	}
+	if sectionName == nil {
+		return gwXdsIR.HTTP
+	}
+	if listener := gwXdsIR.GetHTTPListener(irHTTPListenerName(gateway.GetListenerContext(*sectionName))); listener != nil {
+		return []*ir.HTTPListener{listener}
+	}
+
+	return nil
+}
+
+// irTCPListenersForGateway returns all IR TCP listeners of the TLSRoutes attached to the
+// listeners of the gateway, or only to the listener named sectionName when it is set.
+func irTCPListenersForGateway(xdsIR XdsIRMap, gateway *GatewayContext, sectionName *v1beta1.SectionName,
+	tlsRoutes []*TLSRouteContext) []*ir.TCPListener {
+	gwXdsIR, ok := xdsIR[irStringKey(gateway.Gateway)]
+	if !ok {
+		return nil
+	}
+	names := map[string]bool{}
+	for _, listener := range gateway.Spec.Listeners {
+		if sectionName != nil && listener.Name != *sectionName {
+			continue
+		}
+		for _, tlsRoute := range tlsRoutes {
+			names[irTCPListenerName(gateway.GetListenerContext(listener.Name), tlsRoute)] = true
+		}
+	}
+
+	var listeners []*ir.TCPListener
+	for _, listener := range gwXdsIR.TCP {
+		if names[listener.Name] {
+			listeners = append(listeners, listener)
+		}
+	}
+
+	return listeners
+}
@@ -0,0 +1,46 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package gatewayapi
+
+import (
+	"fmt"
+
+	"github.com/envoyproxy/gateway/internal/envoygateway/config"
+	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/provider/utils"
+)
+
+// ProcessGlobalRateLimits points the gateways whose routes have global rate limits
+// to their rate limit service, and sets the descriptors of the rate limit service
+// in the infra IR so that it gets deployed next to the proxy.
+func (t *Translator) ProcessGlobalRateLimits(xdsIR XdsIRMap, infraIR InfraIRMap) {
+	for irKey, gatewayXdsIR := range xdsIR {
+		descriptors := gatewayXdsIR.RateLimitDescriptors()
+		if len(descriptors) == 0 {
+			continue
+		}
+
+		gatewayXdsIR.RateLimitService = &ir.RateLimitService{
+			// Rely on the DNS search domains of the proxy pod rather than
+			// assuming the domain of the cluster.
+			Host:   fmt.Sprintf("%s.%s", rateLimitServiceName(irKey), t.Namespace),
+			Port:   config.RateLimitGRPCPort,
+			Domain: irKey,
+		}
+		if gatewayInfraIR, ok := infraIR[irKey]; ok {
+			gatewayInfraIR.RateLimit = &ir.RateLimitInfra{
+				Domain:      irKey,
+				Descriptors: descriptors,
+			}
+		}
+	}
+}
+
+// rateLimitServiceName returns the name of the Service of the rate limit service
+// managed for the proxy, matching the one created by the infrastructure provider.
+func rateLimitServiceName(proxyName string) string {
+	return fmt.Sprintf("%s-%s", config.EnvoyRateLimitPrefix, utils.GetHashedName(proxyName))
+}
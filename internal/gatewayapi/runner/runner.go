@@ -7,10 +7,14 @@ package runner
 
 import (
 	"context"
+	"errors"
 
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/gateway-api/apis/v1beta1"
 	"sigs.k8s.io/yaml"
 
+	egcfgv1a1 "github.com/envoyproxy/gateway/api/config/v1alpha1"
 	"github.com/envoyproxy/gateway/internal/envoygateway/config"
 	"github.com/envoyproxy/gateway/internal/gatewayapi"
 	"github.com/envoyproxy/gateway/internal/message"
@@ -55,7 +59,9 @@ func (r *Runner) subscribeAndTranslate(ctx context.Context) {
 
 			// Translate and publish IRs.
 			t := &gatewayapi.Translator{
-				GatewayClassName: v1beta1.ObjectName(update.Key),
+				GatewayClassName:       v1beta1.ObjectName(update.Key),
+				Namespace:              r.Namespace,
+				GlobalRateLimitEnabled: r.EnvoyGateway.RateLimit != nil,
 			}
 			// Translate to IR
 			result := t.Translate(val)
@@ -115,6 +121,32 @@ func (r *Runner) subscribeAndTranslate(ctx context.Context) {
 				key := utils.NamespacedName(udpRoute)
 				r.ProviderResources.UDPRouteStatuses.Store(key, udpRoute)
 			}
+			for _, policy := range result.ClientTrafficPolicies {
+				key := utils.NamespacedName(policy)
+				r.ProviderResources.ClientTrafficPolicyStatuses.Store(key, policy)
+			}
+			for _, policy := range result.BackendTrafficPolicies {
+				key := utils.NamespacedName(policy)
+				r.ProviderResources.BackendTrafficPolicyStatuses.Store(key, policy)
+			}
+			for _, policy := range result.BackendTLSPolicies {
+				key := utils.NamespacedName(policy)
+				r.ProviderResources.BackendTLSPolicyStatuses.Store(key, policy)
+			}
+			for _, policy := range result.SecurityPolicies {
+				key := utils.NamespacedName(policy)
+				r.ProviderResources.SecurityPolicyStatuses.Store(key, policy)
+			}
+			if result.EnvoyProxy != nil {
+				accepted := meta.FindStatusCondition(result.EnvoyProxy.Status.Conditions,
+					string(egcfgv1a1.EnvoyProxyConditionAccepted))
+				if accepted != nil && accepted.Status == metav1.ConditionFalse {
+					r.Logger.Error(errors.New(accepted.Message), "invalid envoyproxy, skipped the invalid settings",
+						"namespace", result.EnvoyProxy.Namespace, "name", result.EnvoyProxy.Name)
+				}
+				key := utils.NamespacedName(result.EnvoyProxy)
+				r.ProviderResources.EnvoyProxyStatuses.Store(key, result.EnvoyProxy)
+			}
 		},
 	)
 	r.Logger.Info("shutting down")
@@ -0,0 +1,210 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package gatewayapi
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+// ProcessSecurityPolicies validates the SecurityPolicies, computes their status
+// and applies the accepted ones to the IR routes of their targets. Policies targeting an
+// HTTPRoute take precedence over policies targeting the Gateway the route is attached to,
+// for the settings they define only: a route policy defining CORS keeps the IP filter
+// of the Gateway policy. The IP filters of the policies targeting a Gateway also apply
+// to its TLS passthrough listeners.
+func (t *Translator) ProcessSecurityPolicies(securityPolicies []*egv1a1.SecurityPolicy, gateways []*GatewayContext,
+	httpRoutes []*HTTPRouteContext, tlsRoutes []*TLSRouteContext, resources *Resources, xdsIR XdsIRMap) []*egv1a1.SecurityPolicy {
+	var res []*egv1a1.SecurityPolicy
+
+	for _, policy := range securityPolicies {
+		res = append(res, policy.DeepCopy())
+	}
+	sortPolicies(res)
+
+	attachments := make([]*policyAttachment, 0, len(res))
+	for _, policy := range res {
+		policy := policy
+		var extAuth *ir.ExtAuth
+		var ipFilter *ir.IPFilter
+		var cors *ir.CORS
+		attachments = append(attachments, &policyAttachment{
+			policy:      policy,
+			kind:        egv1a1.KindSecurityPolicy,
+			targetRef:   policy.Spec.TargetRef,
+			targetKinds: []string{KindGateway, KindHTTPRoute},
+			status:      &policy.Status,
+			validate: func() error {
+				var err error
+				if extAuth, err = buildIRExtAuth(policy, resources); err != nil {
+					return err
+				}
+				if ipFilter, err = buildIRIPFilter(policy); err != nil {
+					return err
+				}
+				cors, err = buildIRCORS(policy)
+				return err
+			},
+			apply: func(irRoute *ir.HTTPRoute) {
+				if irRoute.ExtAuth == nil {
+					irRoute.ExtAuth = extAuth
+				}
+				if irRoute.IPFilter == nil {
+					irRoute.IPFilter = ipFilter
+				}
+				if irRoute.CORS == nil {
+					irRoute.CORS = cors
+				}
+			},
+			applyTCP: func(irListener *ir.TCPListener) {
+				if irListener.IPFilter == nil {
+					irListener.IPFilter = ipFilter
+				}
+			},
+			merge: true,
+		})
+	}
+	attachPolicies(attachments, gateways, httpRoutes, xdsIR)
+	attachPoliciesToTCPListeners(attachments, gateways, tlsRoutes, xdsIR)
+
+	return res
+}
+
+// buildIRExtAuth translates the external authorization service of the policy,
+// resolving its backend. It returns nil if the policy does not define any.
+func buildIRExtAuth(policy *egv1a1.SecurityPolicy, resources *Resources) (*ir.ExtAuth, error) {
+	extAuth := policy.Spec.ExtAuth
+	if extAuth == nil {
+		return nil, nil
+	}
+
+	irExtAuth := &ir.ExtAuth{
+		Name:     fmt.Sprintf("securitypolicy/%s/%s", policy.Namespace, policy.Name),
+		Timeout:  extAuth.Timeout,
+		FailOpen: extAuth.FailOpen != nil && *extAuth.FailOpen,
+	}
+	switch {
+	case extAuth.GRPC != nil && extAuth.HTTP != nil:
+		return nil, errors.New("only one of the fields grpc or http must be specified for extAuth")
+	case extAuth.GRPC != nil:
+		destination, err := buildExtAuthDestination(extAuth.GRPC.BackendRef, policy, resources)
+		if err != nil {
+			return nil, err
+		}
+		irExtAuth.GRPC = &ir.GRPCExtAuthService{Destination: destination}
+	case extAuth.HTTP != nil:
+		destination, err := buildExtAuthDestination(extAuth.HTTP.BackendRef, policy, resources)
+		if err != nil {
+			return nil, err
+		}
+		irExtAuth.HTTP = &ir.HTTPExtAuthService{
+			Destination:      destination,
+			HeadersToExtAuth: extAuth.HTTP.HeadersToExtAuth,
+			HeadersToBackend: extAuth.HTTP.HeadersToBackend,
+			HeadersToClient:  extAuth.HTTP.HeadersToClient,
+		}
+		if extAuth.HTTP.Path != nil {
+			irExtAuth.HTTP.Path = *extAuth.HTTP.Path
+		}
+	default:
+		return nil, errors.New("one of the fields grpc or http must be specified for extAuth")
+	}
+
+	if err := irExtAuth.Validate(); err != nil {
+		return nil, err
+	}
+	return irExtAuth, nil
+}
+
+// buildIRIPFilter translates the IP filter of the policy. It returns nil if the
+// policy does not define any.
+func buildIRIPFilter(policy *egv1a1.SecurityPolicy) (*ir.IPFilter, error) {
+	ipFilter := policy.Spec.IPFilter
+	if ipFilter == nil {
+		return nil, nil
+	}
+
+	if len(ipFilter.Allow) == 0 && len(ipFilter.Deny) == 0 {
+		return nil, errors.New("at least one of the fields allow or deny must be specified for ipFilter")
+	}
+	for _, cidr := range append(append([]string{}, ipFilter.Allow...), ipFilter.Deny...) {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			return nil, fmt.Errorf("invalid CIDR %s in ipFilter", cidr)
+		}
+	}
+
+	irIPFilter := &ir.IPFilter{
+		Allow:            ipFilter.Allow,
+		Deny:             ipFilter.Deny,
+		UseXForwardedFor: ipFilter.ClientIPSource != nil && *ipFilter.ClientIPSource == egv1a1.ClientIPSourceXForwardedFor,
+	}
+	if err := irIPFilter.Validate(); err != nil {
+		return nil, err
+	}
+	return irIPFilter, nil
+}
+
+// buildIRCORS translates the CORS policy of the policy. It returns nil if the
+// policy does not define any.
+func buildIRCORS(policy *egv1a1.SecurityPolicy) (*ir.CORS, error) {
+	cors := policy.Spec.CORS
+	if cors == nil {
+		return nil, nil
+	}
+
+	irCORS := &ir.CORS{
+		AllowMethods:     cors.AllowMethods,
+		AllowHeaders:     cors.AllowHeaders,
+		ExposeHeaders:    cors.ExposeHeaders,
+		MaxAge:           cors.MaxAge,
+		AllowCredentials: cors.AllowCredentials != nil && *cors.AllowCredentials,
+	}
+	for _, origin := range cors.AllowOrigins {
+		switch CORSOriginMatchTypeDerefOr(origin.Type, egv1a1.CORSOriginMatchExact) {
+		case egv1a1.CORSOriginMatchPrefix:
+			irCORS.AllowOrigins = append(irCORS.AllowOrigins, &ir.StringMatch{Prefix: StringPtr(origin.Value)})
+		case egv1a1.CORSOriginMatchRegularExpression:
+			if _, err := regexp.Compile(origin.Value); err != nil {
+				return nil, fmt.Errorf("invalid regular expression %s in cors allowOrigins", origin.Value)
+			}
+			irCORS.AllowOrigins = append(irCORS.AllowOrigins, &ir.StringMatch{SafeRegex: StringPtr(origin.Value)})
+		default:
+			irCORS.AllowOrigins = append(irCORS.AllowOrigins, &ir.StringMatch{Exact: StringPtr(origin.Value)})
+		}
+	}
+
+	if err := irCORS.Validate(); err != nil {
+		return nil, err
+	}
+	return irCORS, nil
+}
+
+// buildExtAuthDestination resolves the backend of an external authorization service,
+// applying the same rules as the backends of HTTPRoutes, see resolveServiceBackendRef.
+func buildExtAuthDestination(backendRef v1beta1.BackendObjectReference, policy *egv1a1.SecurityPolicy,
+	resources *Resources) (*ir.RouteDestination, error) {
+	destination, err := resolveServiceBackendRef(backendRef,
+		crossNamespaceFrom{
+			group:     egv1a1.GroupVersion.Group,
+			kind:      egv1a1.KindSecurityPolicy,
+			namespace: policy.Namespace,
+		},
+		resources,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	destination.Weight = 1
+	return destination, nil
+}
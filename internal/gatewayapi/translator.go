@@ -6,6 +6,7 @@
 package gatewayapi
 
 import (
+	"errors"
 	"fmt"
 	"net/netip"
 	"strings"
@@ -17,6 +18,8 @@ import (
 	"sigs.k8s.io/gateway-api/apis/v1alpha2"
 	"sigs.k8s.io/gateway-api/apis/v1beta1"
 
+	egv1alpha1 "github.com/envoyproxy/gateway/api/config/v1alpha1"
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
 	"github.com/envoyproxy/gateway/internal/ir"
 )
 
@@ -58,6 +61,21 @@ type Resources struct {
 	Namespaces      []*v1.Namespace
 	Services        []*v1.Service
 	Secrets         []*v1.Secret
+	ConfigMaps      []*v1.ConfigMap
+
+	ClientTrafficPolicies  []*egv1a1.ClientTrafficPolicy
+	BackendTrafficPolicies []*egv1a1.BackendTrafficPolicy
+	BackendTLSPolicies     []*egv1a1.BackendTLSPolicy
+	SecurityPolicies       []*egv1a1.SecurityPolicy
+	AuthenticationFilters  []*egv1a1.AuthenticationFilter
+
+	// OIDCProviders holds the configurations discovered by the provider from
+	// the issuers of the OIDC AuthenticationFilters.
+	OIDCProviders []*OIDCProviderConfig
+
+	// EnvoyProxy is the configuration of the proxies of the GatewayClass,
+	// referenced by its parameters.
+	EnvoyProxy *egv1alpha1.EnvoyProxy
 }
 
 func (r *Resources) GetNamespace(name string) *v1.Namespace {
@@ -90,6 +108,50 @@ func (r *Resources) GetSecret(namespace, name string) *v1.Secret {
 	return nil
 }
 
+func (r *Resources) GetConfigMap(namespace, name string) *v1.ConfigMap {
+	for _, configMap := range r.ConfigMaps {
+		if configMap.Namespace == namespace && configMap.Name == name {
+			return configMap
+		}
+	}
+
+	return nil
+}
+
+func (r *Resources) GetAuthenticationFilter(namespace, name string) *egv1a1.AuthenticationFilter {
+	for _, filter := range r.AuthenticationFilters {
+		if filter.Namespace == namespace && filter.Name == name {
+			return filter
+		}
+	}
+
+	return nil
+}
+
+func (r *Resources) GetOIDCProvider(issuer string) *OIDCProviderConfig {
+	for _, provider := range r.OIDCProviders {
+		if provider.Issuer == issuer {
+			return provider
+		}
+	}
+
+	return nil
+}
+
+// OIDCProviderConfig holds the endpoints published by an OIDC provider, as
+// defined by OpenID Connect Discovery 1.0.
+// +k8s:deepcopy-gen=true
+type OIDCProviderConfig struct {
+	// Issuer of the OIDC provider, as specified by the AuthenticationFilters.
+	Issuer string
+	// AuthorizationEndpoint is the URL of the login page of the provider.
+	AuthorizationEndpoint string
+	// TokenEndpoint is the URL from which the tokens are retrieved.
+	TokenEndpoint string
+	// Error is the reason the configuration could not be discovered, if any.
+	Error string
+}
+
 // Translator translates Gateway API resources to IRs and computes status
 // for Gateway API resources.
 type Translator struct {
@@ -101,15 +163,28 @@ type Translator struct {
 	// the Infra IR. If unspecified, the default proxy
 	// image will be used.
 	ProxyImage string
+
+	// Namespace is the namespace that Envoy Gateway runs in,
+	// where the managed rate limit service is deployed.
+	Namespace string
+
+	// GlobalRateLimitEnabled is true if the rate limit service
+	// enforcing the global rate limits is configured.
+	GlobalRateLimitEnabled bool
 }
 
 type TranslateResult struct {
-	Gateways   []*v1beta1.Gateway
-	HTTPRoutes []*v1beta1.HTTPRoute
-	TLSRoutes  []*v1alpha2.TLSRoute
-	UDPRoutes  []*v1alpha2.UDPRoute
-	XdsIR      XdsIRMap
-	InfraIR    InfraIRMap
+	Gateways               []*v1beta1.Gateway
+	HTTPRoutes             []*v1beta1.HTTPRoute
+	TLSRoutes              []*v1alpha2.TLSRoute
+	UDPRoutes              []*v1alpha2.UDPRoute
+	ClientTrafficPolicies  []*egv1a1.ClientTrafficPolicy
+	BackendTrafficPolicies []*egv1a1.BackendTrafficPolicy
+	BackendTLSPolicies     []*egv1a1.BackendTLSPolicy
+	SecurityPolicies       []*egv1a1.SecurityPolicy
+	EnvoyProxy             *egv1alpha1.EnvoyProxy
+	XdsIR                  XdsIRMap
+	InfraIR                InfraIRMap
 }
 
 type ProtocolPort struct {
@@ -151,6 +226,9 @@ func (t *Translator) Translate(resources *Resources) *TranslateResult {
 	// Process all Listeners for all relevant Gateways.
 	t.ProcessListeners(gateways, xdsIR, infraIR, resources)
 
+	// Apply the telemetry configured by the EnvoyProxy to the Gateways.
+	envoyProxy := t.ProcessEnvoyProxy(gateways, resources, xdsIR)
+
 	// Process all relevant HTTPRoutes.
 	httpRoutes := t.ProcessHTTPRoutes(resources.HTTPRoutes, gateways, resources, xdsIR)
 
@@ -160,10 +238,32 @@ func (t *Translator) Translate(resources *Resources) *TranslateResult {
 	// Process all relevant UDPRoutes.
 	udpRoutes := t.ProcessUDPRoutes(resources.UDPRoutes, gateways, resources, xdsIR)
 
+	// Process all ClientTrafficPolicies and apply them to the HTTP and TLS passthrough listeners.
+	clientTrafficPolicies := t.ProcessClientTrafficPolicies(resources.ClientTrafficPolicies, gateways, tlsRoutes, resources, xdsIR)
+
+	// Process all BackendTrafficPolicies and apply them to the HTTP routes.
+	backendTrafficPolicies := t.ProcessBackendTrafficPolicies(resources.BackendTrafficPolicies, gateways, httpRoutes, xdsIR)
+
+	// Process all BackendTLSPolicies and apply them to the destinations of the HTTP routes.
+	backendTLSPolicies := t.ProcessBackendTLSPolicies(resources.BackendTLSPolicies, resources, xdsIR)
+
+	// Process all SecurityPolicies and apply them to the HTTP routes and TLS passthrough listeners.
+	securityPolicies := t.ProcessSecurityPolicies(resources.SecurityPolicies, gateways, httpRoutes, tlsRoutes, resources, xdsIR)
+
+	// Process the global rate limits of the HTTP routes.
+	t.ProcessGlobalRateLimits(xdsIR, infraIR)
+
 	// Sort xdsIR based on the Gateway API spec
 	sortXdsIRMap(xdsIR)
 
-	return newTranslateResult(gateways, httpRoutes, tlsRoutes, udpRoutes, xdsIR, infraIR)
+	translateResult := newTranslateResult(gateways, httpRoutes, tlsRoutes, udpRoutes, xdsIR, infraIR)
+	translateResult.ClientTrafficPolicies = clientTrafficPolicies
+	translateResult.BackendTrafficPolicies = backendTrafficPolicies
+	translateResult.BackendTLSPolicies = backendTLSPolicies
+	translateResult.SecurityPolicies = securityPolicies
+	translateResult.EnvoyProxy = envoyProxy
+
+	return translateResult
 }
 
 func (t *Translator) GetRelevantGateways(gateways []*v1beta1.Gateway) []*GatewayContext {
@@ -219,6 +319,9 @@ func (t *Translator) ProcessListeners(gateways []*GatewayContext, xdsIR XdsIRMap
 		if len(t.ProxyImage) > 0 {
 			gwInfraIR.Proxy.Image = t.ProxyImage
 		}
+		if resources.EnvoyProxy != nil {
+			gwInfraIR.Proxy.Config = resources.EnvoyProxy.DeepCopy()
+		}
 
 		// save the IR references in the map before the translation starts
 		xdsIR[irKey] = gwXdsIR
@@ -270,7 +373,10 @@ func (t *Translator) ProcessListeners(gateways []*GatewayContext, xdsIR XdsIRMap
 					Name:    irHTTPListenerName(listener),
 					Address: "0.0.0.0",
 					Port:    uint32(containerPort),
-					TLS:     irTLSConfig(listener.tlsSecret),
+					TLS:     irTLSConfig(listener),
+				}
+				if listener.http3 {
+					irListener.HTTP3 = &ir.HTTP3Settings{AdvertisedPort: uint32(listener.Port)}
 				}
 				if listener.Hostname != nil {
 					irListener.Hostnames = append(irListener.Hostnames, string(*listener.Hostname))
@@ -307,6 +413,19 @@ func (t *Translator) ProcessListeners(gateways []*GatewayContext, xdsIR XdsIRMap
 				// Only 1 listener is supported.
 				gwInfraIR.Proxy.Listeners[0].Ports = append(gwInfraIR.Proxy.Listeners[0].Ports, infraPort)
 			}
+
+			// HTTP/3 is served on the UDP port with the same number.
+			http3Port := &ProtocolPort{protocol: v1beta1.UDPProtocolType, port: servicePort.port}
+			if listener.http3 && !containsPort(foundPorts, http3Port) {
+				foundPorts = append(foundPorts, http3Port)
+				infraPort := ir.ListenerPort{
+					Name:          string(listener.Name) + "-http3",
+					Protocol:      ir.HTTP3ProtocolType,
+					ServicePort:   servicePort.port,
+					ContainerPort: containerPort,
+				}
+				gwInfraIR.Proxy.Listeners[0].Ports = append(gwInfraIR.Proxy.Listeners[0].Ports, infraPort)
+			}
 		}
 	}
 }
@@ -403,100 +522,38 @@ func (t *Translator) checkTLS(listener *ListenerContext, resources *Resources) {
 			break
 		}
 
-		if len(listener.TLS.CertificateRefs) != 1 {
+		if len(listener.TLS.CertificateRefs) == 0 {
 			listener.SetCondition(
 				v1beta1.ListenerConditionProgrammed,
 				metav1.ConditionFalse,
 				v1beta1.ListenerReasonInvalid,
-				"Listener must have exactly 1 TLS certificate ref",
-			)
-			break
-		}
-
-		certificateRef := listener.TLS.CertificateRefs[0]
-
-		if certificateRef.Group != nil && string(*certificateRef.Group) != "" {
-			listener.SetCondition(
-				v1beta1.ListenerConditionResolvedRefs,
-				metav1.ConditionFalse,
-				v1beta1.ListenerReasonInvalidCertificateRef,
-				"Listener's TLS certificate ref group must be unspecified/empty.",
+				"Listener must have at least 1 TLS certificate ref",
 			)
 			break
 		}
 
-		if certificateRef.Kind != nil && string(*certificateRef.Kind) != KindSecret {
-			listener.SetCondition(
-				v1beta1.ListenerConditionResolvedRefs,
-				metav1.ConditionFalse,
-				v1beta1.ListenerReasonInvalidCertificateRef,
-				fmt.Sprintf("Listener's TLS certificate ref kind must be %s.", KindSecret),
-			)
-			break
-		}
-
-		secretNamespace := listener.gateway.Namespace
-
-		if certificateRef.Namespace != nil && string(*certificateRef.Namespace) != "" && string(*certificateRef.Namespace) != listener.gateway.Namespace {
-			if !isValidCrossNamespaceRef(
-				crossNamespaceFrom{
-					group:     string(v1beta1.GroupName),
-					kind:      KindGateway,
-					namespace: listener.gateway.Namespace,
-				},
-				crossNamespaceTo{
-					group:     "",
-					kind:      KindSecret,
-					namespace: string(*certificateRef.Namespace),
-					name:      string(certificateRef.Name),
-				},
-				resources.ReferenceGrants,
-			) {
-				listener.SetCondition(
-					v1beta1.ListenerConditionResolvedRefs,
-					metav1.ConditionFalse,
-					v1beta1.ListenerReasonRefNotPermitted,
-					fmt.Sprintf("Certificate ref to secret %s/%s not permitted by any ReferenceGrant", *certificateRef.Namespace, certificateRef.Name),
-				)
+		// Several certificates, e.g. an ECDSA and an RSA one, may be served
+		// by the listener. All of them must be resolved.
+		secrets := make([]*v1.Secret, 0, len(listener.TLS.CertificateRefs))
+		for _, certificateRef := range listener.TLS.CertificateRefs {
+			secret := t.resolveTLSCertificateRef(listener, certificateRef, resources)
+			if secret == nil {
 				break
 			}
-
-			secretNamespace = string(*certificateRef.Namespace)
+			secrets = append(secrets, secret)
 		}
-
-		secret := resources.GetSecret(secretNamespace, string(certificateRef.Name))
-
-		if secret == nil {
-			listener.SetCondition(
-				v1beta1.ListenerConditionResolvedRefs,
-				metav1.ConditionFalse,
-				v1beta1.ListenerReasonInvalidCertificateRef,
-				fmt.Sprintf("Secret %s/%s does not exist.", listener.gateway.Namespace, certificateRef.Name),
-			)
+		if len(secrets) != len(listener.TLS.CertificateRefs) {
 			break
 		}
 
-		if secret.Type != v1.SecretTypeTLS {
-			listener.SetCondition(
-				v1beta1.ListenerConditionResolvedRefs,
-				metav1.ConditionFalse,
-				v1beta1.ListenerReasonInvalidCertificateRef,
-				fmt.Sprintf("Secret %s/%s must be of type %s.", listener.gateway.Namespace, certificateRef.Name, v1.SecretTypeTLS),
-			)
+		if !t.checkTLSParameters(listener) {
 			break
 		}
 
-		if len(secret.Data[v1.TLSCertKey]) == 0 || len(secret.Data[v1.TLSPrivateKeyKey]) == 0 {
-			listener.SetCondition(
-				v1beta1.ListenerConditionResolvedRefs,
-				metav1.ConditionFalse,
-				v1beta1.ListenerReasonInvalidCertificateRef,
-				fmt.Sprintf("Secret %s/%s must contain %s and %s.", listener.gateway.Namespace, certificateRef.Name, v1.TLSCertKey, v1.TLSPrivateKeyKey),
-			)
-			break
-		}
+		listener.SetTLSSecrets(secrets)
 
-		listener.SetTLSSecret(secret)
+		t.checkTLSClientValidation(listener, resources)
+		t.checkHTTP3(listener)
 	case v1beta1.TLSProtocolType:
 		if listener.TLS == nil {
 			listener.SetCondition(
@@ -530,6 +587,93 @@ func (t *Translator) checkTLS(listener *ListenerContext, resources *Resources) {
 	}
 }
 
+// resolveTLSCertificateRef returns the TLS Secret of the certificate ref of the
+// listener, or nil after setting the listener conditions if it cannot be resolved.
+func (t *Translator) resolveTLSCertificateRef(listener *ListenerContext, certificateRef v1beta1.SecretObjectReference, resources *Resources) *v1.Secret {
+	if certificateRef.Group != nil && string(*certificateRef.Group) != "" {
+		listener.SetCondition(
+			v1beta1.ListenerConditionResolvedRefs,
+			metav1.ConditionFalse,
+			v1beta1.ListenerReasonInvalidCertificateRef,
+			"Listener's TLS certificate ref group must be unspecified/empty.",
+		)
+		return nil
+	}
+
+	if certificateRef.Kind != nil && string(*certificateRef.Kind) != KindSecret {
+		listener.SetCondition(
+			v1beta1.ListenerConditionResolvedRefs,
+			metav1.ConditionFalse,
+			v1beta1.ListenerReasonInvalidCertificateRef,
+			fmt.Sprintf("Listener's TLS certificate ref kind must be %s.", KindSecret),
+		)
+		return nil
+	}
+
+	secretNamespace := listener.gateway.Namespace
+
+	if certificateRef.Namespace != nil && string(*certificateRef.Namespace) != "" && string(*certificateRef.Namespace) != listener.gateway.Namespace {
+		if !isValidCrossNamespaceRef(
+			crossNamespaceFrom{
+				group:     string(v1beta1.GroupName),
+				kind:      KindGateway,
+				namespace: listener.gateway.Namespace,
+			},
+			crossNamespaceTo{
+				group:     "",
+				kind:      KindSecret,
+				namespace: string(*certificateRef.Namespace),
+				name:      string(certificateRef.Name),
+			},
+			resources.ReferenceGrants,
+		) {
+			listener.SetCondition(
+				v1beta1.ListenerConditionResolvedRefs,
+				metav1.ConditionFalse,
+				v1beta1.ListenerReasonRefNotPermitted,
+				fmt.Sprintf("Certificate ref to secret %s/%s not permitted by any ReferenceGrant", *certificateRef.Namespace, certificateRef.Name),
+			)
+			return nil
+		}
+
+		secretNamespace = string(*certificateRef.Namespace)
+	}
+
+	secret := resources.GetSecret(secretNamespace, string(certificateRef.Name))
+
+	if secret == nil {
+		listener.SetCondition(
+			v1beta1.ListenerConditionResolvedRefs,
+			metav1.ConditionFalse,
+			v1beta1.ListenerReasonInvalidCertificateRef,
+			fmt.Sprintf("Secret %s/%s does not exist.", listener.gateway.Namespace, certificateRef.Name),
+		)
+		return nil
+	}
+
+	if secret.Type != v1.SecretTypeTLS {
+		listener.SetCondition(
+			v1beta1.ListenerConditionResolvedRefs,
+			metav1.ConditionFalse,
+			v1beta1.ListenerReasonInvalidCertificateRef,
+			fmt.Sprintf("Secret %s/%s must be of type %s.", listener.gateway.Namespace, certificateRef.Name, v1.SecretTypeTLS),
+		)
+		return nil
+	}
+
+	if len(secret.Data[v1.TLSCertKey]) == 0 || len(secret.Data[v1.TLSPrivateKeyKey]) == 0 {
+		listener.SetCondition(
+			v1beta1.ListenerConditionResolvedRefs,
+			metav1.ConditionFalse,
+			v1beta1.ListenerReasonInvalidCertificateRef,
+			fmt.Sprintf("Secret %s/%s must contain %s and %s.", listener.gateway.Namespace, certificateRef.Name, v1.TLSCertKey, v1.TLSPrivateKeyKey),
+		)
+		return nil
+	}
+
+	return secret
+}
+
 func (t *Translator) checkHostName(listener *ListenerContext) {
 	if listener.Protocol == v1beta1.UDPProtocolType || listener.Protocol == v1beta1.TCPProtocolType {
 		if listener.Hostname != nil {
@@ -693,70 +837,90 @@ func buildRuleRouteDest(backendRef v1beta1.HTTPBackendRef,
 		weight = uint32(*backendRef.Weight)
 	}
 
-	if backendRef.Group != nil && *backendRef.Group != "" {
+	destination, err := resolveServiceBackendRef(backendRef.BackendObjectReference,
+		crossNamespaceFrom{
+			group:     v1beta1.GroupName,
+			kind:      KindHTTPRoute,
+			namespace: httpRoute.Namespace,
+		},
+		resources,
+	)
+	if err != nil {
 		parentRef.SetCondition(httpRoute,
 			v1beta1.RouteConditionResolvedRefs,
 			metav1.ConditionFalse,
-			v1beta1.RouteReasonInvalidKind,
-			"Group is invalid, only the core API group (specified by omitting the group field or setting it to an empty string) is supported",
+			err.reason,
+			err.message,
 		)
 		return nil, weight
 	}
 
+	destination.Weight = weight
+	return destination, weight
+}
+
+// backendRefError is the error resolving a backendRef, along with the reason
+// of the ResolvedRefs condition of the routes.
+type backendRefError struct {
+	reason  v1beta1.RouteConditionReason
+	message string
+}
+
+func (e *backendRefError) Error() string {
+	return e.message
+}
+
+// resolveServiceBackendRef resolves the port of the Service referenced by the
+// backendRef of a resource, which must be allowed by a ReferenceGrant if the
+// Service is in another namespace. The returned destination has no weight.
+func resolveServiceBackendRef(backendRef v1beta1.BackendObjectReference, from crossNamespaceFrom,
+	resources *Resources) (*ir.RouteDestination, *backendRefError) {
+	if backendRef.Group != nil && *backendRef.Group != "" {
+		return nil, &backendRefError{
+			reason:  v1beta1.RouteReasonInvalidKind,
+			message: "Group is invalid, only the core API group (specified by omitting the group field or setting it to an empty string) is supported",
+		}
+	}
+
 	if backendRef.Kind != nil && *backendRef.Kind != KindService {
-		parentRef.SetCondition(httpRoute,
-			v1beta1.RouteConditionResolvedRefs,
-			metav1.ConditionFalse,
-			v1beta1.RouteReasonInvalidKind,
-			"Kind is invalid, only Service is supported",
-		)
-		return nil, weight
+		return nil, &backendRefError{
+			reason:  v1beta1.RouteReasonInvalidKind,
+			message: "Kind is invalid, only Service is supported",
+		}
 	}
 
-	if backendRef.Namespace != nil && string(*backendRef.Namespace) != "" && string(*backendRef.Namespace) != httpRoute.Namespace {
+	serviceNamespace := NamespaceDerefOr(backendRef.Namespace, from.namespace)
+	if serviceNamespace != from.namespace {
 		if !isValidCrossNamespaceRef(
-			crossNamespaceFrom{
-				group:     v1beta1.GroupName,
-				kind:      KindHTTPRoute,
-				namespace: httpRoute.Namespace,
-			},
+			from,
 			crossNamespaceTo{
 				group:     "",
 				kind:      KindService,
-				namespace: string(*backendRef.Namespace),
+				namespace: serviceNamespace,
 				name:      string(backendRef.Name),
 			},
 			resources.ReferenceGrants,
 		) {
-			parentRef.SetCondition(httpRoute,
-				v1beta1.RouteConditionResolvedRefs,
-				metav1.ConditionFalse,
-				v1beta1.RouteReasonRefNotPermitted,
-				fmt.Sprintf("Backend ref to service %s/%s not permitted by any ReferenceGrant", *backendRef.Namespace, backendRef.Name),
-			)
-			return nil, weight
+			return nil, &backendRefError{
+				reason:  v1beta1.RouteReasonRefNotPermitted,
+				message: fmt.Sprintf("Backend ref to service %s/%s not permitted by any ReferenceGrant", serviceNamespace, backendRef.Name),
+			}
 		}
 	}
 
 	if backendRef.Port == nil {
-		parentRef.SetCondition(httpRoute,
-			v1beta1.RouteConditionResolvedRefs,
-			metav1.ConditionFalse,
-			"PortNotSpecified",
-			"A valid port number corresponding to a port on the Service must be specified",
-		)
-		return nil, weight
+		return nil, &backendRefError{
+			reason:  "PortNotSpecified",
+			message: "A valid port number corresponding to a port on the Service must be specified",
+		}
 	}
 
-	service := resources.GetService(NamespaceDerefOr(backendRef.Namespace, httpRoute.Namespace), string(backendRef.Name))
+	service := resources.GetService(serviceNamespace, string(backendRef.Name))
 	if service == nil {
-		parentRef.SetCondition(httpRoute,
-			v1beta1.RouteConditionResolvedRefs,
-			metav1.ConditionFalse,
-			v1beta1.RouteReasonBackendNotFound,
-			fmt.Sprintf("Service %s/%s not found", NamespaceDerefOr(backendRef.Namespace, httpRoute.Namespace), string(backendRef.Name)),
-		)
-		return nil, weight
+		return nil, &backendRefError{
+			reason:  v1beta1.RouteReasonBackendNotFound,
+			message: fmt.Sprintf("Service %s/%s not found", serviceNamespace, string(backendRef.Name)),
+		}
 	}
 
 	var portFound bool
@@ -769,21 +933,16 @@ func buildRuleRouteDest(backendRef v1beta1.HTTPBackendRef,
 	}
 
 	if !portFound {
-		parentRef.SetCondition(httpRoute,
-			v1beta1.RouteConditionResolvedRefs,
-			metav1.ConditionFalse,
-			"PortNotFound",
-			fmt.Sprintf("Port %d not found on service %s/%s", *backendRef.Port, NamespaceDerefOr(backendRef.Namespace, httpRoute.Namespace), string(backendRef.Name)),
-		)
-		return nil, weight
+		return nil, &backendRefError{
+			reason:  "PortNotFound",
+			message: fmt.Sprintf("Port %d not found on service %s/%s", *backendRef.Port, serviceNamespace, string(backendRef.Name)),
+		}
 	}
 
 	return &ir.RouteDestination{
-		Host:   service.Spec.ClusterIP,
-		Port:   uint32(*backendRef.Port),
-		Weight: weight,
-	}, weight
-
+		Host: service.Spec.ClusterIP,
+		Port: uint32(*backendRef.Port),
+	}, nil
 }
 
 func (t *Translator) ProcessHTTPRoutes(httpRoutes []*v1beta1.HTTPRoute, gateways []*GatewayContext, resources *Resources, xdsIR XdsIRMap) []*HTTPRouteContext {
@@ -823,6 +982,7 @@ func (t *Translator) ProcessHTTPRoutes(httpRoutes []*v1beta1.HTTPRoute, gateways
 				// First see if there are any filters in the rules. Then apply those filters to any irRoutes.
 				var directResponse *ir.DirectResponse
 				var redirectResponse *ir.Redirect
+				var authn *routeAuthentication
 
 				addRequestHeaders := []ir.AddHeader{}
 				removeRequestHeaders := []string{}
@@ -1238,6 +1398,35 @@ func (t *Translator) ProcessHTTPRoutes(httpRoutes []*v1beta1.HTTPRoute, gateways
 							)
 						}
 					case v1beta1.HTTPRouteFilterExtensionRef:
+						if isAuthenticationFilterRef(filter.ExtensionRef) {
+							// Can't have two authentication filters for the same route
+							if authn != nil {
+								parentRef.SetCondition(httpRoute,
+									v1beta1.RouteConditionAccepted,
+									metav1.ConditionFalse,
+									v1beta1.RouteReasonUnsupportedValue,
+									"Cannot configure multiple AuthenticationFilters for a single HTTPRouteRule",
+								)
+								continue
+							}
+
+							var err error
+							if authn, err = t.buildRouteAuthentication(httpRoute.Namespace, filter.ExtensionRef, resources); err != nil {
+								errMsg := fmt.Sprintf("Invalid AuthenticationFilter %s/%s: %s", httpRoute.Namespace, filter.ExtensionRef.Name, err)
+								parentRef.SetCondition(httpRoute,
+									v1beta1.RouteConditionAccepted,
+									metav1.ConditionFalse,
+									v1beta1.RouteReasonUnsupportedValue,
+									errMsg,
+								)
+								directResponse = &ir.DirectResponse{
+									Body:       &errMsg,
+									StatusCode: 500,
+								}
+							}
+							break
+						}
+
 						// "If a reference to a custom filter type cannot be resolved, the filter MUST NOT be skipped.
 						// Instead, requests that would have been processed by that filter MUST receive a HTTP error response."
 						errMsg := fmt.Sprintf("Unknown custom filter type: %s", filter.Type)
@@ -1334,6 +1523,9 @@ func (t *Translator) ProcessHTTPRoutes(httpRoutes []*v1beta1.HTTPRoute, gateways
 					if directResponse != nil {
 						irRoute.DirectResponse = directResponse
 					}
+					if authn != nil {
+						authn.apply(irRoute)
+					}
 					if len(addRequestHeaders) > 0 {
 						irRoute.AddRequestHeaders = addRequestHeaders
 					}
@@ -1425,6 +1617,9 @@ func (t *Translator) ProcessHTTPRoutes(httpRoutes []*v1beta1.HTTPRoute, gateways
 							Destinations:          routeRoute.Destinations,
 							Redirect:              routeRoute.Redirect,
 							DirectResponse:        routeRoute.DirectResponse,
+							OIDC:                  routeRoute.OIDC,
+							BasicAuth:             routeRoute.BasicAuth,
+							JWT:                   routeRoute.JWT,
 						}
 						// Don't bother copying over the weights unless the route has invalid backends.
 						if routeRoute.BackendWeights.Invalid > 0 {
@@ -1438,6 +1633,7 @@ func (t *Translator) ProcessHTTPRoutes(httpRoutes []*v1beta1.HTTPRoute, gateways
 				irListener := xdsIR[irKey].GetHTTPListener(irHTTPListenerName(listener))
 				if irListener != nil {
 					irListener.Routes = append(irListener.Routes, perHostRoutes...)
+					httpRoute.irRoutes = append(httpRoute.irRoutes, perHostRoutes...)
 				}
 				// Theoretically there should only be one parent ref per
 				// Route that attaches to a given Listener, so fine to just increment here, but we
@@ -1984,6 +2180,40 @@ func isValidCrossNamespaceRef(from crossNamespaceFrom, to crossNamespaceTo, refe
 	return false
 }
 
+// resolveSecretRef returns the Secret referenced by a resource described by from,
+// checking that a ReferenceGrant permits the reference when it crosses namespaces.
+func resolveSecretRef(from crossNamespaceFrom, secretRef v1beta1.SecretObjectReference, resources *Resources) (*v1.Secret, error) {
+	if secretRef.Group != nil && *secretRef.Group != "" {
+		return nil, errors.New("group of secret ref is invalid, only the core API group is supported")
+	}
+	if secretRef.Kind != nil && *secretRef.Kind != KindSecret {
+		return nil, errors.New("kind of secret ref is invalid, only Secret is supported")
+	}
+
+	secretNamespace := NamespaceDerefOr(secretRef.Namespace, from.namespace)
+	if secretNamespace != from.namespace {
+		if !isValidCrossNamespaceRef(
+			from,
+			crossNamespaceTo{
+				group:     "",
+				kind:      KindSecret,
+				namespace: secretNamespace,
+				name:      string(secretRef.Name),
+			},
+			resources.ReferenceGrants,
+		) {
+			return nil, fmt.Errorf("secret ref to secret %s/%s not permitted by any ReferenceGrant",
+				secretNamespace, secretRef.Name)
+		}
+	}
+
+	secret := resources.GetSecret(secretNamespace, string(secretRef.Name))
+	if secret == nil {
+		return nil, fmt.Errorf("secret %s/%s not found", secretNamespace, secretRef.Name)
+	}
+	return secret, nil
+}
+
 // Checks if a hostname is valid according to RFC 1123 and gateway API's requirement that it not be an IP address
 func isValidHostname(hostname string) error {
 	if errs := validation.IsDNS1123Subdomain(hostname); errs != nil {
@@ -2033,15 +2263,26 @@ func routeName(route RouteContext, ruleIdx, matchIdx int) string {
 	return fmt.Sprintf("%s-%s-rule-%d-match-%d", route.GetNamespace(), route.GetName(), ruleIdx, matchIdx)
 }
 
-func irTLSConfig(tlsSecret *v1.Secret) *ir.TLSListenerConfig {
-	if tlsSecret == nil {
+func irTLSConfig(listener *ListenerContext) *ir.TLSListenerConfig {
+	if len(listener.tlsSecrets) == 0 {
 		return nil
 	}
 
-	return &ir.TLSListenerConfig{
-		ServerCertificate: tlsSecret.Data[v1.TLSCertKey],
-		PrivateKey:        tlsSecret.Data[v1.TLSPrivateKeyKey],
+	tlsConfig := &ir.TLSListenerConfig{
+		MinVersion:       listener.tlsParameters.minVersion,
+		MaxVersion:       listener.tlsParameters.maxVersion,
+		Ciphers:          listener.tlsParameters.ciphers,
+		ECDHCurves:       listener.tlsParameters.ecdhCurves,
+		ALPNProtocols:    listener.tlsParameters.alpnProtocols,
+		ClientValidation: listener.tlsClientValidation,
+	}
+	for _, tlsSecret := range listener.tlsSecrets {
+		tlsConfig.Certificates = append(tlsConfig.Certificates, ir.TLSCertificate{
+			ServerCertificate: tlsSecret.Data[v1.TLSCertKey],
+			PrivateKey:        tlsSecret.Data[v1.TLSPrivateKeyKey],
+		})
 	}
+	return tlsConfig
 }
 
 // GatewayOwnerLabels returns the Gateway Owner labels using
@@ -47,8 +47,10 @@ func TestTranslate(t *testing.T) {
 			mustUnmarshal(t, string(output), want)
 
 			translator := &Translator{
-				GatewayClassName: "envoy-gateway-class",
-				ProxyImage:       "envoyproxy/envoy:translator-tests",
+				GatewayClassName:       "envoy-gateway-class",
+				ProxyImage:             "envoyproxy/envoy:translator-tests",
+				Namespace:              "envoy-gateway-system",
+				GlobalRateLimitEnabled: true,
 			}
 
 			// Add common test fixtures
@@ -11,11 +11,28 @@
 package gatewayapi
 
 import (
+	configv1alpha1 "github.com/envoyproxy/gateway/api/config/v1alpha1"
+	"github.com/envoyproxy/gateway/api/v1alpha1"
 	"k8s.io/api/core/v1"
 	"sigs.k8s.io/gateway-api/apis/v1alpha2"
 	"sigs.k8s.io/gateway-api/apis/v1beta1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OIDCProviderConfig) DeepCopyInto(out *OIDCProviderConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OIDCProviderConfig.
+func (in *OIDCProviderConfig) DeepCopy() *OIDCProviderConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OIDCProviderConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Resources) DeepCopyInto(out *Resources) {
 	*out = *in
@@ -107,6 +124,88 @@ func (in *Resources) DeepCopyInto(out *Resources) {
 			}
 		}
 	}
+	if in.ConfigMaps != nil {
+		in, out := &in.ConfigMaps, &out.ConfigMaps
+		*out = make([]*v1.ConfigMap, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(v1.ConfigMap)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.ClientTrafficPolicies != nil {
+		in, out := &in.ClientTrafficPolicies, &out.ClientTrafficPolicies
+		*out = make([]*v1alpha1.ClientTrafficPolicy, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(v1alpha1.ClientTrafficPolicy)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.BackendTrafficPolicies != nil {
+		in, out := &in.BackendTrafficPolicies, &out.BackendTrafficPolicies
+		*out = make([]*v1alpha1.BackendTrafficPolicy, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(v1alpha1.BackendTrafficPolicy)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.BackendTLSPolicies != nil {
+		in, out := &in.BackendTLSPolicies, &out.BackendTLSPolicies
+		*out = make([]*v1alpha1.BackendTLSPolicy, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(v1alpha1.BackendTLSPolicy)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.SecurityPolicies != nil {
+		in, out := &in.SecurityPolicies, &out.SecurityPolicies
+		*out = make([]*v1alpha1.SecurityPolicy, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(v1alpha1.SecurityPolicy)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.AuthenticationFilters != nil {
+		in, out := &in.AuthenticationFilters, &out.AuthenticationFilters
+		*out = make([]*v1alpha1.AuthenticationFilter, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(v1alpha1.AuthenticationFilter)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.OIDCProviders != nil {
+		in, out := &in.OIDCProviders, &out.OIDCProviders
+		*out = make([]*OIDCProviderConfig, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(OIDCProviderConfig)
+				**out = **in
+			}
+		}
+	}
+	if in.EnvoyProxy != nil {
+		in, out := &in.EnvoyProxy, &out.EnvoyProxy
+		*out = new(configv1alpha1.EnvoyProxy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Resources.
@@ -8,6 +8,45 @@ admin:
     socket_address:
       address: {{ .AdminServer.Address }}
       port_value: {{ .AdminServer.Port }}
+{{- with .StatsMatcher }}
+stats_config:
+  stats_matcher:
+    {{ .ListName }}:
+      patterns:
+      {{- range .Patterns }}
+      {{- if eq .Type "safe_regex" }}
+      - safe_regex:
+          google_re2: {}
+          regex: {{ .Value }}
+      {{- else }}
+      - {{ .Type }}: {{ .Value }}
+      {{- end }}
+      {{- end }}
+{{- end }}
+{{- if or .StatsDSinks .OpenTelemetrySinks }}
+stats_sinks:
+{{- range .StatsDSinks }}
+- name: envoy.stat_sinks.statsd
+  typed_config:
+    "@type": type.googleapis.com/envoy.config.metrics.v3.StatsdSink
+    address:
+      socket_address:
+        protocol: UDP
+        address: {{ .Address }}
+        port_value: {{ .Port }}
+    {{- if .Prefix }}
+    prefix: {{ .Prefix }}
+    {{- end }}
+{{- end }}
+{{- range .OpenTelemetrySinks }}
+- name: envoy.stat_sinks.open_telemetry
+  typed_config:
+    "@type": type.googleapis.com/envoy.extensions.stat_sinks.open_telemetry.v3.SinkConfig
+    grpc_service:
+      envoy_grpc:
+        cluster_name: {{ .ClusterName }}
+{{- end }}
+{{- end }}
 dynamic_resources:
   cds_config:
     resource_api_version: V3
@@ -28,7 +67,72 @@ dynamic_resources:
           cluster_name: xds_cluster
       set_node_on_first_message_only: true
 static_resources:
+{{- with .StatsServer }}
+  listeners:
+  - name: envoy-gateway-proxy-stats-{{ .Address }}-{{ .Port }}
+    address:
+      socket_address:
+        address: {{ .Address }}
+        port_value: {{ .Port }}
+        protocol: TCP
+    filter_chains:
+    - filters:
+      - name: envoy.filters.network.http_connection_manager
+        typed_config:
+          "@type": type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+          stat_prefix: eg-stats-http
+          normalize_path: true
+          route_config:
+            name: local_route
+            virtual_hosts:
+            - name: prometheus_stats
+              domains:
+              - "*"
+              routes:
+              - match:
+                  path: {{ .PrometheusPath }}
+                route:
+                  cluster: prometheus_stats
+          http_filters:
+          - name: envoy.filters.http.router
+            typed_config:
+              "@type": type.googleapis.com/envoy.extensions.filters.http.router.v3.Router
+{{- end }}
   clusters:
+{{- if .StatsServer }}
+  - connect_timeout: 0.250s
+    load_assignment:
+      cluster_name: prometheus_stats
+      endpoints:
+      - lb_endpoints:
+        - endpoint:
+            address:
+              socket_address:
+                address: {{ .AdminServer.Address }}
+                port_value: {{ .AdminServer.Port }}
+    name: prometheus_stats
+    type: STATIC
+{{- end }}
+{{- range .OpenTelemetrySinks }}
+  - connect_timeout: 1s
+    dns_lookup_family: V4_ONLY
+    load_assignment:
+      cluster_name: {{ .ClusterName }}
+      endpoints:
+      - lb_endpoints:
+        - endpoint:
+            address:
+              socket_address:
+                address: {{ .Host }}
+                port_value: {{ .Port }}
+    typed_extension_protocol_options:
+      "envoy.extensions.upstreams.http.v3.HttpProtocolOptions":
+         "@type": "type.googleapis.com/envoy.extensions.upstreams.http.v3.HttpProtocolOptions"
+         "explicit_http_config":
+           "http2_protocol_options": {}
+    name: {{ .ClusterName }}
+    type: STRICT_DNS
+{{- end }}
   - connect_timeout: 1s
     load_assignment:
       cluster_name: xds_cluster
@@ -10,6 +10,7 @@ import (
 	_ "embed"
 	"fmt"
 	"reflect"
+	"strconv"
 	"strings"
 	"text/template"
 
@@ -20,6 +21,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/pointer"
 
+	egcfgv1a1 "github.com/envoyproxy/gateway/api/config/v1alpha1"
 	"github.com/envoyproxy/gateway/internal/envoygateway/config"
 	"github.com/envoyproxy/gateway/internal/gatewayapi"
 	"github.com/envoyproxy/gateway/internal/ir"
@@ -49,6 +51,15 @@ const (
 	envoyAdminPort = 19000
 	// envoyAdminAccessLogPath is the path used to expose admin access log.
 	envoyAdminAccessLogPath = "/dev/null"
+	// envoyStatsAddress is the listening address of the envoy stats listener.
+	envoyStatsAddress = "0.0.0.0"
+	// envoyStatsPort is the default port of the envoy stats listener.
+	envoyStatsPort = int32(19001)
+	// envoyPrometheusPath is the path of the Prometheus endpoint of the stats listener.
+	envoyPrometheusPath = "/stats/prometheus"
+	// defaultOTLPGRPCPort is the default port of the OTLP gRPC endpoint of the
+	// OpenTelemetry collectors.
+	defaultOTLPGRPCPort = int32(4317)
 )
 
 //go:embed bootstrap.yaml.tpl
@@ -70,6 +81,17 @@ type bootstrapParameters struct {
 	XdsServer xdsServerParameters
 	// AdminServer defines the configuration of the Envoy admin interface.
 	AdminServer adminServerParameters
+	// StatsServer defines the configuration of the stats listener serving the
+	// Prometheus endpoint. The listener is not added if nil.
+	StatsServer *statsServerParameters
+	// StatsDSinks defines the StatsD servers the stats are pushed to.
+	StatsDSinks []statsDSinkParameters
+	// OpenTelemetrySinks defines the OpenTelemetry collectors the stats are
+	// pushed to.
+	OpenTelemetrySinks []openTelemetrySinkParameters
+	// StatsMatcher defines the stats created by Envoy. All the stats are
+	// created if nil.
+	StatsMatcher *statsMatcherParameters
 }
 
 type xdsServerParameters struct {
@@ -88,6 +110,49 @@ type adminServerParameters struct {
 	AccessLogPath string
 }
 
+type statsServerParameters struct {
+	// Address is the address of the stats listener.
+	Address string
+	// Port is the port of the stats listener.
+	Port int32
+	// PrometheusPath is the path of the Prometheus endpoint.
+	PrometheusPath string
+}
+
+type statsDSinkParameters struct {
+	// Address is the IP address of the StatsD server.
+	Address string
+	// Port is the port of the StatsD server.
+	Port int32
+	// Prefix is prepended to the stat names, Envoy uses "envoy" if empty.
+	Prefix string
+}
+
+type openTelemetrySinkParameters struct {
+	// ClusterName is the name of the static cluster of the collector.
+	ClusterName string
+	// Host is the host of the collector.
+	Host string
+	// Port is the port of the OTLP gRPC endpoint of the collector.
+	Port int32
+}
+
+type statsMatcherParameters struct {
+	// ListName is the name of the list of the patterns in the stats matcher,
+	// i.e. inclusion_list or exclusion_list.
+	ListName string
+	// Patterns are the patterns matching the stat names.
+	Patterns []statsPatternParameters
+}
+
+type statsPatternParameters struct {
+	// Type is the name of the field of the string matcher, i.e. exact, prefix,
+	// suffix or safe_regex.
+	Type string
+	// Value is the value of the string matcher, quoted for YAML.
+	Value string
+}
+
 // render the stringified bootstrap config in yaml format.
 func (b *bootstrapConfig) render() error {
 	buf := new(strings.Builder)
@@ -99,6 +164,119 @@ func (b *bootstrapConfig) render() error {
 	return nil
 }
 
+// expectedBootstrapParameters returns the bootstrap parameters of the proxy,
+// rendering the metrics configured by its EnvoyProxy.
+func expectedBootstrapParameters(infra *ir.Infra) bootstrapParameters {
+	parameters := bootstrapParameters{
+		XdsServer: xdsServerParameters{
+			Address: envoyGatewayXdsServerHost,
+			Port:    xdsrunner.XdsServerPort,
+		},
+		AdminServer: adminServerParameters{
+			Address:       envoyAdminAddress,
+			Port:          envoyAdminPort,
+			AccessLogPath: envoyAdminAccessLogPath,
+		},
+	}
+
+	metrics := proxyMetrics(infra)
+	if metrics == nil {
+		return parameters
+	}
+
+	if metrics.Prometheus != nil {
+		parameters.StatsServer = &statsServerParameters{
+			Address:        envoyStatsAddress,
+			Port:           expectedStatsPort(metrics.Prometheus),
+			PrometheusPath: envoyPrometheusPath,
+		}
+	}
+
+	for _, sink := range metrics.Sinks {
+		switch {
+		case sink.Type == egcfgv1a1.ProxyMetricSinkTypeStatsD && sink.StatsD != nil:
+			statsD := statsDSinkParameters{
+				Address: sink.StatsD.Address,
+				Port:    sink.StatsD.Port,
+			}
+			if sink.StatsD.Prefix != nil {
+				statsD.Prefix = strconv.Quote(*sink.StatsD.Prefix)
+			}
+			parameters.StatsDSinks = append(parameters.StatsDSinks, statsD)
+		case sink.Type == egcfgv1a1.ProxyMetricSinkTypeOpenTelemetry && sink.OpenTelemetry != nil:
+			openTelemetry := openTelemetrySinkParameters{
+				ClusterName: fmt.Sprintf("otel_metric_sink_%d", len(parameters.OpenTelemetrySinks)),
+				Host:        sink.OpenTelemetry.Host,
+				Port:        sink.OpenTelemetry.Port,
+			}
+			if openTelemetry.Port == 0 {
+				openTelemetry.Port = defaultOTLPGRPCPort
+			}
+			parameters.OpenTelemetrySinks = append(parameters.OpenTelemetrySinks, openTelemetry)
+		}
+	}
+
+	if metrics.Matcher != nil && len(metrics.Matcher.Matches) > 0 {
+		matcher := &statsMatcherParameters{ListName: "inclusion_list"}
+		if metrics.Matcher.Type == egcfgv1a1.ProxyStatsMatcherTypeExclusion {
+			matcher.ListName = "exclusion_list"
+		}
+		for _, match := range metrics.Matcher.Matches {
+			matcher.Patterns = append(matcher.Patterns, statsPatternParameters{
+				Type:  expectedStatsPatternType(match.Type),
+				Value: strconv.Quote(match.Value),
+			})
+		}
+		parameters.StatsMatcher = matcher
+	}
+
+	return parameters
+}
+
+// proxyMetrics returns the metrics configured by the EnvoyProxy of the proxy,
+// or nil if it configures none.
+func proxyMetrics(infra *ir.Infra) *egcfgv1a1.ProxyMetrics {
+	config := infra.GetProxyInfra().Config
+	if config == nil || config.Spec.Telemetry == nil {
+		return nil
+	}
+	return config.Spec.Telemetry.Metrics
+}
+
+func expectedStatsPort(prometheus *egcfgv1a1.PrometheusMetrics) int32 {
+	if prometheus.Port == 0 {
+		return envoyStatsPort
+	}
+	return prometheus.Port
+}
+
+func expectedStatsPatternType(matchType egcfgv1a1.StatNameMatchType) string {
+	switch matchType {
+	case egcfgv1a1.StatNameMatchTypePrefix:
+		return "prefix"
+	case egcfgv1a1.StatNameMatchTypeSuffix:
+		return "suffix"
+	case egcfgv1a1.StatNameMatchTypeRegularExpression:
+		return "safe_regex"
+	default:
+		return "exact"
+	}
+}
+
+// expectedPodAnnotations returns the annotations of the pods of the proxy,
+// which announce the Prometheus endpoint to the Prometheus servers.
+func expectedPodAnnotations(infra *ir.Infra) map[string]string {
+	metrics := proxyMetrics(infra)
+	if metrics == nil || metrics.Prometheus == nil {
+		return nil
+	}
+	return map[string]string{
+		"prometheus.io/scrape": "true",
+		"prometheus.io/port":   strconv.Itoa(int(expectedStatsPort(metrics.Prometheus))),
+		"prometheus.io/path":   envoyPrometheusPath,
+	}
+}
+
 func expectedDeploymentName(proxyName string) string {
 	deploymentName := utils.GetHashedName(proxyName)
 	return fmt.Sprintf("%s-%s", config.EnvoyPrefix, deploymentName)
@@ -132,7 +310,8 @@ func (i *Infra) expectedDeployment(infra *ir.Infra) (*appsv1.Deployment, error)
 			Selector: envoySelector(infra.GetProxyInfra().GetProxyMetadata().Labels),
 			Template: corev1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
-					Labels: envoySelector(infra.GetProxyInfra().GetProxyMetadata().Labels).MatchLabels,
+					Labels:      envoySelector(infra.GetProxyInfra().GetProxyMetadata().Labels).MatchLabels,
+					Annotations: expectedPodAnnotations(infra),
 				},
 				Spec: corev1.PodSpec{
 					Containers:                    containers,
@@ -195,19 +374,27 @@ func expectedContainers(infra *ir.Infra) ([]corev1.Container, error) {
 			Protocol:      corev1.ProtocolTCP,
 		},
 	}
+	// The UDP ports of the listeners, e.g. the ones serving HTTP/3, must be
+	// exposed for the traffic to reach them.
+	for _, listener := range infra.Proxy.Listeners {
+		for _, port := range listener.Ports {
+			if protocol := expectedPortProtocol(port.Protocol); protocol == corev1.ProtocolUDP {
+				ports = append(ports, corev1.ContainerPort{
+					Name:          fmt.Sprintf("udp-%d", port.ContainerPort),
+					ContainerPort: port.ContainerPort,
+					Protocol:      protocol,
+				})
+			}
+		}
+	}
 
-	cfg := bootstrapConfig{
-		parameters: bootstrapParameters{
-			XdsServer: xdsServerParameters{
-				Address: envoyGatewayXdsServerHost,
-				Port:    xdsrunner.XdsServerPort,
-			},
-			AdminServer: adminServerParameters{
-				Address:       envoyAdminAddress,
-				Port:          envoyAdminPort,
-				AccessLogPath: envoyAdminAccessLogPath,
-			},
-		},
+	cfg := bootstrapConfig{parameters: expectedBootstrapParameters(infra)}
+	if cfg.parameters.StatsServer != nil {
+		ports = append(ports, corev1.ContainerPort{
+			Name:          "metrics",
+			ContainerPort: cfg.parameters.StatsServer.Port,
+			Protocol:      corev1.ProtocolTCP,
+		})
 	}
 	if err := cfg.render(); err != nil {
 		return nil, err
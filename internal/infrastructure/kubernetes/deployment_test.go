@@ -16,9 +16,11 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	egcfgv1a1 "github.com/envoyproxy/gateway/api/config/v1alpha1"
 	"github.com/envoyproxy/gateway/internal/envoygateway"
 	"github.com/envoyproxy/gateway/internal/envoygateway/config"
 	"github.com/envoyproxy/gateway/internal/gatewayapi"
@@ -116,6 +118,14 @@ func TestExpectedDeployment(t *testing.T) {
 
 	infra.Proxy.GetProxyMetadata().Labels[gatewayapi.OwningGatewayNamespaceLabel] = "default"
 	infra.Proxy.GetProxyMetadata().Labels[gatewayapi.OwningGatewayNameLabel] = infra.Proxy.Name
+	infra.Proxy.Listeners[0].Ports = []ir.ListenerPort{
+		{
+			Name:          "gateway-system-gateway-1-http3",
+			Protocol:      ir.HTTP3ProtocolType,
+			ServicePort:   443,
+			ContainerPort: 2443,
+		},
+	}
 
 	deploy, err := kube.expectedDeployment(infra)
 	require.NoError(t, err)
@@ -149,12 +159,92 @@ func TestExpectedDeployment(t *testing.T) {
 	checkContainerHasArg(t, container, fmt.Sprintf("--config-yaml %s", cfg.rendered))
 
 	// Check container ports for the deployment are as expected.
-	ports := []int32{envoyHTTPPort, envoyHTTPSPort}
+	ports := []int32{envoyHTTPPort, envoyHTTPSPort, 2443}
 	for _, port := range ports {
 		checkContainerHasPort(t, deploy, port)
 	}
 }
 
+func TestExpectedDeploymentWithMetrics(t *testing.T) {
+	svrCfg, err := config.New()
+	require.NoError(t, err)
+	cli := fakeclient.NewClientBuilder().WithScheme(envoygateway.GetScheme()).WithObjects().Build()
+	kube := NewInfra(cli, svrCfg)
+	infra := ir.NewInfra()
+
+	infra.Proxy.GetProxyMetadata().Labels[gatewayapi.OwningGatewayNamespaceLabel] = "default"
+	infra.Proxy.GetProxyMetadata().Labels[gatewayapi.OwningGatewayNameLabel] = infra.Proxy.Name
+	infra.Proxy.Config = &egcfgv1a1.EnvoyProxy{
+		Spec: egcfgv1a1.EnvoyProxySpec{
+			Telemetry: &egcfgv1a1.ProxyTelemetry{
+				Metrics: &egcfgv1a1.ProxyMetrics{
+					Prometheus: &egcfgv1a1.PrometheusMetrics{Port: 19002},
+					Sinks: []egcfgv1a1.ProxyMetricSink{
+						{
+							Type: egcfgv1a1.ProxyMetricSinkTypeStatsD,
+							StatsD: &egcfgv1a1.StatsDMetricSink{
+								Address: "10.0.0.10",
+								Port:    8125,
+								Prefix:  pointer.String("eg"),
+							},
+						},
+						{
+							Type: egcfgv1a1.ProxyMetricSinkTypeOpenTelemetry,
+							OpenTelemetry: &egcfgv1a1.OpenTelemetryMetricSink{
+								Host: "otel-collector.monitoring.svc.cluster.local",
+							},
+						},
+					},
+					Matcher: &egcfgv1a1.ProxyStatsMatcher{
+						Type: egcfgv1a1.ProxyStatsMatcherTypeExclusion,
+						Matches: []egcfgv1a1.StatNameMatch{
+							{Type: egcfgv1a1.StatNameMatchTypePrefix, Value: "cluster."},
+							{Type: egcfgv1a1.StatNameMatchTypeRegularExpression, Value: `^http\..*`},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	deploy, err := kube.expectedDeployment(infra)
+	require.NoError(t, err)
+
+	// Check the bootstrap parameters rendered into the config are as expected.
+	parameters := expectedBootstrapParameters(infra)
+	require.Equal(t, &statsServerParameters{
+		Address:        envoyStatsAddress,
+		Port:           19002,
+		PrometheusPath: envoyPrometheusPath,
+	}, parameters.StatsServer)
+	require.Equal(t, []statsDSinkParameters{
+		{Address: "10.0.0.10", Port: 8125, Prefix: `"eg"`},
+	}, parameters.StatsDSinks)
+	require.Equal(t, []openTelemetrySinkParameters{
+		{ClusterName: "otel_metric_sink_0", Host: "otel-collector.monitoring.svc.cluster.local", Port: defaultOTLPGRPCPort},
+	}, parameters.OpenTelemetrySinks)
+	require.Equal(t, &statsMatcherParameters{
+		ListName: "exclusion_list",
+		Patterns: []statsPatternParameters{
+			{Type: "prefix", Value: `"cluster."`},
+			{Type: "safe_regex", Value: `"^http\\..*"`},
+		},
+	}, parameters.StatsMatcher)
+
+	cfg := &bootstrapConfig{parameters: parameters}
+	require.NoError(t, cfg.render())
+	container := checkContainer(t, deploy, envoyContainerName, true)
+	checkContainerHasArg(t, container, fmt.Sprintf("--config-yaml %s", cfg.rendered))
+
+	// Check the Prometheus endpoint is exposed and announced.
+	checkContainerHasPort(t, deploy, 19002)
+	require.Equal(t, map[string]string{
+		"prometheus.io/scrape": "true",
+		"prometheus.io/port":   "19002",
+		"prometheus.io/path":   envoyPrometheusPath,
+	}, deploy.Spec.Template.Annotations)
+}
+
 func deploymentWithImage(deploy *appsv1.Deployment, image string) *appsv1.Deployment {
 	dCopy := deploy.DeepCopy()
 	for i, c := range dCopy.Spec.Template.Spec.Containers {
@@ -11,6 +11,7 @@ import (
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/envoyproxy/gateway/api/config/v1alpha1"
 	"github.com/envoyproxy/gateway/internal/envoygateway/config"
 	"github.com/envoyproxy/gateway/internal/ir"
 )
@@ -22,14 +23,21 @@ type Infra struct {
 
 	// Namespace is the Namespace used for managed infra.
 	Namespace string
+
+	// RateLimit is the configuration of the managed rate limit service.
+	RateLimit *v1alpha1.RateLimit
 }
 
 // NewInfra returns a new Infra.
 func NewInfra(cli client.Client, cfg *config.Server) *Infra {
-	return &Infra{
+	infra := &Infra{
 		Client:    cli,
 		Namespace: cfg.Namespace,
 	}
+	if cfg.EnvoyGateway != nil {
+		infra.RateLimit = cfg.EnvoyGateway.RateLimit
+	}
+	return infra
 }
 
 // CreateOrUpdateInfra creates the managed kube infra, if it doesn't exist.
@@ -58,6 +66,15 @@ func (i *Infra) CreateOrUpdateInfra(ctx context.Context, infra *ir.Infra) error
 		return err
 	}
 
+	// The rate limit service is only needed by the proxies enforcing global rate limits.
+	if infra.RateLimit != nil {
+		if err := i.createOrUpdateRateLimit(ctx, infra); err != nil {
+			return err
+		}
+	} else if err := i.deleteRateLimit(ctx, infra); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -67,6 +84,10 @@ func (i *Infra) DeleteInfra(ctx context.Context, infra *ir.Infra) error {
 		return errors.New("infra ir is nil")
 	}
 
+	if err := i.deleteRateLimit(ctx, infra); err != nil {
+		return err
+	}
+
 	if err := i.deleteService(ctx, infra); err != nil {
 		return err
 	}
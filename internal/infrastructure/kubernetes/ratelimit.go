@@ -0,0 +1,348 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/envoyproxy/gateway/internal/envoygateway/config"
+	"github.com/envoyproxy/gateway/internal/gatewayapi"
+	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/provider/utils"
+)
+
+const (
+	// rateLimitContainerName is the name of the rate limit container.
+	rateLimitContainerName = "envoy-ratelimit"
+	// rateLimitImage is the container image of the rate limit service.
+	rateLimitImage = "envoyproxy/ratelimit:f28024e3"
+	// rateLimitRuntimeRoot is the directory watched by the rate limit service
+	// for its configuration.
+	rateLimitRuntimeRoot = "/data"
+	// rateLimitRuntimeSubdirectory is the subdirectory of the runtime root
+	// holding the configuration files.
+	rateLimitRuntimeSubdirectory = "ratelimit"
+	// rateLimitConfigFileName is the name of the rate limit configuration file.
+	rateLimitConfigFileName = "ratelimit.yaml"
+	// rateLimitConfigVolumeName is the name of the volume holding the configuration.
+	rateLimitConfigVolumeName = "ratelimit-config"
+)
+
+// rateLimitConfig is the configuration of the rate limit service,
+// as read from its runtime directory.
+type rateLimitConfig struct {
+	Domain      string                 `json:"domain"`
+	Descriptors []*rateLimitDescriptor `json:"descriptors,omitempty"`
+}
+
+type rateLimitDescriptor struct {
+	Key         string                 `json:"key"`
+	Value       string                 `json:"value,omitempty"`
+	RateLimit   *rateLimitPolicy       `json:"rate_limit,omitempty"`
+	Descriptors []*rateLimitDescriptor `json:"descriptors,omitempty"`
+}
+
+type rateLimitPolicy struct {
+	Unit            string `json:"unit"`
+	RequestsPerUnit uint32 `json:"requests_per_unit"`
+}
+
+func expectedRateLimitName(proxyName string) string {
+	rateLimitName := utils.GetHashedName(proxyName)
+	return fmt.Sprintf("%s-%s", config.EnvoyRateLimitPrefix, rateLimitName)
+}
+
+// rateLimitLabels returns the labels of the rate limit resources. The app label
+// differs from the Envoy one so that the Envoy Service does not select the rate
+// limit pods.
+func rateLimitLabels(infra *ir.Infra) (map[string]string, error) {
+	labels := map[string]string{
+		"app.gateway.envoyproxy.io/name": config.EnvoyRateLimitPrefix,
+	}
+	for k, v := range infra.GetProxyInfra().GetProxyMetadata().Labels {
+		if k == gatewayapi.OwningGatewayNamespaceLabel || k == gatewayapi.OwningGatewayNameLabel {
+			labels[k] = v
+		}
+	}
+	if len(labels[gatewayapi.OwningGatewayNamespaceLabel]) == 0 || len(labels[gatewayapi.OwningGatewayNameLabel]) == 0 {
+		return nil, fmt.Errorf("missing owning gateway labels")
+	}
+
+	return labels, nil
+}
+
+// expectedRateLimitConfigMap returns the expected ConfigMap holding the
+// configuration of the rate limit service based on the provided infra.
+func (i *Infra) expectedRateLimitConfigMap(infra *ir.Infra) (*corev1.ConfigMap, error) {
+	labels, err := rateLimitLabels(infra)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := rateLimitConfig{
+		Domain:      infra.RateLimit.Domain,
+		Descriptors: buildRateLimitDescriptors(infra.RateLimit.Descriptors),
+	}
+	data, err := yaml.Marshal(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal ratelimit config: %w", err)
+	}
+
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: i.Namespace,
+			Name:      expectedRateLimitName(infra.Proxy.Name),
+			Labels:    labels,
+		},
+		Data: map[string]string{
+			rateLimitConfigFileName: string(data),
+		},
+	}, nil
+}
+
+func buildRateLimitDescriptors(descriptors []*ir.RateLimitDescriptor) []*rateLimitDescriptor {
+	var res []*rateLimitDescriptor
+	for _, descriptor := range descriptors {
+		d := &rateLimitDescriptor{
+			Key:         descriptor.Key,
+			Value:       descriptor.Value,
+			Descriptors: buildRateLimitDescriptors(descriptor.Descriptors),
+		}
+		if descriptor.RateLimit != nil {
+			d.RateLimit = &rateLimitPolicy{
+				Unit:            strings.ToLower(string(descriptor.RateLimit.Unit)),
+				RequestsPerUnit: descriptor.RateLimit.RequestsPerUnit,
+			}
+		}
+		res = append(res, d)
+	}
+	return res
+}
+
+// expectedRateLimitDeployment returns the expected Deployment of the rate limit
+// service based on the provided infra.
+func (i *Infra) expectedRateLimitDeployment(infra *ir.Infra) (*appsv1.Deployment, error) {
+	labels, err := rateLimitLabels(infra)
+	if err != nil {
+		return nil, err
+	}
+
+	var redisURL string
+	if i.RateLimit != nil && i.RateLimit.Backend.Redis != nil {
+		redisURL = i.RateLimit.Backend.Redis.URL
+	}
+
+	containers := []corev1.Container{
+		{
+			Name:            rateLimitContainerName,
+			Image:           rateLimitImage,
+			ImagePullPolicy: corev1.PullIfNotPresent,
+			Command: []string{
+				"/bin/ratelimit",
+			},
+			Env: []corev1.EnvVar{
+				{Name: "RUNTIME_ROOT", Value: rateLimitRuntimeRoot},
+				{Name: "RUNTIME_SUBDIRECTORY", Value: rateLimitRuntimeSubdirectory},
+				{Name: "RUNTIME_IGNOREDOTFILES", Value: "true"},
+				{Name: "RUNTIME_WATCH_ROOT", Value: "false"},
+				{Name: "LOG_LEVEL", Value: "info"},
+				{Name: "USE_STATSD", Value: "false"},
+				{Name: "REDIS_SOCKET_TYPE", Value: "tcp"},
+				{Name: "REDIS_URL", Value: redisURL},
+				{Name: "GRPC_PORT", Value: fmt.Sprintf("%d", config.RateLimitGRPCPort)},
+			},
+			Ports: []corev1.ContainerPort{
+				{
+					Name:          "grpc",
+					ContainerPort: config.RateLimitGRPCPort,
+					Protocol:      corev1.ProtocolTCP,
+				},
+			},
+			VolumeMounts: []corev1.VolumeMount{
+				{
+					Name:      rateLimitConfigVolumeName,
+					MountPath: fmt.Sprintf("%s/%s/config", rateLimitRuntimeRoot, rateLimitRuntimeSubdirectory),
+					ReadOnly:  true,
+				},
+			},
+			TerminationMessagePolicy: corev1.TerminationMessageReadFile,
+			TerminationMessagePath:   "/dev/termination-log",
+		},
+	}
+
+	return &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Deployment",
+			APIVersion: "apps/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: i.Namespace,
+			Name:      expectedRateLimitName(infra.Proxy.Name),
+			Labels:    labels,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: pointer.Int32(1),
+			Selector: &metav1.LabelSelector{MatchLabels: labels},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: corev1.PodSpec{
+					Containers:                    containers,
+					AutomountServiceAccountToken:  pointer.BoolPtr(false),
+					TerminationGracePeriodSeconds: pointer.Int64Ptr(int64(300)),
+					DNSPolicy:                     corev1.DNSClusterFirst,
+					RestartPolicy:                 corev1.RestartPolicyAlways,
+					SchedulerName:                 "default-scheduler",
+					Volumes: []corev1.Volume{
+						{
+							Name: rateLimitConfigVolumeName,
+							VolumeSource: corev1.VolumeSource{
+								ConfigMap: &corev1.ConfigMapVolumeSource{
+									LocalObjectReference: corev1.LocalObjectReference{
+										Name: expectedRateLimitName(infra.Proxy.Name),
+									},
+									DefaultMode: pointer.Int32Ptr(int32(420)),
+									Optional:    pointer.BoolPtr(false),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+// expectedRateLimitService returns the expected Service of the rate limit
+// service based on the provided infra.
+func (i *Infra) expectedRateLimitService(infra *ir.Infra) (*corev1.Service, error) {
+	labels, err := rateLimitLabels(infra)
+	if err != nil {
+		return nil, err
+	}
+
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: i.Namespace,
+			Name:      expectedRateLimitName(infra.Proxy.Name),
+			Labels:    labels,
+		},
+		Spec: corev1.ServiceSpec{
+			Type: corev1.ServiceTypeClusterIP,
+			Ports: []corev1.ServicePort{
+				{
+					Name:       "grpc",
+					Protocol:   corev1.ProtocolTCP,
+					Port:       config.RateLimitGRPCPort,
+					TargetPort: intstr.IntOrString{IntVal: config.RateLimitGRPCPort},
+				},
+			},
+			Selector:        labels,
+			SessionAffinity: corev1.ServiceAffinityNone,
+		},
+	}, nil
+}
+
+// createOrUpdateRateLimit creates the ConfigMap, Deployment and Service of the
+// rate limit service in the kube api server based on the provided infra, if they
+// don't exist and updates them if they do.
+func (i *Infra) createOrUpdateRateLimit(ctx context.Context, infra *ir.Infra) error {
+	cm, err := i.expectedRateLimitConfigMap(infra)
+	if err != nil {
+		return err
+	}
+	if err := i.createOrUpdateRateLimitObject(ctx, cm, &corev1.ConfigMap{}, func(current client.Object) bool {
+		return reflect.DeepEqual(cm.Data, current.(*corev1.ConfigMap).Data)
+	}); err != nil {
+		return err
+	}
+
+	deploy, err := i.expectedRateLimitDeployment(infra)
+	if err != nil {
+		return err
+	}
+	if err := i.createOrUpdateRateLimitObject(ctx, deploy, &appsv1.Deployment{}, func(current client.Object) bool {
+		return reflect.DeepEqual(deploy.Spec, current.(*appsv1.Deployment).Spec)
+	}); err != nil {
+		return err
+	}
+
+	svc, err := i.expectedRateLimitService(infra)
+	if err != nil {
+		return err
+	}
+	return i.createOrUpdateRateLimitObject(ctx, svc, &corev1.Service{}, func(current client.Object) bool {
+		return reflect.DeepEqual(svc.Spec, current.(*corev1.Service).Spec)
+	})
+}
+
+// createOrUpdateRateLimitObject creates obj if it doesn't exist, and updates it
+// if the current object is not equal to it.
+func (i *Infra) createOrUpdateRateLimitObject(ctx context.Context, obj, current client.Object, equal func(client.Object) bool) error {
+	key := types.NamespacedName{
+		Namespace: obj.GetNamespace(),
+		Name:      obj.GetName(),
+	}
+	kind := strings.ToLower(reflect.TypeOf(obj).Elem().Name())
+
+	if err := i.Client.Get(ctx, key, current); err != nil {
+		// Create if not found.
+		if kerrors.IsNotFound(err) {
+			if err := i.Client.Create(ctx, obj); err != nil {
+				return fmt.Errorf("failed to create %s %s/%s: %w", kind, obj.GetNamespace(), obj.GetName(), err)
+			}
+		}
+	} else {
+		// Update if current value is different.
+		if !equal(current) {
+			if err := i.Client.Update(ctx, obj); err != nil {
+				return fmt.Errorf("failed to update %s %s/%s: %w", kind, obj.GetNamespace(), obj.GetName(), err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// deleteRateLimit deletes the Service, Deployment and ConfigMap of the rate
+// limit service in the kube api server, if they exist.
+func (i *Infra) deleteRateLimit(ctx context.Context, infra *ir.Infra) error {
+	meta := metav1.ObjectMeta{
+		Namespace: i.Namespace,
+		Name:      expectedRateLimitName(infra.Proxy.Name),
+	}
+	objs := []client.Object{
+		&corev1.Service{ObjectMeta: meta},
+		&appsv1.Deployment{ObjectMeta: meta},
+		&corev1.ConfigMap{ObjectMeta: meta},
+	}
+
+	for _, obj := range objs {
+		if err := i.Client.Delete(ctx, obj); err != nil {
+			if kerrors.IsNotFound(err) {
+				continue
+			}
+			kind := strings.ToLower(reflect.TypeOf(obj).Elem().Name())
+			return fmt.Errorf("failed to delete %s %s/%s: %w", kind, meta.Namespace, meta.Name, err)
+		}
+	}
+
+	return nil
+}
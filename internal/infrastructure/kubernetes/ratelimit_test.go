@@ -0,0 +1,163 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/envoyproxy/gateway/api/config/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/envoygateway"
+	"github.com/envoyproxy/gateway/internal/envoygateway/config"
+	"github.com/envoyproxy/gateway/internal/gatewayapi"
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+func newTestRateLimitInfra() *ir.Infra {
+	infra := ir.NewInfra()
+	infra.Proxy.GetProxyMetadata().Labels[gatewayapi.OwningGatewayNamespaceLabel] = "default"
+	infra.Proxy.GetProxyMetadata().Labels[gatewayapi.OwningGatewayNameLabel] = infra.Proxy.Name
+	infra.Proxy.Listeners[0].Ports = []ir.ListenerPort{
+		{
+			Name:          "gateway-system-gateway-1",
+			Protocol:      ir.HTTPProtocolType,
+			ServicePort:   80,
+			ContainerPort: 2080,
+		},
+	}
+	infra.RateLimit = &ir.RateLimitInfra{
+		Domain: "default-gateway-1",
+		Descriptors: []*ir.RateLimitDescriptor{
+			{
+				Key:   "generic_key",
+				Value: "route-rule-0",
+				Descriptors: []*ir.RateLimitDescriptor{
+					{
+						Key: "remote_address",
+						RateLimit: &ir.RateLimitPolicy{
+							Unit:            ir.Second,
+							RequestsPerUnit: 5,
+						},
+					},
+				},
+			},
+			{
+				Key:   "generic_key",
+				Value: "route-rule-1",
+				RateLimit: &ir.RateLimitPolicy{
+					Unit:            ir.Hour,
+					RequestsPerUnit: 1000,
+				},
+			},
+		},
+	}
+	return infra
+}
+
+func newTestRateLimitKube(t *testing.T) *Infra {
+	t.Helper()
+
+	cfg, err := config.New()
+	require.NoError(t, err)
+	cfg.EnvoyGateway.RateLimit = &v1alpha1.RateLimit{
+		Backend: v1alpha1.RateLimitDatabaseBackend{
+			Type:  v1alpha1.RedisBackendType,
+			Redis: &v1alpha1.RateLimitRedisSettings{URL: "redis.redis.svc:6379"},
+		},
+	}
+	cli := fakeclient.NewClientBuilder().WithScheme(envoygateway.GetScheme()).WithObjects().Build()
+	return NewInfra(cli, cfg)
+}
+
+func TestExpectedRateLimitConfigMap(t *testing.T) {
+	kube := newTestRateLimitKube(t)
+	infra := newTestRateLimitInfra()
+
+	cm, err := kube.expectedRateLimitConfigMap(infra)
+	require.NoError(t, err)
+	assert.Equal(t, expectedRateLimitName(infra.Proxy.Name), cm.Name)
+
+	expected := `descriptors:
+- descriptors:
+  - key: remote_address
+    rate_limit:
+      requests_per_unit: 5
+      unit: second
+  key: generic_key
+  value: route-rule-0
+- key: generic_key
+  rate_limit:
+    requests_per_unit: 1000
+    unit: hour
+  value: route-rule-1
+domain: default-gateway-1
+`
+	assert.Equal(t, expected, cm.Data[rateLimitConfigFileName])
+
+	// The Envoy Service must not select the rate limit pods.
+	assert.NotEqual(t, envoyAppLabel()["app.gateway.envoyproxy.io/name"], cm.Labels["app.gateway.envoyproxy.io/name"])
+}
+
+func TestExpectedRateLimitDeployment(t *testing.T) {
+	kube := newTestRateLimitKube(t)
+	infra := newTestRateLimitInfra()
+
+	deploy, err := kube.expectedRateLimitDeployment(infra)
+	require.NoError(t, err)
+	assert.Equal(t, expectedRateLimitName(infra.Proxy.Name), deploy.Name)
+
+	container := deploy.Spec.Template.Spec.Containers[0]
+	assert.Equal(t, rateLimitImage, container.Image)
+	assert.Contains(t, container.Env, corev1.EnvVar{Name: "REDIS_URL", Value: "redis.redis.svc:6379"})
+	assert.Contains(t, container.Env, corev1.EnvVar{Name: "GRPC_PORT", Value: "8081"})
+	assert.Equal(t, "/data/ratelimit/config", container.VolumeMounts[0].MountPath)
+	assert.Equal(t, expectedRateLimitName(infra.Proxy.Name),
+		deploy.Spec.Template.Spec.Volumes[0].ConfigMap.Name)
+}
+
+func TestExpectedRateLimitService(t *testing.T) {
+	kube := newTestRateLimitKube(t)
+	infra := newTestRateLimitInfra()
+
+	svc, err := kube.expectedRateLimitService(infra)
+	require.NoError(t, err)
+	assert.Equal(t, expectedRateLimitName(infra.Proxy.Name), svc.Name)
+	checkServiceHasPort(t, svc, config.RateLimitGRPCPort)
+	checkServiceHasTargetPort(t, svc, config.RateLimitGRPCPort)
+	assert.Equal(t, svc.Labels, svc.Spec.Selector)
+
+	// The owning gateway labels are required.
+	_, err = kube.expectedRateLimitService(&ir.Infra{Proxy: ir.NewProxyInfra()})
+	require.Error(t, err)
+}
+
+func TestCreateOrUpdateInfraWithRateLimit(t *testing.T) {
+	kube := newTestRateLimitKube(t)
+	infra := newTestRateLimitInfra()
+	key := types.NamespacedName{
+		Namespace: kube.Namespace,
+		Name:      expectedRateLimitName(infra.Proxy.Name),
+	}
+
+	require.NoError(t, kube.CreateOrUpdateInfra(context.Background(), infra))
+	require.NoError(t, kube.Client.Get(context.Background(), key, &corev1.ConfigMap{}))
+	require.NoError(t, kube.Client.Get(context.Background(), key, &appsv1.Deployment{}))
+	require.NoError(t, kube.Client.Get(context.Background(), key, &corev1.Service{}))
+
+	// The rate limit service is removed once the proxy has no global rate limits.
+	infra.RateLimit = nil
+	require.NoError(t, kube.CreateOrUpdateInfra(context.Background(), infra))
+	err := kube.Client.Get(context.Background(), key, &appsv1.Deployment{})
+	require.True(t, kerrors.IsNotFound(err))
+}
@@ -35,7 +35,7 @@ func (i *Infra) expectedService(infra *ir.Infra) (*corev1.Service, error) {
 			target := intstr.IntOrString{IntVal: port.ContainerPort}
 			p := corev1.ServicePort{
 				Name:       port.Name,
-				Protocol:   corev1.ProtocolTCP,
+				Protocol:   expectedPortProtocol(port.Protocol),
 				Port:       port.ServicePort,
 				TargetPort: target,
 			}
@@ -68,6 +68,16 @@ func (i *Infra) expectedService(infra *ir.Infra) (*corev1.Service, error) {
 	return svc, nil
 }
 
+// expectedPortProtocol returns the layer-4 protocol of a port of the listeners.
+func expectedPortProtocol(protocol ir.ProtocolType) corev1.Protocol {
+	switch protocol {
+	case ir.UDPProtocolType, ir.HTTP3ProtocolType:
+		return corev1.ProtocolUDP
+	default:
+		return corev1.ProtocolTCP
+	}
+}
+
 // createOrUpdateService creates a Service in the kube api server based on the provided infra,
 // if it doesn't exist or updates it if it does.
 func (i *Infra) createOrUpdateService(ctx context.Context, infra *ir.Infra) error {
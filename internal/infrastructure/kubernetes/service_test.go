@@ -56,6 +56,18 @@ func checkServiceHasPortName(t *testing.T, svc *corev1.Service, name string) {
 	t.Errorf("service is missing port name %q", name)
 }
 
+func checkServiceHasPortProtocol(t *testing.T, svc *corev1.Service, name string, protocol corev1.Protocol) {
+	t.Helper()
+
+	for _, p := range svc.Spec.Ports {
+		if p.Name == name {
+			assert.Equal(t, protocol, p.Protocol)
+			return
+		}
+	}
+	t.Errorf("service is missing port name %q", name)
+}
+
 func checkServiceHasLabels(t *testing.T, svc *corev1.Service, expected map[string]string) {
 	t.Helper()
 
@@ -87,6 +99,12 @@ func TestDesiredService(t *testing.T) {
 			ServicePort:   443,
 			ContainerPort: 2443,
 		},
+		{
+			Name:          "gateway-system-gateway-1-http3",
+			Protocol:      ir.HTTP3ProtocolType,
+			ServicePort:   443,
+			ContainerPort: 2443,
+		},
 	}
 	svc, err := kube.expectedService(infra)
 	require.NoError(t, err)
@@ -108,6 +126,10 @@ func TestDesiredService(t *testing.T) {
 	for _, port := range infra.Proxy.Listeners[0].Ports {
 		checkServiceHasPortName(t, svc, port.Name)
 	}
+
+	// HTTP/3 is served over UDP.
+	checkServiceHasPortProtocol(t, svc, "gateway-system-gateway-1", corev1.ProtocolTCP)
+	checkServiceHasPortProtocol(t, svc, "gateway-system-gateway-1-http3", corev1.ProtocolUDP)
 }
 
 func TestDeleteService(t *testing.T) {
@@ -24,6 +24,9 @@ const (
 type Infra struct {
 	// Proxy defines managed proxy infrastructure.
 	Proxy *ProxyInfra
+	// RateLimit defines the configuration of the managed rate limit service
+	// enforcing the global rate limits of the proxy.
+	RateLimit *RateLimitInfra
 }
 
 // ProxyInfra defines managed proxy infrastructure.
@@ -72,6 +75,38 @@ type ListenerPort struct {
 	ContainerPort int32
 }
 
+// RateLimitInfra defines the configuration of the managed rate limit service.
+// +k8s:deepcopy-gen=true
+type RateLimitInfra struct {
+	// Domain of the rate limit descriptors.
+	Domain string
+	// Descriptors define the rate limits enforced by the service.
+	Descriptors []*RateLimitDescriptor
+}
+
+// RateLimitDescriptor defines a descriptor entry of the rate limit service
+// configuration, with the descriptors nested under it.
+// +k8s:deepcopy-gen=true
+type RateLimitDescriptor struct {
+	// Key of the descriptor entry.
+	Key string
+	// Value of the descriptor entry. If empty, each value gets its own limit.
+	Value string
+	// RateLimit applied to the requests matching the descriptor.
+	RateLimit *RateLimitPolicy
+	// Descriptors nested under this descriptor.
+	Descriptors []*RateLimitDescriptor
+}
+
+// RateLimitPolicy defines the number of requests allowed per unit of time.
+// +k8s:deepcopy-gen=true
+type RateLimitPolicy struct {
+	// Unit of time of the rate limit.
+	Unit RateLimitUnit
+	// RequestsPerUnit is the number of requests allowed per unit of time.
+	RequestsPerUnit uint32
+}
+
 // ProtocolType defines the application protocol accepted by a ListenerPort.
 //
 // Valid values include "HTTP" and "HTTPS".
@@ -90,6 +125,9 @@ const (
 
 	// UDPProtocolType accepts UDP connection.
 	UDPProtocolType ProtocolType = "UDP"
+
+	// HTTP3ProtocolType accepts HTTP/3 sessions over QUIC, which runs on UDP.
+	HTTP3ProtocolType ProtocolType = "HTTP3"
 )
 
 // NewInfra returns a new Infra with default parameters.
@@ -170,6 +208,10 @@ func (i *Infra) Validate() error {
 		}
 	}
 
+	if i.RateLimit != nil && len(i.RateLimit.Domain) == 0 {
+		errs = append(errs, errors.New("ratelimit domain field required"))
+	}
+
 	return utilerrors.NewAggregate(errs)
 }
 
@@ -0,0 +1,110 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package ir
+
+import (
+	"fmt"
+	"net"
+)
+
+// Keys of the descriptor entries generated by the rate limit actions of the
+// routes, shared by the xds translator generating the actions and the rate
+// limit service configuration matching them.
+const (
+	RateLimitGenericKeyDescriptorKey          = "generic_key"
+	RateLimitHeaderMatchDescriptorKey         = "header_match"
+	RateLimitRemoteAddressDescriptorKey       = "remote_address"
+	RateLimitMaskedRemoteAddressDescriptorKey = "masked_remote_address"
+	// RateLimitHeaderMatchDescriptorValue is the value of the descriptor entry
+	// generated when the request matches the headers and path of a rule.
+	RateLimitHeaderMatchDescriptorValue = "match"
+)
+
+// GlobalRateLimitRuleKey returns the value of the generic key identifying the
+// rule at the given index of the route.
+func GlobalRateLimitRuleKey(routeName string, index int) string {
+	return fmt.Sprintf("%s-rule-%d", routeName, index)
+}
+
+// GlobalRateLimitHeaderKey returns the key of the descriptor entry holding the
+// value of the distinct header at the given index of a rule.
+func GlobalRateLimitHeaderKey(index int) string {
+	return fmt.Sprintf("header-%d", index)
+}
+
+// MatchesHeadersOrPath returns true if the rule only applies to the requests
+// matching some headers or path, i.e. it has a non distinct header match or a
+// path match.
+func (g *GlobalRateLimitRule) MatchesHeadersOrPath() bool {
+	if g.PathMatch != nil {
+		return true
+	}
+	for _, headerMatch := range g.HeaderMatches {
+		if !headerMatch.Distinct {
+			return true
+		}
+	}
+	return false
+}
+
+// RateLimitDescriptors returns the descriptors of the rate limit service
+// configuration enforcing the global rate limits of the routes. Routes sharing
+// the same name, such as the routes of an HTTPRoute attached to several
+// listeners, share the same descriptors.
+func (x Xds) RateLimitDescriptors() []*RateLimitDescriptor {
+	var descriptors []*RateLimitDescriptor
+	seen := map[string]bool{}
+	for _, httpListener := range x.HTTP {
+		for _, route := range httpListener.Routes {
+			if route.RateLimit == nil || route.RateLimit.Global == nil || seen[route.Name] {
+				continue
+			}
+			seen[route.Name] = true
+
+			for i, rule := range route.RateLimit.Global.Rules {
+				descriptors = append(descriptors, buildGlobalRateLimitRuleDescriptor(route.Name, i, rule))
+			}
+		}
+	}
+	return descriptors
+}
+
+// buildGlobalRateLimitRuleDescriptor returns the descriptor matching the
+// entries generated by the actions of the rule, nested in the same order.
+func buildGlobalRateLimitRuleDescriptor(routeName string, index int, rule *GlobalRateLimitRule) *RateLimitDescriptor {
+	root := &RateLimitDescriptor{
+		Key:   RateLimitGenericKeyDescriptorKey,
+		Value: GlobalRateLimitRuleKey(routeName, index),
+	}
+	leaf := root
+	next := func(key, value string) {
+		descriptor := &RateLimitDescriptor{Key: key, Value: value}
+		leaf.Descriptors = append(leaf.Descriptors, descriptor)
+		leaf = descriptor
+	}
+
+	if rule.MatchesHeadersOrPath() {
+		next(RateLimitHeaderMatchDescriptorKey, RateLimitHeaderMatchDescriptorValue)
+	}
+	for i, headerMatch := range rule.HeaderMatches {
+		if headerMatch.Distinct {
+			next(GlobalRateLimitHeaderKey(i), "")
+		}
+	}
+	if rule.ClientIP != nil {
+		if rule.ClientIP.CIDR == nil {
+			next(RateLimitRemoteAddressDescriptorKey, "")
+		} else if _, ipNet, err := net.ParseCIDR(*rule.ClientIP.CIDR); err == nil {
+			next(RateLimitMaskedRemoteAddressDescriptorKey, ipNet.String())
+		}
+	}
+
+	leaf.RateLimit = &RateLimitPolicy{
+		Unit:            rule.Limit.Unit,
+		RequestsPerUnit: rule.Limit.Requests,
+	}
+	return root
+}
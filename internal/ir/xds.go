@@ -6,33 +6,136 @@
 package ir
 
 import (
+	"crypto/sha1"
+	"encoding/json"
 	"errors"
 	"net"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
 
 	"github.com/tetratelabs/multierror"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 var (
-	ErrListenerNameEmpty             = errors.New("field Name must be specified")
-	ErrListenerAddressInvalid        = errors.New("field Address must be a valid IP address")
-	ErrListenerPortInvalid           = errors.New("field Port specified is invalid")
-	ErrHTTPListenerHostnamesEmpty    = errors.New("field Hostnames must be specified with at least a single hostname entry")
-	ErrTCPListenesSNIsEmpty          = errors.New("field SNIs must be specified with at least a single server name entry")
-	ErrTLSServerCertEmpty            = errors.New("field ServerCertificate must be specified")
-	ErrTLSPrivateKey                 = errors.New("field PrivateKey must be specified")
-	ErrHTTPRouteNameEmpty            = errors.New("field Name must be specified")
-	ErrHTTPRouteMatchEmpty           = errors.New("either PathMatch, HeaderMatches or QueryParamMatches fields must be specified")
-	ErrRouteDestinationHostInvalid   = errors.New("field Address must be a valid IP address")
-	ErrRouteDestinationPortInvalid   = errors.New("field Port specified is invalid")
-	ErrStringMatchConditionInvalid   = errors.New("only one of the Exact, Prefix or SafeRegex fields must be specified")
-	ErrDirectResponseStatusInvalid   = errors.New("only HTTP status codes 100 - 599 are supported for DirectResponse")
-	ErrRedirectUnsupportedStatus     = errors.New("only HTTP status codes 301 and 302 are supported for redirect filters")
-	ErrRedirectUnsupportedScheme     = errors.New("only http and https are supported for the scheme in redirect filters")
-	ErrHTTPPathModifierDoubleReplace = errors.New("redirect filter cannot have a path modifier that supplies both fullPathReplace and prefixMatchReplace")
-	ErrHTTPPathModifierNoReplace     = errors.New("redirect filter cannot have a path modifier that does not supply either fullPathReplace or prefixMatchReplace")
-	ErrAddHeaderEmptyName            = errors.New("header modifier filter cannot configure a header without a name to be added")
-	ErrAddHeaderDuplicate            = errors.New("header modifier filter attempts to add the same header more than once (case insensitive)")
-	ErrRemoveHeaderDuplicate         = errors.New("header modifier filter attempts to remove the same header more than once (case insensitive)")
+	ErrListenerNameEmpty              = errors.New("field Name must be specified")
+	ErrListenerAddressInvalid         = errors.New("field Address must be a valid IP address")
+	ErrListenerPortInvalid            = errors.New("field Port specified is invalid")
+	ErrHTTPListenerHostnamesEmpty     = errors.New("field Hostnames must be specified with at least a single hostname entry")
+	ErrTCPListenesSNIsEmpty           = errors.New("field SNIs must be specified with at least a single server name entry")
+	ErrHTTP3TLSEmpty                  = errors.New("field TLS must be specified to serve HTTP/3")
+	ErrClientIPHeaderNameEmpty        = errors.New("field CustomHeader.Name must be specified")
+	ErrClientIPCIDRInvalid            = errors.New("field TrustedCIDRs must only contain valid IP address ranges")
+	ErrMaxRequestHeadersInvalid       = errors.New("field MaxRequestHeadersKiB must be between 1 and 8192")
+	ErrServerHeaderInvalid            = errors.New("field ServerHeaderTransformation must be Overwrite, AppendIfAbsent or PassThrough")
+	ErrHTTP10DefaultHostInvalid       = errors.New("field DefaultHostForHTTP10 requires EnableHTTP10")
+	ErrLocalReplyStatusCodesEmpty     = errors.New("field StatusCodes must be specified with at least a single status code")
+	ErrLocalReplyStatusCodeInvalid    = errors.New("only HTTP status codes 100 - 599 are supported for local replies")
+	ErrLocalReplyMapperEmpty          = errors.New("at least one of the StatusCode or Body fields must be specified")
+	ErrLocalReplyBodyFormatInvalid    = errors.New("field Format must be Text or JSON")
+	ErrLocalReplyJSONBodyInvalid      = errors.New("field Template must be a JSON object for JSON bodies")
+	ErrTLSCertificatesEmpty           = errors.New("field Certificates must be specified with at least a single certificate")
+	ErrTLSServerCertEmpty             = errors.New("field ServerCertificate must be specified")
+	ErrTLSPrivateKey                  = errors.New("field PrivateKey must be specified")
+	ErrTLSVersionInvalid              = errors.New("fields MinVersion and MaxVersion must be one of 1.0, 1.1, 1.2 or 1.3")
+	ErrTLSVersionRangeInvalid         = errors.New("field MaxVersion must not be lower than MinVersion")
+	ErrTLSParameterEmpty              = errors.New("cipher suites, ECDH curves and ALPN protocols must not be empty")
+	ErrTLSClientCACertEmpty           = errors.New("field CACertificate must be specified for client certificate validation")
+	ErrTLSSubjectAltNameInvalid       = errors.New("subject alternative names must have a DNS, URI, EMAIL or IP type and a value")
+	ErrHTTPRouteNameEmpty             = errors.New("field Name must be specified")
+	ErrHTTPRouteMatchEmpty            = errors.New("either PathMatch, HeaderMatches or QueryParamMatches fields must be specified")
+	ErrRouteDestinationHostInvalid    = errors.New("field Address must be a valid IP address")
+	ErrRouteDestinationPortInvalid    = errors.New("field Port specified is invalid")
+	ErrTLSUpstreamNameEmpty           = errors.New("field Name must be specified for the TLS connections to a destination")
+	ErrStringMatchConditionInvalid    = errors.New("only one of the Exact, Prefix or SafeRegex fields must be specified")
+	ErrDirectResponseStatusInvalid    = errors.New("only HTTP status codes 100 - 599 are supported for DirectResponse")
+	ErrRedirectUnsupportedStatus      = errors.New("only HTTP status codes 301 and 302 are supported for redirect filters")
+	ErrRedirectUnsupportedScheme      = errors.New("only http and https are supported for the scheme in redirect filters")
+	ErrHTTPPathModifierDoubleReplace  = errors.New("redirect filter cannot have a path modifier that supplies both fullPathReplace and prefixMatchReplace")
+	ErrHTTPPathModifierNoReplace      = errors.New("redirect filter cannot have a path modifier that does not supply either fullPathReplace or prefixMatchReplace")
+	ErrAddHeaderEmptyName             = errors.New("header modifier filter cannot configure a header without a name to be added")
+	ErrAddHeaderDuplicate             = errors.New("header modifier filter attempts to add the same header more than once (case insensitive)")
+	ErrRemoveHeaderDuplicate          = errors.New("header modifier filter attempts to remove the same header more than once (case insensitive)")
+	ErrTimeoutNegative                = errors.New("timeouts must not be negative")
+	ErrRetryStatusCodeInvalid         = errors.New("only HTTP status codes 100 - 599 are supported for retriable status codes")
+	ErrRetryBackOffBaseIntervalEmpty  = errors.New("field BaseInterval must be specified when MaxInterval is set")
+	ErrRetryBackOffIntervalInvalid    = errors.New("field MaxInterval must not be lower than BaseInterval")
+	ErrRetryBudgetPercentInvalid      = errors.New("field Percent must be between 0 and 100")
+	ErrHealthCheckerInvalid           = errors.New("only one of the HTTP, TCP or GRPC fields must be specified")
+	ErrHealthCheckThresholdInvalid    = errors.New("health check thresholds must be greater than 0")
+	ErrHTTPHealthCheckPathEmpty       = errors.New("field Path must be specified for HTTP health checks")
+	ErrHTTPHealthCheckStatusInvalid   = errors.New("only HTTP status codes 100 - 599 are supported for expected statuses")
+	ErrOutlierDetectionPercentInvalid = errors.New("field MaxEjectionPercent must be between 0 and 100")
+	ErrLoadBalancerInvalid            = errors.New("only one of the RoundRobin, LeastRequest, Random or ConsistentHash fields must be specified")
+	ErrConsistentHashPolicyEmpty      = errors.New("at least one of the SourceIP, Header, Cookie or QueryParam fields must be specified")
+	ErrConsistentHashNameEmpty        = errors.New("field Name must be specified for header, cookie and query parameter hash policies")
+	ErrL4ConsistentHashInvalid        = errors.New("only source IP hashing is supported for TCP and UDP listeners")
+	ErrRateLimitRequestsInvalid       = errors.New("field Requests must be greater than 0")
+	ErrRateLimitUnitInvalid           = errors.New("field Unit must be one of Second, Minute or Hour")
+	ErrRateLimitBurstInvalid          = errors.New("field Burst must be greater than 0")
+	ErrRateLimitStatusCodeInvalid     = errors.New("field ResponseStatusCode must be between 400 and 599")
+	ErrLocalRateLimitRuleEmpty        = errors.New("field HeaderMatches must be specified with at least a single header")
+	ErrLocalRateLimitRuleUnitInvalid  = errors.New("the unit of a rule must not be shorter than the unit of the default limit")
+	ErrRateLimitRegexInvalid          = errors.New("field SafeRegex must be a valid regular expression")
+	ErrRateLimitBurstUnsupported      = errors.New("field Burst is not supported for global rate limits")
+	ErrRateLimitCIDRInvalid           = errors.New("field CIDR must be a valid IP address range")
+	ErrRateLimitServiceHostEmpty      = errors.New("field Host must be specified for the rate limit service")
+	ErrRateLimitServicePortInvalid    = errors.New("field Port must be specified for the rate limit service")
+	ErrRateLimitServiceDomainEmpty    = errors.New("field Domain must be specified for the rate limit service")
+	ErrExtAuthNameEmpty               = errors.New("field Name must be specified for the external authorization service")
+	ErrExtAuthServiceInvalid          = errors.New("only one of the GRPC or HTTP fields must be specified")
+	ErrExtAuthDestinationEmpty        = errors.New("field Destination must be specified for the external authorization service")
+	ErrOIDCNameEmpty                  = errors.New("field Name must be specified for the OIDC provider")
+	ErrOIDCEndpointInvalid            = errors.New("fields AuthorizationEndpoint and TokenEndpoint must be valid http or https URLs")
+	ErrOIDCClientIDEmpty              = errors.New("field ClientID must be specified for the OIDC provider")
+	ErrOIDCClientSecretEmpty          = errors.New("field ClientSecret must be specified for the OIDC provider")
+	ErrOIDCHMACSecretEmpty            = errors.New("field HMACSecret must be specified for the OIDC provider")
+	ErrOIDCRedirectInvalid            = errors.New("fields RedirectURL and RedirectPath must be specified, and RedirectPath must start with /")
+	ErrOIDCLogoutPathInvalid          = errors.New("field LogoutPath must start with /")
+	ErrBasicAuthNameEmpty             = errors.New("field Name must be specified for basic authentication")
+	ErrBasicAuthUsersEmpty            = errors.New("field Users must be specified with at least a single user")
+	ErrBasicAuthUserInvalid           = errors.New("user names must be specified without colons, and password digests must be SHA1 digests")
+	ErrJWTNameEmpty                   = errors.New("field Name must be specified for JWT authentication")
+	ErrJWTProvidersEmpty              = errors.New("field Providers must be specified with at least a single provider")
+	ErrJWTProviderNameInvalid         = errors.New("provider names must be specified and unique")
+	ErrJWTProviderJWKSInvalid         = errors.New("only one of the RemoteJWKS or LocalJWKS fields must be specified")
+	ErrJWTRemoteJWKSURIInvalid        = errors.New("field URI must be a valid http or https URL")
+	ErrJWTClaimToHeaderInvalid        = errors.New("fields Header and Claim must be specified for claims copied to headers")
+	ErrJWTExtractorInvalid            = errors.New("header and query parameter names must be specified")
+	ErrJWTClockSkewNegative           = errors.New("field ClockSkew must not be negative")
+	ErrIPFilterEmpty                  = errors.New("at least one of the Allow or Deny fields must be specified")
+	ErrIPFilterCIDRInvalid            = errors.New("fields Allow and Deny must only contain valid IP address ranges")
+	ErrCORSAllowOriginsEmpty          = errors.New("field AllowOrigins must be specified with at least a single origin")
+	ErrCORSOriginInvalid              = errors.New("only exact, prefix and regular expression matches are supported for allowed origins")
+	ErrCORSOriginRegexInvalid         = errors.New("field SafeRegex must be a valid regular expression for allowed origins")
+	ErrCORSMaxAgeNegative             = errors.New("field MaxAge must not be negative")
+	ErrCompressionNameEmpty           = errors.New("field Name must be specified for compression")
+	ErrCompressionAlgorithmsEmpty     = errors.New("field Algorithms must be specified with at least a single algorithm")
+	ErrCompressionAlgorithmInvalid    = errors.New("field Algorithms must only contain Gzip or Brotli")
+	ErrCompressionAlgorithmDuplicate  = errors.New("field Algorithms must not contain duplicates")
+	ErrFaultInjectionEmpty            = errors.New("at least one of the Delay or Abort fields must be specified")
+	ErrFaultDelayInvalid              = errors.New("field FixedDelay must be greater than 0")
+	ErrFaultAbortInvalid              = errors.New("only one of the HTTPStatus or GRPCStatus fields must be specified")
+	ErrFaultAbortHTTPStatusInvalid    = errors.New("only HTTP status codes 200 - 599 are supported for aborted requests")
+	ErrFaultPercentageInvalid         = errors.New("field Percentage must be between 0 and 100")
+	ErrAccessLogFormatInvalid         = errors.New("field Format must be Text or JSON")
+	ErrAccessLogJSONFieldsEmpty       = errors.New("field JSON must be specified with at least a single field for JSON access logs")
+	ErrAccessLogSinksEmpty            = errors.New("field Sinks must be specified with at least a single sink")
+	ErrAccessLogSinkInvalid           = errors.New("only one of the File, ALS or OpenTelemetry fields must be specified")
+	ErrAccessLogFilePathEmpty         = errors.New("field Path must be specified for file access logs")
+	ErrAccessLogServiceHostEmpty      = errors.New("field Host must be specified for the access log service")
+	ErrAccessLogServicePortInvalid    = errors.New("field Port must be specified for the access log service")
+	ErrAccessLogNameEmpty             = errors.New("field LogName must be specified for gRPC access logs")
+	ErrAccessLogStatusCodeInvalid     = errors.New("only HTTP status codes 100 - 599 are supported for access log filters")
+	ErrAccessLogSamplingInvalid       = errors.New("field SamplingPercent must be between 0 and 100")
+	ErrTracingServiceNameEmpty        = errors.New("field ServiceName must be specified for tracing")
+	ErrTracingProviderInvalid         = errors.New("field Provider must be OpenTelemetry, Zipkin or Datadog")
+	ErrTracingDestinationEmpty        = errors.New("field Destination must be specified for the tracing collector")
+	ErrTracingSamplingRateInvalid     = errors.New("field SamplingRate must be between 0 and 100")
+	ErrCustomTagInvalid               = errors.New("custom tags must have a name and only one of the Literal, Environment or RequestHeader fields")
+	ErrCustomTagSourceNameEmpty       = errors.New("field Name must be specified for environment and request header tags")
 )
 
 // Xds holds the intermediate representation of a Gateway and is
@@ -45,6 +148,15 @@ type Xds struct {
 	TCP []*TCPListener
 	// UDP Listeners exposed by the gateway.
 	UDP []*UDPListener
+	// RateLimitService enforcing the global rate limits of the routes.
+	RateLimitService *RateLimitService
+	// AccessLog defines the access logs of the listeners. The listeners log
+	// to stdout in the default Envoy format if it is nil.
+	AccessLog *AccessLog
+	// Tracing defines the tracing of the requests of the HTTP listeners.
+	Tracing *Tracing
+	// RequestID defines the handling of the request IDs by the HTTP listeners.
+	RequestID *RequestIDSettings
 }
 
 // Validate the fields within the Xds structure.
@@ -65,6 +177,21 @@ func (x Xds) Validate() error {
 			errs = multierror.Append(errs, err)
 		}
 	}
+	if x.RateLimitService != nil {
+		if err := x.RateLimitService.Validate(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+	if x.AccessLog != nil {
+		if err := x.AccessLog.Validate(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+	if x.Tracing != nil {
+		if err := x.Tracing.Validate(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
 	return errs
 }
 
@@ -125,6 +252,236 @@ type HTTPListener struct {
 	Routes []*HTTPRoute
 	// IsHTTP2 is set if the upstream client as well as the downstream server are configured to serve HTTP2 traffic.
 	IsHTTP2 bool
+	// HTTP3 serves HTTP/3 over QUIC on the UDP port with the same number, in
+	// addition to HTTP/1.1 and HTTP/2 over TCP, if set. It requires TLS.
+	HTTP3 *HTTP3Settings
+	// EnableProxyProtocol reads the client addresses from the PROXY protocol
+	// header sent at the start of the connections. As it is enabled on the port,
+	// it applies to all listeners sharing the port of the listener.
+	EnableProxyProtocol bool
+	// ClientIPDetection configures how the client IP address is determined from
+	// the requests. The peer address of the downstream connection is used when nil.
+	ClientIPDetection *ClientIPDetectionSettings
+	// Timeout defines the timeouts of the downstream connections and requests.
+	Timeout *ClientTimeout
+	// Headers defines the limits and transformations of the HTTP headers.
+	Headers *HeaderSettings
+	// Path defines the normalization of the request paths.
+	Path *PathSettings
+	// HTTP1 defines the settings specific to HTTP/1 clients.
+	HTTP1 *HTTP1Settings
+	// LocalReplies rewrite the responses generated by Envoy, the first mapper
+	// matching the status code of a response applies.
+	LocalReplies []*LocalReplyMapper
+}
+
+// ClientIPDetectionSettings holds the configuration of the client IP address
+// detection of a listener.
+// +k8s:deepcopy-gen=true
+type ClientIPDetectionSettings struct {
+	// XForwardedForNumTrustedHops is the number of rightmost addresses of the
+	// X-Forwarded-For header, set by trusted proxies, skipped to find the client
+	// IP address.
+	XForwardedForNumTrustedHops uint32
+	// CustomHeader takes the client IP address from a custom header, falling back
+	// to the X-Forwarded-For header, if set.
+	CustomHeader *CustomHeaderSettings
+	// TrustedCIDRs lists the address ranges of the trusted proxies, the client IP
+	// address of the connections of the other peers is their peer address. All the
+	// peers are trusted when empty.
+	TrustedCIDRs []string
+}
+
+// CustomHeaderSettings holds the configuration of the client IP address
+// detection from a custom header.
+// +k8s:deepcopy-gen=true
+type CustomHeaderSettings struct {
+	// Name of the header holding the client IP address.
+	Name string
+	// FailClosed rejects the requests without a valid IP address in the header.
+	FailClosed bool
+}
+
+// Validate the fields within the ClientIPDetectionSettings structure
+func (c *ClientIPDetectionSettings) Validate() error {
+	var errs error
+	if c.CustomHeader != nil && c.CustomHeader.Name == "" {
+		errs = multierror.Append(errs, ErrClientIPHeaderNameEmpty)
+	}
+	for _, cidr := range c.TrustedCIDRs {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			errs = multierror.Append(errs, ErrClientIPCIDRInvalid)
+			break
+		}
+	}
+	return errs
+}
+
+// ClientTimeout holds the timeouts of the downstream connections and requests.
+// Envoy's defaults are used for the nil timeouts.
+// +k8s:deepcopy-gen=true
+type ClientTimeout struct {
+	// Request is the timeout for receiving the entire request and sending the response.
+	Request *metav1.Duration
+	// StreamIdle is the timeout for a request stream without any activity.
+	StreamIdle *metav1.Duration
+	// Idle is the timeout for a connection without any active request.
+	Idle *metav1.Duration
+}
+
+// Validate the fields within the ClientTimeout structure
+func (t ClientTimeout) Validate() error {
+	var errs error
+	for _, d := range []*metav1.Duration{t.Request, t.StreamIdle, t.Idle} {
+		if d != nil && d.Duration < 0 {
+			errs = multierror.Append(errs, ErrTimeoutNegative)
+			break
+		}
+	}
+	return errs
+}
+
+// HeaderSettings holds the limits and transformations of the HTTP headers.
+// +k8s:deepcopy-gen=true
+type HeaderSettings struct {
+	// MaxRequestHeadersKiB is the maximum size of the request headers in KiB.
+	// Envoy's default is used when nil.
+	MaxRequestHeadersKiB *uint32
+	// ServerHeaderTransformation defines how the Server header of the
+	// responses is set. The header is overwritten when empty.
+	ServerHeaderTransformation ServerHeaderTransformation
+}
+
+// ServerHeaderTransformation defines how the Server header of the responses is set.
+type ServerHeaderTransformation string
+
+const (
+	// ServerHeaderOverwrite overwrites the Server header of the backend.
+	ServerHeaderOverwrite ServerHeaderTransformation = "Overwrite"
+	// ServerHeaderAppendIfAbsent sets the Server header if the backend did not set one.
+	ServerHeaderAppendIfAbsent ServerHeaderTransformation = "AppendIfAbsent"
+	// ServerHeaderPassThrough keeps the Server header of the backend, if any.
+	ServerHeaderPassThrough ServerHeaderTransformation = "PassThrough"
+)
+
+// Validate the fields within the HeaderSettings structure
+func (h HeaderSettings) Validate() error {
+	var errs error
+	if h.MaxRequestHeadersKiB != nil && (*h.MaxRequestHeadersKiB == 0 || *h.MaxRequestHeadersKiB > 8192) {
+		errs = multierror.Append(errs, ErrMaxRequestHeadersInvalid)
+	}
+	switch h.ServerHeaderTransformation {
+	case "", ServerHeaderOverwrite, ServerHeaderAppendIfAbsent, ServerHeaderPassThrough:
+	default:
+		errs = multierror.Append(errs, ErrServerHeaderInvalid)
+	}
+	return errs
+}
+
+// PathSettings holds the normalization of the request paths.
+// +k8s:deepcopy-gen=true
+type PathSettings struct {
+	// Normalize normalizes the request paths according to RFC 3986.
+	Normalize bool
+	// MergeSlashes merges the adjacent slashes of the request paths.
+	MergeSlashes bool
+}
+
+// HTTP1Settings holds the settings specific to HTTP/1 clients.
+// +k8s:deepcopy-gen=true
+type HTTP1Settings struct {
+	// PreserveHeaderCase keeps the case of the header names in the requests
+	// forwarded to the HTTP/1 backends and in their responses.
+	PreserveHeaderCase bool
+	// EnableHTTP10 accepts the HTTP/1.0 requests.
+	EnableHTTP10 bool
+	// DefaultHostForHTTP10 is the host of the HTTP/1.0 requests without a
+	// Host header. It requires EnableHTTP10.
+	DefaultHostForHTTP10 string
+}
+
+// Validate the fields within the HTTP1Settings structure
+func (h HTTP1Settings) Validate() error {
+	var errs error
+	if h.DefaultHostForHTTP10 != "" && !h.EnableHTTP10 {
+		errs = multierror.Append(errs, ErrHTTP10DefaultHostInvalid)
+	}
+	return errs
+}
+
+// LocalReplyMapper holds the rewrite of the local replies, i.e. the responses
+// generated by Envoy, with matching status codes.
+// +k8s:deepcopy-gen=true
+type LocalReplyMapper struct {
+	// StatusCodes of the rewritten local replies.
+	StatusCodes []uint32
+	// StatusCode replaces the status code of the local replies.
+	StatusCode *uint32
+	// Body replaces the body of the local replies.
+	Body *LocalReplyBody
+}
+
+// LocalReplyBody holds the template of the body of rewritten local replies,
+// which may contain the command operators of the access logs.
+// +k8s:deepcopy-gen=true
+type LocalReplyBody struct {
+	// Format of the template.
+	Format LocalReplyBodyFormat
+	// Template of the body. JSON templates are JSON objects whose string values
+	// are formatted.
+	Template string
+	// ContentType of the body. Envoy's default for the format is used when empty.
+	ContentType string
+}
+
+// LocalReplyBodyFormat is the format of the body of rewritten local replies.
+type LocalReplyBodyFormat string
+
+const (
+	LocalReplyBodyFormatText LocalReplyBodyFormat = "Text"
+	LocalReplyBodyFormatJSON LocalReplyBodyFormat = "JSON"
+)
+
+// Validate the fields within the LocalReplyMapper structure
+func (l *LocalReplyMapper) Validate() error {
+	var errs error
+	if len(l.StatusCodes) == 0 {
+		errs = multierror.Append(errs, ErrLocalReplyStatusCodesEmpty)
+	}
+	for _, code := range l.StatusCodes {
+		if code < 100 || code > 599 {
+			errs = multierror.Append(errs, ErrLocalReplyStatusCodeInvalid)
+			break
+		}
+	}
+	if l.StatusCode != nil && (*l.StatusCode < 100 || *l.StatusCode > 599) {
+		errs = multierror.Append(errs, ErrLocalReplyStatusCodeInvalid)
+	}
+	if l.StatusCode == nil && l.Body == nil {
+		errs = multierror.Append(errs, ErrLocalReplyMapperEmpty)
+	}
+	if l.Body != nil {
+		switch l.Body.Format {
+		case LocalReplyBodyFormatText:
+		case LocalReplyBodyFormatJSON:
+			var object map[string]interface{}
+			if err := json.Unmarshal([]byte(l.Body.Template), &object); err != nil || object == nil {
+				errs = multierror.Append(errs, ErrLocalReplyJSONBodyInvalid)
+			}
+		default:
+			errs = multierror.Append(errs, ErrLocalReplyBodyFormatInvalid)
+		}
+	}
+	return errs
+}
+
+// HTTP3Settings holds the configuration of HTTP/3 on a listener.
+// +k8s:deepcopy-gen=true
+type HTTP3Settings struct {
+	// AdvertisedPort is the UDP port advertised to the clients in the alt-svc
+	// header of the responses, i.e. the port on which the service can be expected
+	// to be accessed. The Port of the listener is advertised when it is zero.
+	AdvertisedPort uint32
 }
 
 // Validate the fields within the HTTPListener structure
@@ -147,6 +504,34 @@ func (h HTTPListener) Validate() error {
 			errs = multierror.Append(errs, err)
 		}
 	}
+	if h.HTTP3 != nil && h.TLS == nil {
+		errs = multierror.Append(errs, ErrHTTP3TLSEmpty)
+	}
+	if h.ClientIPDetection != nil {
+		if err := h.ClientIPDetection.Validate(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+	if h.Timeout != nil {
+		if err := h.Timeout.Validate(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+	if h.Headers != nil {
+		if err := h.Headers.Validate(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+	if h.HTTP1 != nil {
+		if err := h.HTTP1.Validate(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+	for _, mapper := range h.LocalReplies {
+		if err := mapper.Validate(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
 	for _, route := range h.Routes {
 		if err := route.Validate(); err != nil {
 			errs = multierror.Append(errs, err)
@@ -158,14 +543,71 @@ func (h HTTPListener) Validate() error {
 // TLSListenerConfig holds the configuration for downstream TLS context.
 // +k8s:deepcopy-gen=true
 type TLSListenerConfig struct {
+	// Certificates served by the listener. Envoy selects the first certificate
+	// supported by the client, e.g. an ECDSA or an RSA certificate.
+	Certificates []TLSCertificate
+	// MinVersion is the minimum TLS protocol version. Envoy's default is used when nil.
+	MinVersion *TLSVersion
+	// MaxVersion is the maximum TLS protocol version. Envoy's default is used when nil.
+	MaxVersion *TLSVersion
+	// Ciphers lists the cipher suites supported for TLS 1.2 and lower.
+	Ciphers []string
+	// ECDHCurves lists the elliptic curves supported for the key exchange.
+	ECDHCurves []string
+	// ALPNProtocols lists the application protocols negotiated with the clients,
+	// in order of preference.
+	ALPNProtocols []string
+	// ClientValidation defines the validation of the client certificates.
+	// Clients are not asked for a certificate when it is nil.
+	ClientValidation *TLSClientValidation
+}
+
+// Validate the fields within the TLSListenerConfig structure
+func (t TLSListenerConfig) Validate() error {
+	var errs error
+	if len(t.Certificates) == 0 {
+		errs = multierror.Append(errs, ErrTLSCertificatesEmpty)
+	}
+	for _, certificate := range t.Certificates {
+		if err := certificate.Validate(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+	for _, version := range []*TLSVersion{t.MinVersion, t.MaxVersion} {
+		if version != nil && !version.valid() {
+			errs = multierror.Append(errs, ErrTLSVersionInvalid)
+		}
+	}
+	if t.MinVersion != nil && t.MaxVersion != nil && t.MinVersion.valid() && t.MaxVersion.valid() &&
+		*t.MaxVersion < *t.MinVersion {
+		errs = multierror.Append(errs, ErrTLSVersionRangeInvalid)
+	}
+	for _, parameters := range [][]string{t.Ciphers, t.ECDHCurves, t.ALPNProtocols} {
+		for _, parameter := range parameters {
+			if parameter == "" {
+				errs = multierror.Append(errs, ErrTLSParameterEmpty)
+			}
+		}
+	}
+	if t.ClientValidation != nil {
+		if err := t.ClientValidation.Validate(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+	return errs
+}
+
+// TLSCertificate holds a certificate of a TLS listener and its private key.
+// +k8s:deepcopy-gen=true
+type TLSCertificate struct {
 	// ServerCertificate of the server.
 	ServerCertificate []byte
 	// PrivateKey for the server.
 	PrivateKey []byte
 }
 
-// Validate the fields within the TLSListenerConfig structure
-func (t TLSListenerConfig) Validate() error {
+// Validate the fields within the TLSCertificate structure
+func (t TLSCertificate) Validate() error {
 	var errs error
 	if len(t.ServerCertificate) == 0 {
 		errs = multierror.Append(errs, ErrTLSServerCertEmpty)
@@ -176,6 +618,86 @@ func (t TLSListenerConfig) Validate() error {
 	return errs
 }
 
+// TLSVersion is a version of the TLS protocol.
+type TLSVersion string
+
+const (
+	TLSv10 TLSVersion = "1.0"
+	TLSv11 TLSVersion = "1.1"
+	TLSv12 TLSVersion = "1.2"
+	TLSv13 TLSVersion = "1.3"
+)
+
+// valid returns true if the version is a supported TLS protocol version.
+// The supported versions sort in the same order as their names.
+func (v TLSVersion) valid() bool {
+	switch v {
+	case TLSv10, TLSv11, TLSv12, TLSv13:
+		return true
+	default:
+		return false
+	}
+}
+
+// TLSClientValidation holds the validation of the client certificates of a
+// TLS listener.
+// +k8s:deepcopy-gen=true
+type TLSClientValidation struct {
+	// CACertificate is the bundle of the CA certificates the client
+	// certificates must be signed by.
+	CACertificate []byte
+	// CRL is the certificate revocation list of the CAs, if any.
+	CRL []byte
+	// Optional accepts the connections of the clients presenting no certificate.
+	// The certificates that are presented must still be valid.
+	Optional bool
+	// SubjectAltNames lists the subject alternative names accepted in the client
+	// certificates. When empty, all the names are accepted.
+	SubjectAltNames []SubjectAltName
+	// ForwardClientCert forwards the subject and names of the client certificates
+	// to the backends in the x-forwarded-client-cert header.
+	ForwardClientCert bool
+}
+
+// Validate the fields within the TLSClientValidation structure
+func (t *TLSClientValidation) Validate() error {
+	var errs error
+	if len(t.CACertificate) == 0 {
+		errs = multierror.Append(errs, ErrTLSClientCACertEmpty)
+	}
+	for _, san := range t.SubjectAltNames {
+		switch san.Type {
+		case SubjectAltNameDNS, SubjectAltNameURI, SubjectAltNameEmail, SubjectAltNameIP:
+		default:
+			errs = multierror.Append(errs, ErrTLSSubjectAltNameInvalid)
+			continue
+		}
+		if san.Value == "" {
+			errs = multierror.Append(errs, ErrTLSSubjectAltNameInvalid)
+		}
+	}
+	return errs
+}
+
+// SubjectAltNameType is the type of a subject alternative name.
+type SubjectAltNameType string
+
+const (
+	SubjectAltNameDNS   SubjectAltNameType = "DNS"
+	SubjectAltNameURI   SubjectAltNameType = "URI"
+	SubjectAltNameEmail SubjectAltNameType = "EMAIL"
+	SubjectAltNameIP    SubjectAltNameType = "IP"
+)
+
+// SubjectAltName holds a subject alternative name of a certificate.
+// +k8s:deepcopy-gen=true
+type SubjectAltName struct {
+	// Type of the name.
+	Type SubjectAltNameType
+	// Value of the name, matched exactly.
+	Value string
+}
+
 // DestinationWeights stores the weights of valid and invalid backends for the route so that 500 error responses can be returned in the same proportions
 type BackendWeights struct {
 	Valid   uint32
@@ -209,6 +731,34 @@ type HTTPRoute struct {
 	Redirect *Redirect
 	// Destinations associated with this matched route.
 	Destinations []*RouteDestination
+	// Timeout defines the timeouts applied to this route and its backends.
+	Timeout *Timeout
+	// Retry defines the retry policy applied to this route.
+	Retry *Retry
+	// HealthCheck defines the health checks performed against the destinations of this route.
+	HealthCheck *HealthCheck
+	// CircuitBreaker defines the limits on the connections and requests sent to the destinations.
+	CircuitBreaker *CircuitBreaker
+	// LoadBalancer defines how requests are balanced across the destinations.
+	LoadBalancer *LoadBalancer
+	// RateLimit defines the rate limits applied to the requests of this route.
+	RateLimit *RateLimit
+	// ExtAuth defines the external authorization service approving the requests of this route.
+	ExtAuth *ExtAuth
+	// OIDC defines the OpenID Connect provider the users of this route log in with.
+	OIDC *OIDC
+	// BasicAuth defines the users allowed to authenticate to this route with the HTTP basic authentication scheme.
+	BasicAuth *BasicAuth
+	// JWT defines the providers of the JSON Web Tokens authenticating the requests of this route.
+	JWT *JWT
+	// IPFilter defines the client IP addresses allowed or denied access to this route.
+	IPFilter *IPFilter
+	// CORS defines the Cross-Origin Resource Sharing policy of this route.
+	CORS *CORS
+	// Compression defines the compression of the responses of this route.
+	Compression *Compression
+	// FaultInjection defines the delays and aborts injected into the requests of this route.
+	FaultInjection *FaultInjection
 }
 
 // Validate the fields within the HTTPRoute structure
@@ -250,6 +800,61 @@ func (h HTTPRoute) Validate() error {
 			errs = multierror.Append(errs, err)
 		}
 	}
+	if h.Timeout != nil {
+		if err := h.Timeout.Validate(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+	if h.Retry != nil {
+		if err := h.Retry.Validate(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+	if h.HealthCheck != nil {
+		if err := h.HealthCheck.Validate(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+	if h.LoadBalancer != nil {
+		if err := h.LoadBalancer.Validate(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+	if h.RateLimit != nil {
+		if err := h.RateLimit.Validate(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+	if h.ExtAuth != nil {
+		if err := h.ExtAuth.Validate(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+	if h.OIDC != nil {
+		if err := h.OIDC.Validate(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+	if h.BasicAuth != nil {
+		if err := h.BasicAuth.Validate(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+	if h.JWT != nil {
+		if err := h.JWT.Validate(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+	if h.IPFilter != nil {
+		if err := h.IPFilter.Validate(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+	if h.CORS != nil {
+		if err := h.CORS.Validate(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
 	if len(h.AddRequestHeaders) > 0 {
 		occurred := map[string]bool{}
 		for _, header := range h.AddRequestHeaders {
@@ -300,10 +905,21 @@ func (h HTTPRoute) Validate() error {
 			}
 		}
 	}
+	if h.Compression != nil {
+		if err := h.Compression.Validate(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+	if h.FaultInjection != nil {
+		if err := h.FaultInjection.Validate(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
 	return errs
 }
 
 // RouteDestination holds the destination details associated with the route
+// +k8s:deepcopy-gen=true
 type RouteDestination struct {
 	// Host refers to the FQDN or IP address of the backend service.
 	Host string
@@ -312,6 +928,9 @@ type RouteDestination struct {
 	// Weight associated with this destination.
 	// Note: Weight is not used in UDP route.
 	Weight uint32
+	// TLS defines the TLS connections originated to the destination.
+	// The connections are in plaintext when it is nil.
+	TLS *TLSUpstreamConfig
 }
 
 // Validate the fields within the RouteDestination structure
@@ -324,100 +943,1245 @@ func (r RouteDestination) Validate() error {
 	if r.Port == 0 {
 		errs = multierror.Append(errs, ErrRouteDestinationPortInvalid)
 	}
+	if r.TLS != nil {
+		if err := r.TLS.Validate(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
 
 	return errs
 }
 
-// Add header configures a headder to be added to a request or response.
+// TLSUpstreamConfig holds the configuration of the TLS connections originated
+// to a destination.
 // +k8s:deepcopy-gen=true
-type AddHeader struct {
-	Name   string
-	Value  string
-	Append bool
+type TLSUpstreamConfig struct {
+	// Name uniquely identifies the configuration. The destinations sharing a
+	// configuration must use the same name.
+	Name string
+	// SNI is the server name sent in the TLS handshake, if any.
+	SNI string
+	// CACertificate is the bundle of the CA certificates validating the
+	// certificates of the destination. They are not validated when it is empty.
+	CACertificate []byte
+	// ClientCertificate is presented to the destination for mutual TLS, if any.
+	ClientCertificate *TLSCertificate
 }
 
-// Validate the fields within the AddHeader structure
-func (h AddHeader) Validate() error {
+// Validate the fields within the TLSUpstreamConfig structure
+func (t TLSUpstreamConfig) Validate() error {
 	var errs error
-	if h.Name == "" {
-		errs = multierror.Append(errs, ErrAddHeaderEmptyName)
+	if t.Name == "" {
+		errs = multierror.Append(errs, ErrTLSUpstreamNameEmpty)
+	}
+	if t.ClientCertificate != nil {
+		if err := t.ClientCertificate.Validate(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
 	}
-
 	return errs
 }
 
-// Direct response holds the details for returning a body and status code for a route.
+// Timeout holds the timeouts applied to a route and its backends.
 // +k8s:deepcopy-gen=true
-type DirectResponse struct {
-	// Body configures the body of the direct response. Currently only a string response
-	// is supported, but in the future a config.core.v3.DataSource may replace it.
-	Body *string
-	// StatusCode will be used for the direct response's status code.
-	StatusCode uint32
+type Timeout struct {
+	// Request is the timeout for the entire request, including all retries.
+	Request *metav1.Duration
+	// Idle is the timeout for a request stream without any activity.
+	Idle *metav1.Duration
+	// Connect is the timeout for establishing a connection to a backend.
+	Connect *metav1.Duration
 }
 
-// Validate the fields within the DirectResponse structure
-func (r DirectResponse) Validate() error {
+// Validate the fields within the Timeout structure
+func (t Timeout) Validate() error {
 	var errs error
-	if status := r.StatusCode; status > 599 || status < 100 {
-		errs = multierror.Append(errs, ErrDirectResponseStatusInvalid)
+	for _, d := range []*metav1.Duration{t.Request, t.Idle, t.Connect} {
+		if d != nil && d.Duration < 0 {
+			errs = multierror.Append(errs, ErrTimeoutNegative)
+			break
+		}
 	}
 
 	return errs
 }
 
-// Redirect holds the details for how and where to redirect a request
+// Retry holds the retry policy applied to a route.
 // +k8s:deepcopy-gen=true
-type Redirect struct {
-	// Scheme configures the replacement of the request's scheme.
-	Scheme *string
-	// Hostname configures the replacement of the request's hostname.
-	Hostname *string
-	// Path contains config for rewriting the path of the request.
-	Path *HTTPPathModifier
-	// Port configures the replacement of the request's port.
-	Port *uint32
-	// Status code configures the redirection response's status code.
-	StatusCode *int32
+type Retry struct {
+	// NumRetries is the number of retries to be attempted.
+	NumRetries *uint32
+	// RetryOn specifies the conditions under which a request is retried.
+	RetryOn *RetryOn
+	// PerRetry holds the timeout and backoff applied to each retry attempt.
+	PerRetry *PerRetryPolicy
+	// Budget limits the number of concurrent retries to the backends.
+	Budget *RetryBudget
 }
 
-// Validate the fields within the Redirect structure
-func (r Redirect) Validate() error {
+// Validate the fields within the Retry structure
+func (r Retry) Validate() error {
 	var errs error
-
-	if r.Scheme != nil {
-		if *r.Scheme != "http" && *r.Scheme != "https" {
-			errs = multierror.Append(errs, ErrRedirectUnsupportedScheme)
+	if r.RetryOn != nil {
+		for _, code := range r.RetryOn.HTTPStatusCodes {
+			if code < 100 || code > 599 {
+				errs = multierror.Append(errs, ErrRetryStatusCodeInvalid)
+				break
+			}
 		}
 	}
-
-	if r.Path != nil {
-		if err := r.Path.Validate(); err != nil {
-			errs = multierror.Append(errs, err)
+	if r.PerRetry != nil {
+		if t := r.PerRetry.Timeout; t != nil && t.Duration < 0 {
+			errs = multierror.Append(errs, ErrTimeoutNegative)
 		}
-	}
-
-	if r.StatusCode != nil {
-		if *r.StatusCode != 301 && *r.StatusCode != 302 {
-			errs = multierror.Append(errs, ErrRedirectUnsupportedStatus)
+		if b := r.PerRetry.BackOff; b != nil && b.MaxInterval != nil {
+			if b.BaseInterval == nil {
+				errs = multierror.Append(errs, ErrRetryBackOffBaseIntervalEmpty)
+			} else if b.MaxInterval.Duration < b.BaseInterval.Duration {
+				errs = multierror.Append(errs, ErrRetryBackOffIntervalInvalid)
+			}
 		}
 	}
+	if r.Budget != nil && r.Budget.Percent != nil && *r.Budget.Percent > 100 {
+		errs = multierror.Append(errs, ErrRetryBudgetPercentInvalid)
+	}
 
 	return errs
 }
 
-// HTTPPathModifier holds instructions for how to modify the path of a request on a redirect response
+// TriggerEnum specifies a condition that triggers a retry.
+type TriggerEnum string
+
+const (
+	Error5XX             TriggerEnum = "5xx"
+	GatewayError         TriggerEnum = "gateway-error"
+	Reset                TriggerEnum = "reset"
+	ConnectFailure       TriggerEnum = "connect-failure"
+	Retriable4XX         TriggerEnum = "retriable-4xx"
+	RefusedStream        TriggerEnum = "refused-stream"
+	RetriableStatusCodes TriggerEnum = "retriable-status-codes"
+)
+
+// RetryOn holds the conditions under which a request is retried.
 // +k8s:deepcopy-gen=true
-type HTTPPathModifier struct {
-	// FullReplace provides a string to replace the full path of the request.
-	FullReplace *string
-	// PrefixMatchReplace provides a string to replace the matched prefix of the request.
-	PrefixMatchReplace *string
+type RetryOn struct {
+	// Triggers specifies the retry conditions.
+	Triggers []TriggerEnum
+	// HTTPStatusCodes specifies the response status codes that trigger a retry.
+	HTTPStatusCodes []uint32
 }
 
-// Validate the fields within the HTTPPathModifier structure
-func (r HTTPPathModifier) Validate() error {
-	var errs error
+// PerRetryPolicy holds the timeout and backoff applied to each retry attempt.
+// +k8s:deepcopy-gen=true
+type PerRetryPolicy struct {
+	// Timeout is the timeout for each retry attempt.
+	Timeout *metav1.Duration
+	// BackOff is the exponential backoff between retry attempts.
+	BackOff *BackOffPolicy
+}
+
+// BackOffPolicy holds the exponential backoff between retry attempts.
+// +k8s:deepcopy-gen=true
+type BackOffPolicy struct {
+	// BaseInterval is the base interval between retries.
+	BaseInterval *metav1.Duration
+	// MaxInterval is the maximum interval between retries.
+	MaxInterval *metav1.Duration
+}
+
+// RetryBudget limits the number of concurrent retries to the backends.
+// +k8s:deepcopy-gen=true
+type RetryBudget struct {
+	// Percent is the maximum percentage of active requests that may be retries.
+	Percent *uint32
+	// MinRetryConcurrency is the number of concurrent retries that are always allowed.
+	MinRetryConcurrency *uint32
+}
+
+// LoadBalancer holds the load balancing policy used to pick a destination.
+// Only one of the fields must be set. Round robin is used when none is set.
+// +k8s:deepcopy-gen=true
+type LoadBalancer struct {
+	// RoundRobin picks the destinations in turn.
+	RoundRobin *RoundRobin
+	// LeastRequest picks the destination with the fewest active requests.
+	LeastRequest *LeastRequest
+	// Random picks a random destination.
+	Random *Random
+	// ConsistentHash picks the destination based on a hash of the request.
+	ConsistentHash *ConsistentHash
+}
+
+// Validate the fields within the LoadBalancer structure
+func (l *LoadBalancer) Validate() error {
+	var errs error
+	matchCount := 0
+	if l.RoundRobin != nil {
+		matchCount++
+	}
+	if l.LeastRequest != nil {
+		matchCount++
+	}
+	if l.Random != nil {
+		matchCount++
+	}
+	if l.ConsistentHash != nil {
+		matchCount++
+		if err := l.ConsistentHash.Validate(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+	if matchCount > 1 {
+		errs = multierror.Append(errs, ErrLoadBalancerInvalid)
+	}
+	return errs
+}
+
+// validateL4 validates the load balancer of a TCP or UDP listener, which can
+// only hash on the source IP of the connection.
+func (l *LoadBalancer) validateL4() error {
+	if err := l.Validate(); err != nil {
+		return err
+	}
+	if hash := l.ConsistentHash; hash != nil &&
+		(hash.Header != nil || hash.Cookie != nil || hash.QueryParam != nil) {
+		return ErrL4ConsistentHashInvalid
+	}
+	return nil
+}
+
+// RoundRobin holds the round robin load balancing settings.
+// +k8s:deepcopy-gen=true
+type RoundRobin struct{}
+
+// LeastRequest holds the least request load balancing settings.
+// +k8s:deepcopy-gen=true
+type LeastRequest struct {
+	// ChoiceCount is the number of random destinations compared to pick the least loaded one.
+	ChoiceCount *uint32
+}
+
+// Random holds the random load balancing settings.
+// +k8s:deepcopy-gen=true
+type Random struct{}
+
+// ConsistentHashAlgorithm is the algorithm used to map hashes to destinations.
+type ConsistentHashAlgorithm string
+
+const (
+	// RingHashAlgorithm maps hashes to destinations using a Ketama ring.
+	RingHashAlgorithm ConsistentHashAlgorithm = "RingHash"
+	// MaglevAlgorithm maps hashes to destinations using a Maglev lookup table.
+	MaglevAlgorithm ConsistentHashAlgorithm = "Maglev"
+)
+
+// ConsistentHash holds the consistent hashing load balancing settings.
+// The hash policies are evaluated in the order SourceIP, Header, Cookie and QueryParam.
+// +k8s:deepcopy-gen=true
+type ConsistentHash struct {
+	// Algorithm used to map hashes to destinations. Defaults to RingHash.
+	Algorithm ConsistentHashAlgorithm
+	// SourceIP hashes on the IP address of the client.
+	SourceIP bool
+	// Header hashes on the value of a request header.
+	Header *HeaderHashPolicy
+	// Cookie hashes on the value of a cookie, generating it when missing.
+	Cookie *CookieHashPolicy
+	// QueryParam hashes on the value of a query parameter.
+	QueryParam *QueryParamHashPolicy
+}
+
+// Validate the fields within the ConsistentHash structure
+func (c *ConsistentHash) Validate() error {
+	var errs error
+	if !c.SourceIP && c.Header == nil && c.Cookie == nil && c.QueryParam == nil {
+		errs = multierror.Append(errs, ErrConsistentHashPolicyEmpty)
+	}
+	if (c.Header != nil && c.Header.Name == "") ||
+		(c.Cookie != nil && c.Cookie.Name == "") ||
+		(c.QueryParam != nil && c.QueryParam.Name == "") {
+		errs = multierror.Append(errs, ErrConsistentHashNameEmpty)
+	}
+	if c.Cookie != nil && c.Cookie.TTL != nil && c.Cookie.TTL.Duration < 0 {
+		errs = multierror.Append(errs, ErrTimeoutNegative)
+	}
+	return errs
+}
+
+// HeaderHashPolicy hashes on the value of a request header.
+// +k8s:deepcopy-gen=true
+type HeaderHashPolicy struct {
+	// Name of the header.
+	Name string
+}
+
+// CookieHashPolicy hashes on the value of a cookie.
+// +k8s:deepcopy-gen=true
+type CookieHashPolicy struct {
+	// Name of the cookie.
+	Name string
+	// TTL of the cookie generated when the request does not carry one.
+	// No cookie is generated when unset.
+	TTL *metav1.Duration
+	// Path of the generated cookie.
+	Path *string
+}
+
+// QueryParamHashPolicy hashes on the value of a query parameter.
+// +k8s:deepcopy-gen=true
+type QueryParamHashPolicy struct {
+	// Name of the query parameter.
+	Name string
+}
+
+// RateLimit holds the rate limits applied to the requests of a route.
+// +k8s:deepcopy-gen=true
+type RateLimit struct {
+	// Local rate limits are enforced by each Envoy proxy on its own.
+	Local *LocalRateLimit
+	// Global rate limits are enforced by the rate limit service, across
+	// all Envoy proxies.
+	Global *GlobalRateLimit
+}
+
+// Validate the fields within the RateLimit structure
+func (r *RateLimit) Validate() error {
+	var errs error
+	if r.Local != nil {
+		if err := r.Local.Validate(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+	if r.Global != nil {
+		if err := r.Global.Validate(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+	return errs
+}
+
+// LocalRateLimit holds the token buckets enforced by each Envoy proxy.
+// +k8s:deepcopy-gen=true
+type LocalRateLimit struct {
+	// Default is the rate limit applied to all requests.
+	Default RateLimitValue
+	// Rules are the rate limits applied to the requests matching their headers,
+	// in addition to the default rate limit.
+	Rules []*LocalRateLimitRule
+	// ResponseStatusCode is the status code of the rate limited responses.
+	// Defaults to 429.
+	ResponseStatusCode *uint32
+	// ResponseHeaders are added to the rate limited responses.
+	ResponseHeaders []AddHeader
+}
+
+// Validate the fields within the LocalRateLimit structure
+func (l *LocalRateLimit) Validate() error {
+	var errs error
+	if err := l.Default.Validate(); err != nil {
+		errs = multierror.Append(errs, err)
+	}
+	for _, rule := range l.Rules {
+		if len(rule.HeaderMatches) == 0 {
+			errs = multierror.Append(errs, ErrLocalRateLimitRuleEmpty)
+		}
+		for _, match := range rule.HeaderMatches {
+			if err := match.Validate(); err != nil {
+				errs = multierror.Append(errs, err)
+			}
+			if match.SafeRegex != nil {
+				if _, err := regexp.Compile(*match.SafeRegex); err != nil {
+					errs = multierror.Append(errs, ErrRateLimitRegexInvalid)
+				}
+			}
+		}
+		if err := rule.Limit.Validate(); err != nil {
+			errs = multierror.Append(errs, err)
+		} else if l.Default.Validate() == nil && rule.Limit.Unit.Duration() < l.Default.Unit.Duration() {
+			// Envoy requires the fill interval of the descriptors to be a multiple
+			// of the fill interval of the default token bucket.
+			errs = multierror.Append(errs, ErrLocalRateLimitRuleUnitInvalid)
+		}
+	}
+	if l.ResponseStatusCode != nil && (*l.ResponseStatusCode < 400 || *l.ResponseStatusCode > 599) {
+		errs = multierror.Append(errs, ErrRateLimitStatusCodeInvalid)
+	}
+	for _, header := range l.ResponseHeaders {
+		if err := header.Validate(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+	return errs
+}
+
+// LocalRateLimitRule holds a rate limit applied to the requests matching all its headers.
+// +k8s:deepcopy-gen=true
+type LocalRateLimitRule struct {
+	// HeaderMatches the request must match.
+	HeaderMatches []*StringMatch
+	// Limit applied to the matching requests.
+	Limit RateLimitValue
+}
+
+// GlobalRateLimit holds the rate limits enforced by the rate limit service.
+// +k8s:deepcopy-gen=true
+type GlobalRateLimit struct {
+	// Rules are the rate limits applied to the requests matching their selectors.
+	Rules []*GlobalRateLimitRule
+}
+
+// Validate the fields within the GlobalRateLimit structure
+func (g *GlobalRateLimit) Validate() error {
+	var errs error
+	for _, rule := range g.Rules {
+		for _, match := range rule.HeaderMatches {
+			if err := match.Validate(); err != nil {
+				errs = multierror.Append(errs, err)
+			}
+			if match.SafeRegex != nil {
+				if _, err := regexp.Compile(*match.SafeRegex); err != nil {
+					errs = multierror.Append(errs, ErrRateLimitRegexInvalid)
+				}
+			}
+		}
+		if rule.PathMatch != nil {
+			if err := rule.PathMatch.Validate(); err != nil {
+				errs = multierror.Append(errs, err)
+			}
+		}
+		if rule.ClientIP != nil && rule.ClientIP.CIDR != nil {
+			if _, _, err := net.ParseCIDR(*rule.ClientIP.CIDR); err != nil {
+				errs = multierror.Append(errs, ErrRateLimitCIDRInvalid)
+			}
+		}
+		if err := rule.Limit.Validate(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+		if rule.Limit.Burst != nil {
+			errs = multierror.Append(errs, ErrRateLimitBurstUnsupported)
+		}
+	}
+	return errs
+}
+
+// GlobalRateLimitRule holds a rate limit applied to the requests matching all
+// its selectors. A rule without selectors applies to all requests.
+// +k8s:deepcopy-gen=true
+type GlobalRateLimitRule struct {
+	// HeaderMatches the request must match. Distinct matches give each value
+	// of the header its own limit.
+	HeaderMatches []*StringMatch
+	// ClientIP of the request.
+	ClientIP *ClientIPMatch
+	// PathMatch the request must match.
+	PathMatch *StringMatch
+	// Limit applied to the matching requests.
+	Limit RateLimitValue
+}
+
+// ClientIPMatch selects the requests based on the IP address of the client.
+// +k8s:deepcopy-gen=true
+type ClientIPMatch struct {
+	// CIDR the client IP address must belong to, all the clients of the
+	// range sharing the same limit. If unset, each client IP address gets
+	// its own limit.
+	CIDR *string
+}
+
+// RateLimitService holds the location of the rate limit service.
+// +k8s:deepcopy-gen=true
+type RateLimitService struct {
+	// Host of the rate limit service.
+	Host string
+	// Port of the gRPC endpoint of the rate limit service.
+	Port uint32
+	// Domain of the rate limit descriptors sent to the service.
+	Domain string
+}
+
+// Validate the fields within the RateLimitService structure
+func (r *RateLimitService) Validate() error {
+	var errs error
+	if r.Host == "" {
+		errs = multierror.Append(errs, ErrRateLimitServiceHostEmpty)
+	}
+	if r.Port == 0 {
+		errs = multierror.Append(errs, ErrRateLimitServicePortInvalid)
+	}
+	if r.Domain == "" {
+		errs = multierror.Append(errs, ErrRateLimitServiceDomainEmpty)
+	}
+	return errs
+}
+
+// ExtAuth holds the external authorization service approving the requests.
+// +k8s:deepcopy-gen=true
+type ExtAuth struct {
+	// Name uniquely identifies the external authorization service. Routes
+	// sharing the same service share the same name.
+	Name string
+	// GRPC defines a gRPC authorization service.
+	GRPC *GRPCExtAuthService
+	// HTTP defines an HTTP authorization service.
+	HTTP *HTTPExtAuthService
+	// Timeout is the timeout of the authorization requests.
+	Timeout *metav1.Duration
+	// FailOpen allows the requests when the authorization service fails.
+	FailOpen bool
+}
+
+// Validate the fields within the ExtAuth structure
+func (e *ExtAuth) Validate() error {
+	var errs error
+	if e.Name == "" {
+		errs = multierror.Append(errs, ErrExtAuthNameEmpty)
+	}
+	var destination *RouteDestination
+	switch {
+	case e.GRPC != nil && e.HTTP == nil:
+		destination = e.GRPC.Destination
+	case e.HTTP != nil && e.GRPC == nil:
+		destination = e.HTTP.Destination
+	default:
+		errs = multierror.Append(errs, ErrExtAuthServiceInvalid)
+	}
+	if (e.GRPC != nil || e.HTTP != nil) && destination == nil {
+		errs = multierror.Append(errs, ErrExtAuthDestinationEmpty)
+	} else if destination != nil {
+		if err := destination.Validate(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+	if e.Timeout != nil && e.Timeout.Duration < 0 {
+		errs = multierror.Append(errs, ErrTimeoutNegative)
+	}
+	return errs
+}
+
+// GRPCExtAuthService holds the location of a gRPC authorization service.
+// +k8s:deepcopy-gen=true
+type GRPCExtAuthService struct {
+	// Destination of the authorization service.
+	Destination *RouteDestination
+}
+
+// HTTPExtAuthService holds the location of an HTTP authorization service
+// and the headers exchanged with it.
+// +k8s:deepcopy-gen=true
+type HTTPExtAuthService struct {
+	// Destination of the authorization service.
+	Destination *RouteDestination
+	// Path is the prefix added to the path of the authorization requests.
+	Path string
+	// HeadersToExtAuth are the request headers forwarded to the service.
+	HeadersToExtAuth []string
+	// HeadersToBackend are the headers of an approving response added to the request.
+	HeadersToBackend []string
+	// HeadersToClient are the headers of a denying response sent to the client.
+	HeadersToClient []string
+}
+
+// OIDC holds the OpenID Connect provider the users log in with, and the
+// client registered with it.
+// +k8s:deepcopy-gen=true
+type OIDC struct {
+	// Name uniquely identifies the provider configuration. Routes sharing the
+	// same configuration share the same name.
+	Name string
+	// AuthorizationEndpoint is the URL of the login page of the provider.
+	AuthorizationEndpoint string
+	// TokenEndpoint is the URL from which the tokens are retrieved.
+	TokenEndpoint string
+	// ClientID is the ID of the client registered with the provider.
+	ClientID string
+	// ClientSecret is the secret of the client registered with the provider.
+	ClientSecret []byte
+	// HMACSecret is the secret used to sign the cookies set once logged in.
+	HMACSecret []byte
+	// RedirectURL is the URL the provider redirects the users to once logged in.
+	RedirectURL string
+	// RedirectPath is the path of RedirectURL, handled by Envoy.
+	RedirectPath string
+	// LogoutPath is the path clearing the cookies.
+	LogoutPath string
+	// Scopes are the scopes requested to the provider.
+	Scopes []string
+	// AccessTokenCookie is the name of the cookie holding the access token, if not the default one.
+	AccessTokenCookie string
+	// HMACCookie is the name of the cookie holding the signature, if not the default one.
+	HMACCookie string
+	// ExpiresCookie is the name of the cookie holding the expiry, if not the default one.
+	ExpiresCookie string
+	// ForwardAccessToken forwards the access token to the backends.
+	ForwardAccessToken bool
+}
+
+// Validate the fields within the OIDC structure
+func (o *OIDC) Validate() error {
+	var errs error
+	if o.Name == "" {
+		errs = multierror.Append(errs, ErrOIDCNameEmpty)
+	}
+	for _, endpoint := range []string{o.AuthorizationEndpoint, o.TokenEndpoint} {
+		u, err := url.Parse(endpoint)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			errs = multierror.Append(errs, ErrOIDCEndpointInvalid)
+			break
+		}
+	}
+	if o.ClientID == "" {
+		errs = multierror.Append(errs, ErrOIDCClientIDEmpty)
+	}
+	if len(o.ClientSecret) == 0 {
+		errs = multierror.Append(errs, ErrOIDCClientSecretEmpty)
+	}
+	if len(o.HMACSecret) == 0 {
+		errs = multierror.Append(errs, ErrOIDCHMACSecretEmpty)
+	}
+	if o.RedirectURL == "" || !strings.HasPrefix(o.RedirectPath, "/") {
+		errs = multierror.Append(errs, ErrOIDCRedirectInvalid)
+	}
+	if !strings.HasPrefix(o.LogoutPath, "/") {
+		errs = multierror.Append(errs, ErrOIDCLogoutPathInvalid)
+	}
+	return errs
+}
+
+// BasicAuth holds the users allowed to authenticate with the HTTP basic
+// authentication scheme.
+// +k8s:deepcopy-gen=true
+type BasicAuth struct {
+	// Name uniquely identifies the users. Routes sharing the same users
+	// share the same name.
+	Name string
+	// Users allowed to authenticate.
+	Users []*BasicAuthUser
+}
+
+// Validate the fields within the BasicAuth structure
+func (b *BasicAuth) Validate() error {
+	var errs error
+	if b.Name == "" {
+		errs = multierror.Append(errs, ErrBasicAuthNameEmpty)
+	}
+	if len(b.Users) == 0 {
+		errs = multierror.Append(errs, ErrBasicAuthUsersEmpty)
+	}
+	for _, user := range b.Users {
+		if user.Name == "" || strings.Contains(user.Name, ":") || len(user.PasswordSHA1) != sha1.Size {
+			errs = multierror.Append(errs, ErrBasicAuthUserInvalid)
+			break
+		}
+	}
+	return errs
+}
+
+// BasicAuthUser holds a user allowed to authenticate with the HTTP basic
+// authentication scheme.
+// +k8s:deepcopy-gen=true
+type BasicAuthUser struct {
+	// Name of the user.
+	Name string
+	// PasswordSHA1 is the SHA1 digest of the password of the user.
+	PasswordSHA1 []byte
+}
+
+// JWT holds the providers of the JSON Web Tokens (JWT) authenticating the
+// requests. A request is authenticated if any of the providers verifies its JWT.
+// +k8s:deepcopy-gen=true
+type JWT struct {
+	// Name uniquely identifies the providers. Routes sharing the same
+	// providers share the same name.
+	Name string
+	// Providers verifying the JWTs.
+	Providers []*JWTProvider
+}
+
+// Validate the fields within the JWT structure
+func (j *JWT) Validate() error {
+	var errs error
+	if j.Name == "" {
+		errs = multierror.Append(errs, ErrJWTNameEmpty)
+	}
+	if len(j.Providers) == 0 {
+		errs = multierror.Append(errs, ErrJWTProvidersEmpty)
+	}
+	names := map[string]bool{}
+	for _, provider := range j.Providers {
+		if provider.Name == "" || names[provider.Name] {
+			errs = multierror.Append(errs, ErrJWTProviderNameInvalid)
+		}
+		names[provider.Name] = true
+		if err := provider.Validate(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+	return errs
+}
+
+// JWTProvider holds how the JWTs of a provider are verified, and how the
+// verified JWTs are passed to the backends.
+// +k8s:deepcopy-gen=true
+type JWTProvider struct {
+	// Name of the provider, unique within the JWT authentication.
+	Name string
+	// Issuer is the expected issuer of the JWTs. It is not checked if empty.
+	Issuer string
+	// Audiences are the accepted audiences of the JWTs. They are not checked if empty.
+	Audiences []string
+	// RemoteJWKS defines the endpoint from which the JSON Web Key Set is fetched.
+	RemoteJWKS *RemoteJWKS
+	// LocalJWKS is the JSON Web Key Set, used instead of fetching it from an endpoint.
+	LocalJWKS string
+	// ForwardToken forwards the JWT to the backends.
+	ForwardToken bool
+	// ClaimToHeaders defines the request headers set to claims of the JWT.
+	ClaimToHeaders []ClaimToHeader
+	// FromHeaders defines the request headers the JWT is extracted from.
+	FromHeaders []JWTHeaderExtractor
+	// FromParams defines the query parameters the JWT is extracted from.
+	FromParams []string
+	// ClockSkew is the tolerance applied when checking the expiration of the JWT.
+	ClockSkew *metav1.Duration
+}
+
+// Validate the fields within the JWTProvider structure
+func (j *JWTProvider) Validate() error {
+	var errs error
+	if (j.RemoteJWKS == nil) == (j.LocalJWKS == "") {
+		errs = multierror.Append(errs, ErrJWTProviderJWKSInvalid)
+	}
+	if j.RemoteJWKS != nil {
+		u, err := url.Parse(j.RemoteJWKS.URI)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			errs = multierror.Append(errs, ErrJWTRemoteJWKSURIInvalid)
+		}
+	}
+	for _, claimToHeader := range j.ClaimToHeaders {
+		if claimToHeader.Header == "" || claimToHeader.Claim == "" {
+			errs = multierror.Append(errs, ErrJWTClaimToHeaderInvalid)
+			break
+		}
+	}
+	for _, header := range j.FromHeaders {
+		if header.Name == "" {
+			errs = multierror.Append(errs, ErrJWTExtractorInvalid)
+			break
+		}
+	}
+	for _, param := range j.FromParams {
+		if param == "" {
+			errs = multierror.Append(errs, ErrJWTExtractorInvalid)
+			break
+		}
+	}
+	if j.ClockSkew != nil && j.ClockSkew.Duration < 0 {
+		errs = multierror.Append(errs, ErrJWTClockSkewNegative)
+	}
+	return errs
+}
+
+// RemoteJWKS holds the endpoint from which a JSON Web Key Set is fetched.
+// +k8s:deepcopy-gen=true
+type RemoteJWKS struct {
+	// URI of the JSON Web Key Set.
+	URI string
+}
+
+// ClaimToHeader holds a request header set to the value of a claim of the JWT.
+// +k8s:deepcopy-gen=true
+type ClaimToHeader struct {
+	// Header is the name of the request header.
+	Header string
+	// Claim is the name of the claim.
+	Claim string
+}
+
+// JWTHeaderExtractor holds a request header the JWT is extracted from.
+// +k8s:deepcopy-gen=true
+type JWTHeaderExtractor struct {
+	// Name of the header.
+	Name string
+	// ValuePrefix is the prefix preceding the JWT in the value of the header.
+	ValuePrefix string
+}
+
+// IPFilter holds the client IP addresses allowed or denied access to a route
+// or listener.
+// +k8s:deepcopy-gen=true
+type IPFilter struct {
+	// Allow lists the CIDRs of the clients allowed access. When empty, all
+	// the clients not denied are allowed.
+	Allow []string
+	// Deny lists the CIDRs of the clients denied access, taking precedence over Allow.
+	Deny []string
+	// UseXForwardedFor takes the client IP address from the X-Forwarded-For
+	// header rather than from the downstream connection. Only for HTTP routes.
+	UseXForwardedFor bool
+}
+
+// Validate the fields within the IPFilter structure
+func (i *IPFilter) Validate() error {
+	var errs error
+	if len(i.Allow) == 0 && len(i.Deny) == 0 {
+		errs = multierror.Append(errs, ErrIPFilterEmpty)
+	}
+	for _, cidr := range append(append([]string{}, i.Allow...), i.Deny...) {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			errs = multierror.Append(errs, ErrIPFilterCIDRInvalid)
+			break
+		}
+	}
+	return errs
+}
+
+// CORS holds the Cross-Origin Resource Sharing policy of a route.
+// +k8s:deepcopy-gen=true
+type CORS struct {
+	// AllowOrigins match the origins allowed to make cross-origin requests.
+	AllowOrigins []*StringMatch
+	// AllowMethods lists the methods allowed in cross-origin requests.
+	AllowMethods []string
+	// AllowHeaders lists the request headers allowed in cross-origin requests.
+	AllowHeaders []string
+	// ExposeHeaders lists the response headers exposed to the pages.
+	ExposeHeaders []string
+	// MaxAge is how long the result of a preflight request may be cached.
+	MaxAge *metav1.Duration
+	// AllowCredentials allows the cross-origin requests to include credentials.
+	AllowCredentials bool
+}
+
+// Validate the fields within the CORS structure
+func (c *CORS) Validate() error {
+	var errs error
+	if len(c.AllowOrigins) == 0 {
+		errs = multierror.Append(errs, ErrCORSAllowOriginsEmpty)
+	}
+	for _, origin := range c.AllowOrigins {
+		if err := origin.Validate(); err != nil {
+			errs = multierror.Append(errs, err)
+			continue
+		}
+		if origin.Suffix != nil || origin.Distinct {
+			errs = multierror.Append(errs, ErrCORSOriginInvalid)
+		}
+		if origin.SafeRegex != nil {
+			if _, err := regexp.Compile(*origin.SafeRegex); err != nil {
+				errs = multierror.Append(errs, ErrCORSOriginRegexInvalid)
+			}
+		}
+	}
+	if c.MaxAge != nil && c.MaxAge.Duration < 0 {
+		errs = multierror.Append(errs, ErrCORSMaxAgeNegative)
+	}
+	return errs
+}
+
+// Compression holds the compression of the responses of a route.
+// +k8s:deepcopy-gen=true
+type Compression struct {
+	// Name uniquely identifies the compression settings. Routes sharing the
+	// same settings share the same name.
+	Name string
+	// Algorithms lists the compression algorithms, in order of preference.
+	Algorithms []CompressionAlgorithm
+	// MinContentLength is the minimum size in bytes of the compressed responses.
+	// Envoy's default is used when nil.
+	MinContentLength *uint32
+	// ContentTypes lists the media types of the compressed responses. Envoy's
+	// default types are used when empty.
+	ContentTypes []string
+}
+
+// CompressionAlgorithm is an algorithm compressing the responses.
+type CompressionAlgorithm string
+
+const (
+	GzipCompressionAlgorithm   CompressionAlgorithm = "Gzip"
+	BrotliCompressionAlgorithm CompressionAlgorithm = "Brotli"
+)
+
+// Validate the fields within the Compression structure
+func (c *Compression) Validate() error {
+	var errs error
+	if c.Name == "" {
+		errs = multierror.Append(errs, ErrCompressionNameEmpty)
+	}
+	if len(c.Algorithms) == 0 {
+		errs = multierror.Append(errs, ErrCompressionAlgorithmsEmpty)
+	}
+	occurred := map[CompressionAlgorithm]bool{}
+	for _, algorithm := range c.Algorithms {
+		switch {
+		case algorithm != GzipCompressionAlgorithm && algorithm != BrotliCompressionAlgorithm:
+			errs = multierror.Append(errs, ErrCompressionAlgorithmInvalid)
+		case occurred[algorithm]:
+			errs = multierror.Append(errs, ErrCompressionAlgorithmDuplicate)
+		}
+		occurred[algorithm] = true
+	}
+	return errs
+}
+
+// FaultInjection holds the faults injected into the requests of a route.
+// +k8s:deepcopy-gen=true
+type FaultInjection struct {
+	// Delay defines the delay injected before the requests are forwarded.
+	Delay *FaultInjectionDelay
+	// Abort defines the status of the aborted requests.
+	Abort *FaultInjectionAbort
+	// HeaderMatches the request must match for the faults to be injected.
+	// Faults are injected into all requests when empty.
+	HeaderMatches []*StringMatch
+}
+
+// FaultInjectionDelay holds the delay injected into the requests of a route.
+// +k8s:deepcopy-gen=true
+type FaultInjectionDelay struct {
+	// FixedDelay is the delay added before the requests are forwarded.
+	FixedDelay metav1.Duration
+	// Percentage of the requests delayed.
+	Percentage uint32
+}
+
+// FaultInjectionAbort holds the status of the requests of a route aborted
+// by the fault injection.
+// +k8s:deepcopy-gen=true
+type FaultInjectionAbort struct {
+	// HTTPStatus is the HTTP status of the aborted requests.
+	HTTPStatus *uint32
+	// GRPCStatus is the gRPC status of the aborted requests.
+	GRPCStatus *uint32
+	// Percentage of the requests aborted.
+	Percentage uint32
+}
+
+// Validate the fields within the FaultInjection structure
+func (f *FaultInjection) Validate() error {
+	var errs error
+	if f.Delay == nil && f.Abort == nil {
+		errs = multierror.Append(errs, ErrFaultInjectionEmpty)
+	}
+	if f.Delay != nil {
+		if f.Delay.FixedDelay.Duration <= 0 {
+			errs = multierror.Append(errs, ErrFaultDelayInvalid)
+		}
+		if f.Delay.Percentage > 100 {
+			errs = multierror.Append(errs, ErrFaultPercentageInvalid)
+		}
+	}
+	if f.Abort != nil {
+		if (f.Abort.HTTPStatus == nil) == (f.Abort.GRPCStatus == nil) {
+			errs = multierror.Append(errs, ErrFaultAbortInvalid)
+		}
+		if f.Abort.HTTPStatus != nil && (*f.Abort.HTTPStatus < 200 || *f.Abort.HTTPStatus > 599) {
+			errs = multierror.Append(errs, ErrFaultAbortHTTPStatusInvalid)
+		}
+		if f.Abort.Percentage > 100 {
+			errs = multierror.Append(errs, ErrFaultPercentageInvalid)
+		}
+	}
+	for _, match := range f.HeaderMatches {
+		if err := match.Validate(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+	return errs
+}
+
+// RateLimitUnit is the unit of time of a rate limit.
+type RateLimitUnit string
+
+const (
+	Second RateLimitUnit = "Second"
+	Minute RateLimitUnit = "Minute"
+	Hour   RateLimitUnit = "Hour"
+)
+
+// Duration returns the duration of the unit, or 0 if the unit is invalid.
+func (u RateLimitUnit) Duration() time.Duration {
+	switch u {
+	case Second:
+		return time.Second
+	case Minute:
+		return time.Minute
+	case Hour:
+		return time.Hour
+	}
+	return 0
+}
+
+// RateLimitValue holds a token bucket refilled with Requests tokens every Unit.
+// +k8s:deepcopy-gen=true
+type RateLimitValue struct {
+	// Requests allowed per unit of time.
+	Requests uint32
+	// Unit of time of the rate limit.
+	Unit RateLimitUnit
+	// Burst is the size of the bucket. Defaults to Requests.
+	Burst *uint32
+}
+
+// Validate the fields within the RateLimitValue structure
+func (r RateLimitValue) Validate() error {
+	var errs error
+	if r.Requests == 0 {
+		errs = multierror.Append(errs, ErrRateLimitRequestsInvalid)
+	}
+	if r.Unit.Duration() == 0 {
+		errs = multierror.Append(errs, ErrRateLimitUnitInvalid)
+	}
+	if r.Burst != nil && *r.Burst == 0 {
+		errs = multierror.Append(errs, ErrRateLimitBurstInvalid)
+	}
+	return errs
+}
+
+// CircuitBreaker holds the limits on the connections and requests sent to destinations.
+// +k8s:deepcopy-gen=true
+type CircuitBreaker struct {
+	// MaxConnections is the maximum number of connections to the destinations.
+	MaxConnections *uint32
+	// MaxPendingRequests is the maximum number of requests waiting for a connection.
+	MaxPendingRequests *uint32
+	// MaxParallelRequests is the maximum number of requests in flight.
+	MaxParallelRequests *uint32
+	// MaxParallelRetries is the maximum number of retries in flight.
+	MaxParallelRetries *uint32
+}
+
+// HealthCheck holds the active and passive health checks performed against destinations.
+// +k8s:deepcopy-gen=true
+type HealthCheck struct {
+	// Active health check, where the destinations are periodically probed.
+	Active *ActiveHealthCheck
+	// Passive health check, where destinations are ejected based on the responses to requests.
+	Passive *OutlierDetection
+}
+
+// Validate the fields within the HealthCheck structure
+func (h HealthCheck) Validate() error {
+	var errs error
+	if h.Active != nil {
+		if err := h.Active.Validate(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+	if h.Passive != nil {
+		if err := h.Passive.Validate(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+
+	return errs
+}
+
+// ActiveHealthCheck holds the configuration of an active health check.
+// Only one of HTTP, TCP or GRPC can be set.
+// +k8s:deepcopy-gen=true
+type ActiveHealthCheck struct {
+	// Timeout is the time to wait for a health check response.
+	Timeout *metav1.Duration
+	// Interval is the time between health checks.
+	Interval *metav1.Duration
+	// UnhealthyThreshold is the number of failed checks before a destination is marked unhealthy.
+	UnhealthyThreshold *uint32
+	// HealthyThreshold is the number of successful checks before a destination is marked healthy.
+	HealthyThreshold *uint32
+	// HTTP health checker.
+	HTTP *HTTPHealthChecker
+	// TCP health checker.
+	TCP *TCPHealthChecker
+	// GRPC health checker.
+	GRPC *GRPCHealthChecker
+}
+
+// Validate the fields within the ActiveHealthCheck structure
+func (a ActiveHealthCheck) Validate() error {
+	var errs error
+	checkerCount := 0
+	if a.HTTP != nil {
+		checkerCount++
+		if a.HTTP.Path == "" {
+			errs = multierror.Append(errs, ErrHTTPHealthCheckPathEmpty)
+		}
+		for _, status := range a.HTTP.ExpectedStatuses {
+			if status < 100 || status > 599 {
+				errs = multierror.Append(errs, ErrHTTPHealthCheckStatusInvalid)
+				break
+			}
+		}
+	}
+	if a.TCP != nil {
+		checkerCount++
+	}
+	if a.GRPC != nil {
+		checkerCount++
+	}
+	if checkerCount != 1 {
+		errs = multierror.Append(errs, ErrHealthCheckerInvalid)
+	}
+	if (a.UnhealthyThreshold != nil && *a.UnhealthyThreshold == 0) ||
+		(a.HealthyThreshold != nil && *a.HealthyThreshold == 0) {
+		errs = multierror.Append(errs, ErrHealthCheckThresholdInvalid)
+	}
+	for _, d := range []*metav1.Duration{a.Timeout, a.Interval} {
+		if d != nil && d.Duration < 0 {
+			errs = multierror.Append(errs, ErrTimeoutNegative)
+			break
+		}
+	}
+
+	return errs
+}
+
+// HTTPHealthChecker holds the configuration of an HTTP health checker.
+// +k8s:deepcopy-gen=true
+type HTTPHealthChecker struct {
+	// Path requested by the health checker.
+	Path string
+	// ExpectedStatuses are the response status codes considered healthy.
+	ExpectedStatuses []uint32
+}
+
+// TCPHealthChecker holds the configuration of a TCP health checker.
+// +k8s:deepcopy-gen=true
+type TCPHealthChecker struct {
+	// Send is the payload sent once connected.
+	Send []byte
+	// Receive is the payload expected in the response.
+	Receive []byte
+}
+
+// GRPCHealthChecker holds the configuration of a gRPC health checker.
+// +k8s:deepcopy-gen=true
+type GRPCHealthChecker struct {
+	// Service is the name of the service to check.
+	Service *string
+}
+
+// OutlierDetection holds the configuration of the passive health check.
+// +k8s:deepcopy-gen=true
+type OutlierDetection struct {
+	// Interval is the time between ejection analysis sweeps.
+	Interval *metav1.Duration
+	// Consecutive5xxErrors is the number of consecutive 5xx errors that eject a destination.
+	Consecutive5xxErrors *uint32
+	// ConsecutiveGatewayErrors is the number of consecutive gateway errors that eject a destination.
+	ConsecutiveGatewayErrors *uint32
+	// BaseEjectionTime is the base duration a destination is ejected for.
+	BaseEjectionTime *metav1.Duration
+	// MaxEjectionPercent is the maximum percentage of destinations that can be ejected.
+	MaxEjectionPercent *uint32
+}
+
+// Validate the fields within the OutlierDetection structure
+func (o OutlierDetection) Validate() error {
+	var errs error
+	if o.MaxEjectionPercent != nil && *o.MaxEjectionPercent > 100 {
+		errs = multierror.Append(errs, ErrOutlierDetectionPercentInvalid)
+	}
+	for _, d := range []*metav1.Duration{o.Interval, o.BaseEjectionTime} {
+		if d != nil && d.Duration < 0 {
+			errs = multierror.Append(errs, ErrTimeoutNegative)
+			break
+		}
+	}
+
+	return errs
+}
+
+// Add header configures a headder to be added to a request or response.
+// +k8s:deepcopy-gen=true
+type AddHeader struct {
+	Name   string
+	Value  string
+	Append bool
+}
+
+// Validate the fields within the AddHeader structure
+func (h AddHeader) Validate() error {
+	var errs error
+	if h.Name == "" {
+		errs = multierror.Append(errs, ErrAddHeaderEmptyName)
+	}
+
+	return errs
+}
+
+// Direct response holds the details for returning a body and status code for a route.
+// +k8s:deepcopy-gen=true
+type DirectResponse struct {
+	// Body configures the body of the direct response. Currently only a string response
+	// is supported, but in the future a config.core.v3.DataSource may replace it.
+	Body *string
+	// StatusCode will be used for the direct response's status code.
+	StatusCode uint32
+}
+
+// Validate the fields within the DirectResponse structure
+func (r DirectResponse) Validate() error {
+	var errs error
+	if status := r.StatusCode; status > 599 || status < 100 {
+		errs = multierror.Append(errs, ErrDirectResponseStatusInvalid)
+	}
+
+	return errs
+}
+
+// Redirect holds the details for how and where to redirect a request
+// +k8s:deepcopy-gen=true
+type Redirect struct {
+	// Scheme configures the replacement of the request's scheme.
+	Scheme *string
+	// Hostname configures the replacement of the request's hostname.
+	Hostname *string
+	// Path contains config for rewriting the path of the request.
+	Path *HTTPPathModifier
+	// Port configures the replacement of the request's port.
+	Port *uint32
+	// Status code configures the redirection response's status code.
+	StatusCode *int32
+}
+
+// Validate the fields within the Redirect structure
+func (r Redirect) Validate() error {
+	var errs error
+
+	if r.Scheme != nil {
+		if *r.Scheme != "http" && *r.Scheme != "https" {
+			errs = multierror.Append(errs, ErrRedirectUnsupportedScheme)
+		}
+	}
+
+	if r.Path != nil {
+		if err := r.Path.Validate(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+
+	if r.StatusCode != nil {
+		if *r.StatusCode != 301 && *r.StatusCode != 302 {
+			errs = multierror.Append(errs, ErrRedirectUnsupportedStatus)
+		}
+	}
+
+	return errs
+}
+
+// HTTPPathModifier holds instructions for how to modify the path of a request on a redirect response
+// +k8s:deepcopy-gen=true
+type HTTPPathModifier struct {
+	// FullReplace provides a string to replace the full path of the request.
+	FullReplace *string
+	// PrefixMatchReplace provides a string to replace the matched prefix of the request.
+	PrefixMatchReplace *string
+}
+
+// Validate the fields within the HTTPPathModifier structure
+func (r HTTPPathModifier) Validate() error {
+	var errs error
 
 	if r.FullReplace != nil && r.PrefixMatchReplace != nil {
 		errs = multierror.Append(errs, ErrHTTPPathModifierDoubleReplace)
@@ -444,6 +2208,9 @@ type StringMatch struct {
 	Suffix *string
 	// SafeRegex match condition.
 	SafeRegex *string
+	// Distinct match condition, matching any value.
+	// Only supported by the global rate limits.
+	Distinct bool
 }
 
 // Validate the fields within the StringMatch structure
@@ -462,6 +2229,9 @@ func (s StringMatch) Validate() error {
 	if s.SafeRegex != nil {
 		matchCount++
 	}
+	if s.Distinct {
+		matchCount++
+	}
 
 	if matchCount != 1 {
 		errs = multierror.Append(errs, ErrStringMatchConditionInvalid)
@@ -484,6 +2254,14 @@ type TCPListener struct {
 	TLS *TLSInspectorConfig
 	// Destinations associated with TCP traffic to the service.
 	Destinations []*RouteDestination
+	// LoadBalancer defines how connections are balanced across the destinations.
+	LoadBalancer *LoadBalancer
+	// IPFilter defines the client IP addresses allowed or denied access to the listener.
+	IPFilter *IPFilter
+	// EnableProxyProtocol reads the client addresses from the PROXY protocol
+	// header sent at the start of the connections. As it is enabled on the port,
+	// it applies to all listeners sharing the port of the listener.
+	EnableProxyProtocol bool
 }
 
 // Validate the fields within the TCPListener structure
@@ -508,6 +2286,16 @@ func (h TCPListener) Validate() error {
 			errs = multierror.Append(errs, err)
 		}
 	}
+	if h.LoadBalancer != nil {
+		if err := h.LoadBalancer.validateL4(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+	if h.IPFilter != nil {
+		if err := h.IPFilter.Validate(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
 	return errs
 }
 
@@ -541,6 +2329,8 @@ type UDPListener struct {
 	Port uint32
 	// Destinations associated with UDP traffic to the service.
 	Destinations []*RouteDestination
+	// LoadBalancer defines how sessions are balanced across the destinations.
+	LoadBalancer *LoadBalancer
 }
 
 // Validate the fields within the UDPListener structure
@@ -560,5 +2350,298 @@ func (h UDPListener) Validate() error {
 			errs = multierror.Append(errs, err)
 		}
 	}
+	if h.LoadBalancer != nil {
+		if err := h.LoadBalancer.validateL4(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+	return errs
+}
+
+// AccessLog holds the access logs written by the listeners. The listeners
+// write no access log if it has no settings.
+// +k8s:deepcopy-gen=true
+type AccessLog struct {
+	// Settings are the access logs written by the listeners.
+	Settings []*AccessLogSetting
+}
+
+// Validate the fields within the AccessLog structure
+func (a *AccessLog) Validate() error {
+	var errs error
+	for _, setting := range a.Settings {
+		if err := setting.Validate(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+	return errs
+}
+
+// AccessLogFormat is the format of the access log entries.
+type AccessLogFormat string
+
+const (
+	// AccessLogFormatText formats the entries as lines of text.
+	AccessLogFormatText AccessLogFormat = "Text"
+	// AccessLogFormatJSON formats the entries as JSON objects.
+	AccessLogFormatJSON AccessLogFormat = "JSON"
+)
+
+// AccessLogSetting holds an access log and the sinks it is written to.
+// +k8s:deepcopy-gen=true
+type AccessLogSetting struct {
+	// Format of the entries.
+	Format AccessLogFormat
+	// Text is the command operator format of the text entries. The default
+	// Envoy format is used if it is empty.
+	Text string
+	// JSON maps the fields of the JSON entries to the command operators of
+	// their values.
+	JSON map[string]string
+	// Filter selects the logged requests and connections, all of them are
+	// logged if it is nil.
+	Filter *AccessLogFilter
+	// Sinks are where the entries are written.
+	Sinks []*AccessLogSink
+}
+
+// Validate the fields within the AccessLogSetting structure
+func (a *AccessLogSetting) Validate() error {
+	var errs error
+	switch a.Format {
+	case AccessLogFormatText:
+	case AccessLogFormatJSON:
+		if len(a.JSON) == 0 {
+			errs = multierror.Append(errs, ErrAccessLogJSONFieldsEmpty)
+		}
+	default:
+		errs = multierror.Append(errs, ErrAccessLogFormatInvalid)
+	}
+	if a.Filter != nil {
+		if err := a.Filter.Validate(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+	if len(a.Sinks) == 0 {
+		errs = multierror.Append(errs, ErrAccessLogSinksEmpty)
+	}
+	for _, sink := range a.Sinks {
+		if err := sink.Validate(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+	return errs
+}
+
+// AccessLogFilter holds the conditions of the logged requests and connections.
+// +k8s:deepcopy-gen=true
+type AccessLogFilter struct {
+	// MinStatusCode is the lowest response status code of the logged requests.
+	MinStatusCode *uint32
+	// MinDuration is the shortest duration of the logged requests and connections.
+	MinDuration *metav1.Duration
+	// SamplingPercent is the percentage of the logged requests and connections.
+	SamplingPercent *uint32
+}
+
+// Validate the fields within the AccessLogFilter structure
+func (a *AccessLogFilter) Validate() error {
+	var errs error
+	if a.MinStatusCode != nil && (*a.MinStatusCode < 100 || *a.MinStatusCode > 599) {
+		errs = multierror.Append(errs, ErrAccessLogStatusCodeInvalid)
+	}
+	if a.MinDuration != nil && a.MinDuration.Duration < 0 {
+		errs = multierror.Append(errs, ErrTimeoutNegative)
+	}
+	if a.SamplingPercent != nil && *a.SamplingPercent > 100 {
+		errs = multierror.Append(errs, ErrAccessLogSamplingInvalid)
+	}
+	return errs
+}
+
+// AccessLogSink holds where the access log entries are written, only one of
+// its fields is set.
+// +k8s:deepcopy-gen=true
+type AccessLogSink struct {
+	// File the entries are written to.
+	File *FileAccessLogSink
+	// ALS is the gRPC Access Log Service the entries are sent to.
+	ALS *ALSAccessLogSink
+	// OpenTelemetry is the OpenTelemetry collector the entries are sent to.
+	OpenTelemetry *OpenTelemetryAccessLogSink
+}
+
+// Validate the fields within the AccessLogSink structure
+func (a *AccessLogSink) Validate() error {
+	var errs error
+	switch {
+	case a.File != nil && a.ALS == nil && a.OpenTelemetry == nil:
+		if a.File.Path == "" {
+			errs = multierror.Append(errs, ErrAccessLogFilePathEmpty)
+		}
+	case a.ALS != nil && a.File == nil && a.OpenTelemetry == nil:
+		if err := validateAccessLogService(a.ALS.Host, a.ALS.Port); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+		if a.ALS.LogName == "" {
+			errs = multierror.Append(errs, ErrAccessLogNameEmpty)
+		}
+	case a.OpenTelemetry != nil && a.File == nil && a.ALS == nil:
+		if err := validateAccessLogService(a.OpenTelemetry.Host, a.OpenTelemetry.Port); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	default:
+		errs = multierror.Append(errs, ErrAccessLogSinkInvalid)
+	}
+	return errs
+}
+
+func validateAccessLogService(host string, port uint32) error {
+	var errs error
+	if host == "" {
+		errs = multierror.Append(errs, ErrAccessLogServiceHostEmpty)
+	}
+	if port == 0 {
+		errs = multierror.Append(errs, ErrAccessLogServicePortInvalid)
+	}
+	return errs
+}
+
+// FileAccessLogSink holds a file the access log entries are written to.
+// +k8s:deepcopy-gen=true
+type FileAccessLogSink struct {
+	// Path of the file.
+	Path string
+}
+
+// ALSAccessLogSink holds a gRPC Access Log Service.
+// +k8s:deepcopy-gen=true
+type ALSAccessLogSink struct {
+	// Host of the service.
+	Host string
+	// Port of the gRPC endpoint of the service.
+	Port uint32
+	// LogName identifies the log in the service.
+	LogName string
+}
+
+// OpenTelemetryAccessLogSink holds an OpenTelemetry collector.
+// +k8s:deepcopy-gen=true
+type OpenTelemetryAccessLogSink struct {
+	// Host of the collector.
+	Host string
+	// Port of the OTLP gRPC endpoint of the collector.
+	Port uint32
+	// Resources are the attributes of the resource emitting the log records.
+	Resources map[string]string
+}
+
+// TracingProvider is the type of the provider the spans are sent to.
+type TracingProvider string
+
+const (
+	// TracingProviderOpenTelemetry sends the spans to an OpenTelemetry collector.
+	TracingProviderOpenTelemetry TracingProvider = "OpenTelemetry"
+	// TracingProviderZipkin sends the spans to a Zipkin collector.
+	TracingProviderZipkin TracingProvider = "Zipkin"
+	// TracingProviderDatadog sends the spans to a Datadog agent.
+	TracingProviderDatadog TracingProvider = "Datadog"
+)
+
+// Tracing holds the tracing of the requests of the HTTP listeners.
+// +k8s:deepcopy-gen=true
+type Tracing struct {
+	// ServiceName is the name of the service emitting the spans.
+	ServiceName string
+	// Provider the spans are sent to.
+	Provider TracingProvider
+	// Destination is the collector of the provider.
+	Destination *RouteDestination
+	// SamplingRate is the percentage of the traced requests.
+	SamplingRate uint32
+	// CustomTags are the tags added to the spans.
+	CustomTags []*CustomTag
+}
+
+// Validate the fields within the Tracing structure
+func (t *Tracing) Validate() error {
+	var errs error
+	if t.ServiceName == "" {
+		errs = multierror.Append(errs, ErrTracingServiceNameEmpty)
+	}
+	switch t.Provider {
+	case TracingProviderOpenTelemetry, TracingProviderZipkin, TracingProviderDatadog:
+	default:
+		errs = multierror.Append(errs, ErrTracingProviderInvalid)
+	}
+	if t.Destination == nil {
+		errs = multierror.Append(errs, ErrTracingDestinationEmpty)
+	} else if err := t.Destination.Validate(); err != nil {
+		errs = multierror.Append(errs, err)
+	}
+	if t.SamplingRate > 100 {
+		errs = multierror.Append(errs, ErrTracingSamplingRateInvalid)
+	}
+	for _, tag := range t.CustomTags {
+		if err := tag.Validate(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+	return errs
+}
+
+// CustomTag holds a tag added to the spans, only one of its value fields is set.
+// +k8s:deepcopy-gen=true
+type CustomTag struct {
+	// Name of the tag.
+	Name string
+	// Literal is the literal value of the tag.
+	Literal *string
+	// Environment is the environment variable of the proxy the tag is set to.
+	Environment *CustomTagSource
+	// RequestHeader is the request header the tag is set to.
+	RequestHeader *CustomTagSource
+}
+
+// Validate the fields within the CustomTag structure
+func (c *CustomTag) Validate() error {
+	var errs error
+	var source *CustomTagSource
+	switch {
+	case c.Name == "":
+		errs = multierror.Append(errs, ErrCustomTagInvalid)
+	case c.Literal != nil && c.Environment == nil && c.RequestHeader == nil:
+	case c.Environment != nil && c.Literal == nil && c.RequestHeader == nil:
+		source = c.Environment
+	case c.RequestHeader != nil && c.Literal == nil && c.Environment == nil:
+		source = c.RequestHeader
+	default:
+		errs = multierror.Append(errs, ErrCustomTagInvalid)
+	}
+	if source != nil && source.Name == "" {
+		errs = multierror.Append(errs, ErrCustomTagSourceNameEmpty)
+	}
 	return errs
 }
+
+// CustomTagSource holds the environment variable or the request header a tag
+// is set to.
+// +k8s:deepcopy-gen=true
+type CustomTagSource struct {
+	// Name of the environment variable or request header.
+	Name string
+	// DefaultValue is the value of the tag when the source has no value, the
+	// tag is not added if it is empty.
+	DefaultValue string
+}
+
+// RequestIDSettings holds the handling of the x-request-id header.
+// +k8s:deepcopy-gen=true
+type RequestIDSettings struct {
+	// Disable disables the generation of request IDs.
+	Disable bool
+	// PreserveExternal keeps the request IDs of the requests from external clients.
+	PreserveExternal bool
+	// SetInResponse adds the request IDs to the responses.
+	SetInResponse bool
+}
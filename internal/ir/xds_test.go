@@ -7,9 +7,11 @@ package ir
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 var (
@@ -27,8 +29,10 @@ var (
 		Port:      80,
 		Hostnames: []string{"example.com"},
 		TLS: &TLSListenerConfig{
-			ServerCertificate: []byte{1, 2, 3},
-			PrivateKey:        []byte{1, 2, 3},
+			Certificates: []TLSCertificate{{
+				ServerCertificate: []byte{1, 2, 3},
+				PrivateKey:        []byte{1, 2, 3},
+			}},
 		},
 		Routes: []*HTTPRoute{&happyHTTPRoute},
 	}
@@ -88,6 +92,22 @@ var (
 		TLS:          &TLSInspectorConfig{SNIs: []string{}},
 		Destinations: []*RouteDestination{&happyRouteDestination},
 	}
+	ipFilterTCPListenerTLSPassthrough = TCPListener{
+		Name:         "ip-filter",
+		Address:      "0.0.0.0",
+		Port:         80,
+		TLS:          &TLSInspectorConfig{SNIs: []string{"example.com"}},
+		Destinations: []*RouteDestination{&happyRouteDestination},
+		IPFilter:     &IPFilter{Allow: []string{"10.0.0.0/8"}},
+	}
+	invalidIPFilterTCPListenerTLSPassthrough = TCPListener{
+		Name:         "invalid-ip-filter",
+		Address:      "0.0.0.0",
+		Port:         80,
+		TLS:          &TLSInspectorConfig{SNIs: []string{"example.com"}},
+		Destinations: []*RouteDestination{&happyRouteDestination},
+		IPFilter:     &IPFilter{},
+	}
 
 	// UDPListener
 	happyUDPListener = UDPListener{
@@ -362,6 +382,538 @@ var (
 		},
 	}
 
+	timeoutRetryHTTPRoute = HTTPRoute{
+		Name: "timeoutretry",
+		PathMatch: &StringMatch{
+			Exact: ptrTo("timeoutretry"),
+		},
+		Destinations: []*RouteDestination{&happyRouteDestination},
+		Timeout: &Timeout{
+			Request: &metav1.Duration{Duration: 10 * time.Second},
+			Connect: &metav1.Duration{Duration: time.Second},
+		},
+		Retry: &Retry{
+			NumRetries: ptrTo(uint32(3)),
+			RetryOn: &RetryOn{
+				Triggers:        []TriggerEnum{Error5XX, ConnectFailure},
+				HTTPStatusCodes: []uint32{429},
+			},
+			PerRetry: &PerRetryPolicy{
+				BackOff: &BackOffPolicy{
+					BaseInterval: &metav1.Duration{Duration: 100 * time.Millisecond},
+					MaxInterval:  &metav1.Duration{Duration: time.Second},
+				},
+			},
+			Budget: &RetryBudget{
+				Percent: ptrTo(uint32(50)),
+			},
+		},
+	}
+
+	timeoutNegativeHTTPRoute = HTTPRoute{
+		Name: "timeoutnegative",
+		PathMatch: &StringMatch{
+			Exact: ptrTo("timeoutnegative"),
+		},
+		Timeout: &Timeout{
+			Idle: &metav1.Duration{Duration: -time.Second},
+		},
+	}
+
+	retryInvalidHTTPRoute = HTTPRoute{
+		Name: "retryinvalid",
+		PathMatch: &StringMatch{
+			Exact: ptrTo("retryinvalid"),
+		},
+		Retry: &Retry{
+			RetryOn: &RetryOn{
+				HTTPStatusCodes: []uint32{600},
+			},
+			PerRetry: &PerRetryPolicy{
+				BackOff: &BackOffPolicy{
+					BaseInterval: &metav1.Duration{Duration: time.Second},
+					MaxInterval:  &metav1.Duration{Duration: 100 * time.Millisecond},
+				},
+			},
+			Budget: &RetryBudget{
+				Percent: ptrTo(uint32(101)),
+			},
+		},
+	}
+
+	retryBackOffNoBaseHTTPRoute = HTTPRoute{
+		Name: "retrybackoffnobase",
+		PathMatch: &StringMatch{
+			Exact: ptrTo("retrybackoffnobase"),
+		},
+		Retry: &Retry{
+			PerRetry: &PerRetryPolicy{
+				BackOff: &BackOffPolicy{
+					MaxInterval: &metav1.Duration{Duration: time.Second},
+				},
+			},
+		},
+	}
+
+	healthCheckHTTPRoute = HTTPRoute{
+		Name: "healthcheck",
+		PathMatch: &StringMatch{
+			Exact: ptrTo("healthcheck"),
+		},
+		Destinations: []*RouteDestination{&happyRouteDestination},
+		HealthCheck: &HealthCheck{
+			Active: &ActiveHealthCheck{
+				Interval:           &metav1.Duration{Duration: 5 * time.Second},
+				UnhealthyThreshold: ptrTo(uint32(3)),
+				HTTP: &HTTPHealthChecker{
+					Path:             "/healthz",
+					ExpectedStatuses: []uint32{200, 204},
+				},
+			},
+			Passive: &OutlierDetection{
+				Consecutive5xxErrors: ptrTo(uint32(5)),
+				MaxEjectionPercent:   ptrTo(uint32(50)),
+			},
+		},
+	}
+
+	healthCheckInvalidHTTPRoute = HTTPRoute{
+		Name: "healthcheckinvalid",
+		PathMatch: &StringMatch{
+			Exact: ptrTo("healthcheckinvalid"),
+		},
+		HealthCheck: &HealthCheck{
+			Active: &ActiveHealthCheck{
+				HealthyThreshold: ptrTo(uint32(0)),
+				HTTP: &HTTPHealthChecker{
+					ExpectedStatuses: []uint32{99},
+				},
+			},
+			Passive: &OutlierDetection{
+				MaxEjectionPercent: ptrTo(uint32(101)),
+			},
+		},
+	}
+
+	healthCheckMultipleCheckersHTTPRoute = HTTPRoute{
+		Name: "healthcheckmultiple",
+		PathMatch: &StringMatch{
+			Exact: ptrTo("healthcheckmultiple"),
+		},
+		HealthCheck: &HealthCheck{
+			Active: &ActiveHealthCheck{
+				TCP:  &TCPHealthChecker{},
+				GRPC: &GRPCHealthChecker{},
+			},
+		},
+	}
+	loadBalancerHTTPRoute = HTTPRoute{
+		Name: "loadbalancer",
+		PathMatch: &StringMatch{
+			Exact: ptrTo("loadbalancer"),
+		},
+		LoadBalancer: &LoadBalancer{
+			ConsistentHash: &ConsistentHash{
+				Algorithm: MaglevAlgorithm,
+				Cookie: &CookieHashPolicy{
+					Name: "session",
+					TTL:  &metav1.Duration{Duration: time.Hour},
+				},
+			},
+		},
+	}
+	loadBalancerMultipleHTTPRoute = HTTPRoute{
+		Name: "loadbalancermultiple",
+		PathMatch: &StringMatch{
+			Exact: ptrTo("loadbalancermultiple"),
+		},
+		LoadBalancer: &LoadBalancer{
+			LeastRequest: &LeastRequest{},
+			Random:       &Random{},
+		},
+	}
+	consistentHashInvalidHTTPRoute = HTTPRoute{
+		Name: "consistenthashinvalid",
+		PathMatch: &StringMatch{
+			Exact: ptrTo("consistenthashinvalid"),
+		},
+		LoadBalancer: &LoadBalancer{
+			ConsistentHash: &ConsistentHash{},
+		},
+	}
+	consistentHashNameEmptyHTTPRoute = HTTPRoute{
+		Name: "consistenthashnameempty",
+		PathMatch: &StringMatch{
+			Exact: ptrTo("consistenthashnameempty"),
+		},
+		LoadBalancer: &LoadBalancer{
+			ConsistentHash: &ConsistentHash{
+				Header: &HeaderHashPolicy{},
+			},
+		},
+	}
+	localRateLimitHTTPRoute = HTTPRoute{
+		Name: "localratelimit",
+		PathMatch: &StringMatch{
+			Exact: ptrTo("localratelimit"),
+		},
+		RateLimit: &RateLimit{
+			Local: &LocalRateLimit{
+				Default: RateLimitValue{Requests: 10, Unit: Second, Burst: ptrTo(uint32(20))},
+				Rules: []*LocalRateLimitRule{{
+					HeaderMatches: []*StringMatch{{Name: "x-user-id", SafeRegex: ptrTo("user-[0-9]+")}},
+					Limit:         RateLimitValue{Requests: 100, Unit: Hour},
+				}},
+				ResponseStatusCode: ptrTo(uint32(503)),
+			},
+		},
+	}
+	localRateLimitInvalidHTTPRoute = HTTPRoute{
+		Name: "localratelimitinvalid",
+		PathMatch: &StringMatch{
+			Exact: ptrTo("localratelimitinvalid"),
+		},
+		RateLimit: &RateLimit{
+			Local: &LocalRateLimit{
+				Default: RateLimitValue{Requests: 0, Unit: "Day"},
+				Rules: []*LocalRateLimitRule{
+					{
+						Limit: RateLimitValue{Requests: 10, Unit: Second},
+					},
+					{
+						HeaderMatches: []*StringMatch{{Name: "x-user-id", SafeRegex: ptrTo("user-[0-9")}},
+						Limit:         RateLimitValue{Requests: 10, Unit: Second, Burst: ptrTo(uint32(0))},
+					},
+				},
+				ResponseStatusCode: ptrTo(uint32(200)),
+			},
+		},
+	}
+	localRateLimitRuleUnitHTTPRoute = HTTPRoute{
+		Name: "localratelimitruleunit",
+		PathMatch: &StringMatch{
+			Exact: ptrTo("localratelimitruleunit"),
+		},
+		RateLimit: &RateLimit{
+			Local: &LocalRateLimit{
+				Default: RateLimitValue{Requests: 100, Unit: Minute},
+				Rules: []*LocalRateLimitRule{{
+					HeaderMatches: []*StringMatch{{Name: "x-user-id", Exact: ptrTo("one")}},
+					Limit:         RateLimitValue{Requests: 10, Unit: Second},
+				}},
+			},
+		},
+	}
+	globalRateLimitHTTPRoute = HTTPRoute{
+		Name: "globalratelimit",
+		PathMatch: &StringMatch{
+			Exact: ptrTo("globalratelimit"),
+		},
+		RateLimit: &RateLimit{
+			Global: &GlobalRateLimit{
+				Rules: []*GlobalRateLimitRule{
+					{
+						HeaderMatches: []*StringMatch{{Name: "x-user-id", Distinct: true}},
+						PathMatch:     &StringMatch{Prefix: ptrTo("/api")},
+						Limit:         RateLimitValue{Requests: 10, Unit: Minute},
+					},
+					{
+						ClientIP: &ClientIPMatch{CIDR: ptrTo("10.0.0.0/8")},
+						Limit:    RateLimitValue{Requests: 100, Unit: Second},
+					},
+				},
+			},
+		},
+	}
+	globalRateLimitInvalidHTTPRoute = HTTPRoute{
+		Name: "globalratelimitinvalid",
+		PathMatch: &StringMatch{
+			Exact: ptrTo("globalratelimitinvalid"),
+		},
+		RateLimit: &RateLimit{
+			Global: &GlobalRateLimit{
+				Rules: []*GlobalRateLimitRule{
+					{
+						HeaderMatches: []*StringMatch{{Name: "x-user-id", SafeRegex: ptrTo("user-[0-9")}},
+						ClientIP:      &ClientIPMatch{CIDR: ptrTo("10.0.0.0")},
+						Limit:         RateLimitValue{Requests: 10, Unit: Second, Burst: ptrTo(uint32(20))},
+					},
+				},
+			},
+		},
+	}
+	extAuthHTTPRoute = HTTPRoute{
+		Name: "extauth",
+		PathMatch: &StringMatch{
+			Exact: ptrTo("extauth"),
+		},
+		ExtAuth: &ExtAuth{
+			Name: "securitypolicy/default/extauth",
+			HTTP: &HTTPExtAuthService{
+				Destination:      &RouteDestination{Host: "10.0.0.1", Port: 8080},
+				Path:             "/authz",
+				HeadersToBackend: []string{"x-user-id"},
+			},
+			Timeout: &metav1.Duration{Duration: time.Second},
+		},
+	}
+	extAuthInvalidHTTPRoute = HTTPRoute{
+		Name: "extauthinvalid",
+		PathMatch: &StringMatch{
+			Exact: ptrTo("extauthinvalid"),
+		},
+		ExtAuth: &ExtAuth{
+			GRPC:    &GRPCExtAuthService{},
+			HTTP:    &HTTPExtAuthService{Destination: &RouteDestination{Host: "10.0.0.1", Port: 8080}},
+			Timeout: &metav1.Duration{Duration: -time.Second},
+		},
+	}
+	extAuthNoDestinationHTTPRoute = HTTPRoute{
+		Name: "extauthnodestination",
+		PathMatch: &StringMatch{
+			Exact: ptrTo("extauthnodestination"),
+		},
+		ExtAuth: &ExtAuth{
+			Name: "securitypolicy/default/extauth",
+			GRPC: &GRPCExtAuthService{},
+		},
+	}
+	oidcHTTPRoute = HTTPRoute{
+		Name: "oidc",
+		PathMatch: &StringMatch{
+			Exact: ptrTo("oidc"),
+		},
+		OIDC: &OIDC{
+			Name:                  "authenticationfilter/default/oidc",
+			AuthorizationEndpoint: "https://auth.example.com/authorize",
+			TokenEndpoint:         "https://auth.example.com/token",
+			ClientID:              "client",
+			ClientSecret:          []byte("secret"),
+			HMACSecret:            []byte("hmac"),
+			RedirectURL:           "https://www.example.com/oauth2/callback",
+			RedirectPath:          "/oauth2/callback",
+			LogoutPath:            "/logout",
+			Scopes:                []string{"openid"},
+		},
+	}
+	oidcInvalidHTTPRoute = HTTPRoute{
+		Name: "oidcinvalid",
+		PathMatch: &StringMatch{
+			Exact: ptrTo("oidcinvalid"),
+		},
+		OIDC: &OIDC{
+			Name:                  "authenticationfilter/default/oidc",
+			AuthorizationEndpoint: "auth.example.com/authorize",
+			TokenEndpoint:         "https://auth.example.com/token",
+			RedirectURL:           "https://www.example.com/oauth2/callback",
+			RedirectPath:          "oauth2/callback",
+		},
+	}
+	basicAuthHTTPRoute = HTTPRoute{
+		Name: "basicauth",
+		PathMatch: &StringMatch{
+			Exact: ptrTo("basicauth"),
+		},
+		BasicAuth: &BasicAuth{
+			Name: "authenticationfilter/default/basic-auth",
+			Users: []*BasicAuthUser{
+				{Name: "user1", PasswordSHA1: make([]byte, 20)},
+			},
+		},
+	}
+	basicAuthInvalidHTTPRoute = HTTPRoute{
+		Name: "basicauthinvalid",
+		PathMatch: &StringMatch{
+			Exact: ptrTo("basicauthinvalid"),
+		},
+		BasicAuth: &BasicAuth{
+			Users: []*BasicAuthUser{
+				{Name: "user:1", PasswordSHA1: []byte("password")},
+			},
+		},
+	}
+	basicAuthNoUsersHTTPRoute = HTTPRoute{
+		Name: "basicauthnousers",
+		PathMatch: &StringMatch{
+			Exact: ptrTo("basicauthnousers"),
+		},
+		BasicAuth: &BasicAuth{
+			Name: "authenticationfilter/default/basic-auth",
+		},
+	}
+	jwtHTTPRoute = HTTPRoute{
+		Name: "jwt",
+		PathMatch: &StringMatch{
+			Exact: ptrTo("jwt"),
+		},
+		JWT: &JWT{
+			Name: "authenticationfilter/default/jwt",
+			Providers: []*JWTProvider{
+				{
+					Name:       "remote",
+					Issuer:     "https://auth.example.com",
+					RemoteJWKS: &RemoteJWKS{URI: "https://auth.example.com/jwks"},
+					ClaimToHeaders: []ClaimToHeader{
+						{Header: "x-user-email", Claim: "email"},
+					},
+				},
+				{
+					Name:        "local",
+					LocalJWKS:   `{"keys":[]}`,
+					FromHeaders: []JWTHeaderExtractor{{Name: "x-token"}},
+					FromParams:  []string{"token"},
+					ClockSkew:   &metav1.Duration{Duration: 30 * time.Second},
+				},
+			},
+		},
+	}
+	jwtInvalidHTTPRoute = HTTPRoute{
+		Name: "jwtinvalid",
+		PathMatch: &StringMatch{
+			Exact: ptrTo("jwtinvalid"),
+		},
+		JWT: &JWT{
+			Providers: []*JWTProvider{
+				{
+					Name:       "provider",
+					RemoteJWKS: &RemoteJWKS{URI: "ftp://auth.example.com/jwks"},
+					ClaimToHeaders: []ClaimToHeader{
+						{Header: "x-user-email"},
+					},
+				},
+				{
+					Name:        "provider",
+					RemoteJWKS:  &RemoteJWKS{URI: "https://auth.example.com/jwks"},
+					LocalJWKS:   `{"keys":[]}`,
+					FromHeaders: []JWTHeaderExtractor{{ValuePrefix: "Bearer "}},
+					ClockSkew:   &metav1.Duration{Duration: -time.Second},
+				},
+			},
+		},
+	}
+	ipFilterHTTPRoute = HTTPRoute{
+		Name: "ipfilter",
+		PathMatch: &StringMatch{
+			Exact: ptrTo("ipfilter"),
+		},
+		IPFilter: &IPFilter{
+			Allow:            []string{"10.0.0.0/8", "2001:db8::/32"},
+			Deny:             []string{"10.0.0.0/24"},
+			UseXForwardedFor: true,
+		},
+	}
+	ipFilterInvalidHTTPRoute = HTTPRoute{
+		Name: "ipfilterinvalid",
+		PathMatch: &StringMatch{
+			Exact: ptrTo("ipfilterinvalid"),
+		},
+		IPFilter: &IPFilter{
+			Deny: []string{"10.0.0.1"},
+		},
+	}
+	corsHTTPRoute = HTTPRoute{
+		Name: "cors",
+		PathMatch: &StringMatch{
+			Exact: ptrTo("cors"),
+		},
+		CORS: &CORS{
+			AllowOrigins: []*StringMatch{
+				{Exact: ptrTo("https://www.example.com")},
+				{SafeRegex: ptrTo(`https://.*\.example\.org`)},
+			},
+			AllowMethods:     []string{"GET", "POST"},
+			MaxAge:           &metav1.Duration{Duration: time.Hour},
+			AllowCredentials: true,
+		},
+	}
+	corsInvalidHTTPRoute = HTTPRoute{
+		Name: "corsinvalid",
+		PathMatch: &StringMatch{
+			Exact: ptrTo("corsinvalid"),
+		},
+		CORS: &CORS{
+			AllowOrigins: []*StringMatch{
+				{SafeRegex: ptrTo("https://(www.example.com")},
+				{Suffix: ptrTo(".example.com")},
+			},
+			MaxAge: &metav1.Duration{Duration: -time.Hour},
+		},
+	}
+	corsNoOriginsHTTPRoute = HTTPRoute{
+		Name: "corsnoorigins",
+		PathMatch: &StringMatch{
+			Exact: ptrTo("corsnoorigins"),
+		},
+		CORS: &CORS{
+			AllowMethods: []string{"GET"},
+		},
+	}
+	compressionHTTPRoute = HTTPRoute{
+		Name: "compression",
+		PathMatch: &StringMatch{
+			Exact: ptrTo("compression"),
+		},
+		Compression: &Compression{
+			Name:             "compression",
+			Algorithms:       []CompressionAlgorithm{BrotliCompressionAlgorithm, GzipCompressionAlgorithm},
+			MinContentLength: ptrTo(uint32(1024)),
+			ContentTypes:     []string{"application/json"},
+		},
+	}
+	compressionInvalidHTTPRoute = HTTPRoute{
+		Name: "compressioninvalid",
+		PathMatch: &StringMatch{
+			Exact: ptrTo("compressioninvalid"),
+		},
+		Compression: &Compression{
+			Algorithms: []CompressionAlgorithm{GzipCompressionAlgorithm, "Deflate", GzipCompressionAlgorithm},
+		},
+	}
+	faultInjectionHTTPRoute = HTTPRoute{
+		Name: "faultinjection",
+		PathMatch: &StringMatch{
+			Exact: ptrTo("faultinjection"),
+		},
+		FaultInjection: &FaultInjection{
+			Delay: &FaultInjectionDelay{
+				FixedDelay: metav1.Duration{Duration: time.Second},
+				Percentage: 50,
+			},
+			Abort: &FaultInjectionAbort{
+				HTTPStatus: ptrTo(uint32(503)),
+				Percentage: 10,
+			},
+			HeaderMatches: []*StringMatch{{
+				Name:  "x-chaos",
+				Exact: ptrTo("true"),
+			}},
+		},
+	}
+	faultInjectionInvalidHTTPRoute = HTTPRoute{
+		Name: "faultinjectioninvalid",
+		PathMatch: &StringMatch{
+			Exact: ptrTo("faultinjectioninvalid"),
+		},
+		FaultInjection: &FaultInjection{
+			Delay: &FaultInjectionDelay{
+				Percentage: 150,
+			},
+			Abort: &FaultInjectionAbort{
+				HTTPStatus: ptrTo(uint32(100)),
+				GRPCStatus: ptrTo(uint32(14)),
+			},
+		},
+	}
+	jwtNoProvidersHTTPRoute = HTTPRoute{
+		Name: "jwtnoproviders",
+		PathMatch: &StringMatch{
+			Exact: ptrTo("jwtnoproviders"),
+		},
+		JWT: &JWT{
+			Name: "authenticationfilter/default/jwt",
+		},
+	}
+
 	// RouteDestination
 	happyRouteDestination = RouteDestination{
 		Host: "10.11.12.13",
@@ -415,6 +967,115 @@ func TestValidateXds(t *testing.T) {
 			},
 			want: nil,
 		},
+		{
+			name: "invalid rate limit service",
+			input: Xds{
+				HTTP:             []*HTTPListener{&happyHTTPListener},
+				RateLimitService: &RateLimitService{},
+			},
+			want: []error{ErrRateLimitServiceHostEmpty, ErrRateLimitServicePortInvalid, ErrRateLimitServiceDomainEmpty},
+		},
+		{
+			name: "happy access log",
+			input: Xds{
+				HTTP: []*HTTPListener{&happyHTTPListener},
+				AccessLog: &AccessLog{
+					Settings: []*AccessLogSetting{
+						{
+							Format: AccessLogFormatJSON,
+							JSON:   map[string]string{"status": "%RESPONSE_CODE%"},
+							Filter: &AccessLogFilter{
+								MinStatusCode:   ptrTo(uint32(400)),
+								MinDuration:     &metav1.Duration{Duration: time.Second},
+								SamplingPercent: ptrTo(uint32(10)),
+							},
+							Sinks: []*AccessLogSink{
+								{File: &FileAccessLogSink{Path: "/dev/stdout"}},
+								{ALS: &ALSAccessLogSink{Host: "als.monitoring", Port: 9001, LogName: "default/gateway-1"}},
+								{OpenTelemetry: &OpenTelemetryAccessLogSink{Host: "otel.monitoring", Port: 4317}},
+							},
+						},
+					},
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "disabled access log",
+			input: Xds{
+				HTTP:      []*HTTPListener{&happyHTTPListener},
+				AccessLog: &AccessLog{},
+			},
+			want: nil,
+		},
+		{
+			name: "invalid access log",
+			input: Xds{
+				HTTP: []*HTTPListener{&happyHTTPListener},
+				AccessLog: &AccessLog{
+					Settings: []*AccessLogSetting{
+						{
+							Format: AccessLogFormatJSON,
+							Filter: &AccessLogFilter{
+								MinStatusCode:   ptrTo(uint32(99)),
+								SamplingPercent: ptrTo(uint32(101)),
+							},
+						},
+						{
+							Format: AccessLogFormat("Yaml"),
+							Sinks: []*AccessLogSink{
+								{},
+								{File: &FileAccessLogSink{}},
+								{ALS: &ALSAccessLogSink{}},
+							},
+						},
+					},
+				},
+			},
+			want: []error{
+				ErrAccessLogJSONFieldsEmpty, ErrAccessLogStatusCodeInvalid, ErrAccessLogSamplingInvalid,
+				ErrAccessLogSinksEmpty, ErrAccessLogFormatInvalid, ErrAccessLogSinkInvalid,
+				ErrAccessLogFilePathEmpty, ErrAccessLogServiceHostEmpty, ErrAccessLogServicePortInvalid,
+				ErrAccessLogNameEmpty,
+			},
+		},
+		{
+			name: "happy tracing",
+			input: Xds{
+				HTTP: []*HTTPListener{&happyHTTPListener},
+				Tracing: &Tracing{
+					ServiceName:  "default/gateway-1",
+					Provider:     TracingProviderOpenTelemetry,
+					Destination:  &RouteDestination{Host: "10.11.12.13", Port: 4317},
+					SamplingRate: 10,
+					CustomTags: []*CustomTag{
+						{Name: "cluster", Literal: ptrTo("cluster-1")},
+						{Name: "pod", Environment: &CustomTagSource{Name: "ENVOY_POD_NAME"}},
+						{Name: "user", RequestHeader: &CustomTagSource{Name: "x-user", DefaultValue: "anonymous"}},
+					},
+				},
+				RequestID: &RequestIDSettings{PreserveExternal: true},
+			},
+			want: nil,
+		},
+		{
+			name: "invalid tracing",
+			input: Xds{
+				HTTP: []*HTTPListener{&happyHTTPListener},
+				Tracing: &Tracing{
+					Provider:     TracingProvider("Jaeger"),
+					SamplingRate: 101,
+					CustomTags: []*CustomTag{
+						{Literal: ptrTo("cluster-1")},
+						{Name: "pod", Environment: &CustomTagSource{}},
+					},
+				},
+			},
+			want: []error{
+				ErrTracingServiceNameEmpty, ErrTracingProviderInvalid, ErrTracingDestinationEmpty,
+				ErrTracingSamplingRateInvalid, ErrCustomTagInvalid, ErrCustomTagSourceNameEmpty,
+			},
+		},
 	}
 	for _, test := range tests {
 		test := test
@@ -471,6 +1132,155 @@ func TestValidateHTTPListener(t *testing.T) {
 			input: invalidRouteMatchHTTPListener,
 			want:  []error{ErrHTTPRouteMatchEmpty},
 		},
+		{
+			name: "http3",
+			input: HTTPListener{
+				Name:      "http3",
+				Address:   "0.0.0.0",
+				Port:      443,
+				Hostnames: []string{"example.com"},
+				TLS:       happyHTTPSListener.TLS,
+				HTTP3:     &HTTP3Settings{AdvertisedPort: 8443},
+				Routes:    []*HTTPRoute{&happyHTTPRoute},
+			},
+			want: nil,
+		},
+		{
+			name: "http3 without tls",
+			input: HTTPListener{
+				Name:      "http3-without-tls",
+				Address:   "0.0.0.0",
+				Port:      80,
+				Hostnames: []string{"example.com"},
+				HTTP3:     &HTTP3Settings{},
+				Routes:    []*HTTPRoute{&happyHTTPRoute},
+			},
+			want: []error{ErrHTTP3TLSEmpty},
+		},
+		{
+			name: "client ip detection",
+			input: HTTPListener{
+				Name:      "client-ip-detection",
+				Address:   "0.0.0.0",
+				Port:      80,
+				Hostnames: []string{"example.com"},
+				ClientIPDetection: &ClientIPDetectionSettings{
+					XForwardedForNumTrustedHops: 1,
+					CustomHeader:                &CustomHeaderSettings{Name: "X-Real-IP"},
+					TrustedCIDRs:                []string{"10.0.0.0/8"},
+				},
+				Routes: []*HTTPRoute{&happyHTTPRoute},
+			},
+			want: nil,
+		},
+		{
+			name: "client ip detection with invalid settings",
+			input: HTTPListener{
+				Name:      "client-ip-detection",
+				Address:   "0.0.0.0",
+				Port:      80,
+				Hostnames: []string{"example.com"},
+				ClientIPDetection: &ClientIPDetectionSettings{
+					CustomHeader: &CustomHeaderSettings{},
+					TrustedCIDRs: []string{"10.0.0.0"},
+				},
+				Routes: []*HTTPRoute{&happyHTTPRoute},
+			},
+			want: []error{ErrClientIPHeaderNameEmpty, ErrClientIPCIDRInvalid},
+		},
+		{
+			name: "http settings",
+			input: HTTPListener{
+				Name:      "http-settings",
+				Address:   "0.0.0.0",
+				Port:      80,
+				Hostnames: []string{"example.com"},
+				Timeout: &ClientTimeout{
+					Request:    &metav1.Duration{Duration: 10 * time.Second},
+					StreamIdle: &metav1.Duration{Duration: 0},
+				},
+				Headers: &HeaderSettings{
+					MaxRequestHeadersKiB:       ptrTo(uint32(100)),
+					ServerHeaderTransformation: ServerHeaderPassThrough,
+				},
+				Path:   &PathSettings{Normalize: true, MergeSlashes: true},
+				HTTP1:  &HTTP1Settings{PreserveHeaderCase: true, EnableHTTP10: true, DefaultHostForHTTP10: "example.com"},
+				Routes: []*HTTPRoute{&happyHTTPRoute},
+			},
+			want: nil,
+		},
+		{
+			name: "invalid http settings",
+			input: HTTPListener{
+				Name:      "http-settings",
+				Address:   "0.0.0.0",
+				Port:      80,
+				Hostnames: []string{"example.com"},
+				Timeout: &ClientTimeout{
+					Idle: &metav1.Duration{Duration: -time.Second},
+				},
+				Headers: &HeaderSettings{
+					MaxRequestHeadersKiB:       ptrTo(uint32(8193)),
+					ServerHeaderTransformation: "Remove",
+				},
+				HTTP1:  &HTTP1Settings{DefaultHostForHTTP10: "example.com"},
+				Routes: []*HTTPRoute{&happyHTTPRoute},
+			},
+			want: []error{ErrTimeoutNegative, ErrMaxRequestHeadersInvalid, ErrServerHeaderInvalid, ErrHTTP10DefaultHostInvalid},
+		},
+		{
+			name: "local replies",
+			input: HTTPListener{
+				Name:      "local-replies",
+				Address:   "0.0.0.0",
+				Port:      80,
+				Hostnames: []string{"example.com"},
+				LocalReplies: []*LocalReplyMapper{
+					{
+						StatusCodes: []uint32{404},
+						Body: &LocalReplyBody{
+							Format:   LocalReplyBodyFormatText,
+							Template: "<h1>%RESPONSE_CODE%</h1>",
+						},
+					},
+					{
+						StatusCodes: []uint32{500, 503},
+						StatusCode:  ptrTo(uint32(502)),
+						Body: &LocalReplyBody{
+							Format:   LocalReplyBodyFormatJSON,
+							Template: `{"code": "%RESPONSE_CODE%", "message": "%LOCAL_REPLY_BODY%"}`,
+						},
+					},
+				},
+				Routes: []*HTTPRoute{&happyHTTPRoute},
+			},
+			want: nil,
+		},
+		{
+			name: "invalid local replies",
+			input: HTTPListener{
+				Name:      "local-replies",
+				Address:   "0.0.0.0",
+				Port:      80,
+				Hostnames: []string{"example.com"},
+				LocalReplies: []*LocalReplyMapper{
+					{},
+					{
+						StatusCodes: []uint32{600},
+						Body: &LocalReplyBody{
+							Format:   LocalReplyBodyFormatJSON,
+							Template: `["%RESPONSE_CODE%"]`,
+						},
+					},
+					{
+						StatusCodes: []uint32{404},
+						Body:        &LocalReplyBody{Format: "HTML"},
+					},
+				},
+				Routes: []*HTTPRoute{&happyHTTPRoute},
+			},
+			want: []error{ErrLocalReplyStatusCodesEmpty, ErrLocalReplyMapperEmpty, ErrLocalReplyStatusCodeInvalid, ErrLocalReplyJSONBodyInvalid, ErrLocalReplyBodyFormatInvalid},
+		},
 	}
 	for _, test := range tests {
 		test := test
@@ -513,6 +1323,16 @@ func TestValidateTCPListener(t *testing.T) {
 			input: invalidSNITCPListenerTLSPassthrough,
 			want:  []error{ErrTCPListenesSNIsEmpty},
 		},
+		{
+			name:  "tls passthrough ip filter",
+			input: ipFilterTCPListenerTLSPassthrough,
+			want:  nil,
+		},
+		{
+			name:  "tls passthrough empty ip filter",
+			input: invalidIPFilterTCPListenerTLSPassthrough,
+			want:  []error{ErrIPFilterEmpty},
+		},
 	}
 	for _, test := range tests {
 		test := test
@@ -538,25 +1358,135 @@ func TestValidateTLSListenerConfig(t *testing.T) {
 		{
 			name: "happy",
 			input: TLSListenerConfig{
-				ServerCertificate: []byte("server-cert"),
-				PrivateKey:        []byte("priv-key"),
+				Certificates: []TLSCertificate{{
+					ServerCertificate: []byte("server-cert"),
+					PrivateKey:        []byte("priv-key"),
+				}},
 			},
 			want: nil,
 		},
 		{
 			name: "invalid server cert",
 			input: TLSListenerConfig{
-				PrivateKey: []byte("priv-key"),
+				Certificates: []TLSCertificate{{
+					PrivateKey: []byte("priv-key"),
+				}},
 			},
 			want: ErrTLSServerCertEmpty,
 		},
 		{
 			name: "invalid private key",
 			input: TLSListenerConfig{
-				ServerCertificate: []byte("server-cert"),
+				Certificates: []TLSCertificate{{
+					ServerCertificate: []byte("server-cert"),
+				}},
 			},
 			want: ErrTLSPrivateKey,
 		},
+		{
+			name:  "no certificates",
+			input: TLSListenerConfig{},
+			want:  ErrTLSCertificatesEmpty,
+		},
+		{
+			name: "multiple certificates and parameters",
+			input: TLSListenerConfig{
+				Certificates: []TLSCertificate{
+					{
+						ServerCertificate: []byte("ecdsa-cert"),
+						PrivateKey:        []byte("ecdsa-key"),
+					},
+					{
+						ServerCertificate: []byte("rsa-cert"),
+						PrivateKey:        []byte("rsa-key"),
+					},
+				},
+				MinVersion:    ptrTo(TLSv12),
+				MaxVersion:    ptrTo(TLSv13),
+				Ciphers:       []string{"ECDHE-ECDSA-AES128-GCM-SHA256", "ECDHE-RSA-AES128-GCM-SHA256"},
+				ECDHCurves:    []string{"X25519", "P-256"},
+				ALPNProtocols: []string{"h2", "http/1.1"},
+			},
+			want: nil,
+		},
+		{
+			name: "invalid version",
+			input: TLSListenerConfig{
+				Certificates: []TLSCertificate{{
+					ServerCertificate: []byte("server-cert"),
+					PrivateKey:        []byte("priv-key"),
+				}},
+				MinVersion: ptrTo(TLSVersion("1.4")),
+			},
+			want: ErrTLSVersionInvalid,
+		},
+		{
+			name: "invalid version range",
+			input: TLSListenerConfig{
+				Certificates: []TLSCertificate{{
+					ServerCertificate: []byte("server-cert"),
+					PrivateKey:        []byte("priv-key"),
+				}},
+				MinVersion: ptrTo(TLSv13),
+				MaxVersion: ptrTo(TLSv12),
+			},
+			want: ErrTLSVersionRangeInvalid,
+		},
+		{
+			name: "empty alpn protocol",
+			input: TLSListenerConfig{
+				Certificates: []TLSCertificate{{
+					ServerCertificate: []byte("server-cert"),
+					PrivateKey:        []byte("priv-key"),
+				}},
+				ALPNProtocols: []string{""},
+			},
+			want: ErrTLSParameterEmpty,
+		},
+		{
+			name: "client validation",
+			input: TLSListenerConfig{
+				Certificates: []TLSCertificate{{
+					ServerCertificate: []byte("server-cert"),
+					PrivateKey:        []byte("priv-key"),
+				}},
+				ClientValidation: &TLSClientValidation{
+					CACertificate: []byte("ca-cert"),
+					CRL:           []byte("crl"),
+					SubjectAltNames: []SubjectAltName{
+						{Type: SubjectAltNameDNS, Value: "client.example.com"},
+						{Type: SubjectAltNameURI, Value: "spiffe://example.com/client"},
+					},
+					ForwardClientCert: true,
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "client validation without ca cert",
+			input: TLSListenerConfig{
+				Certificates: []TLSCertificate{{
+					ServerCertificate: []byte("server-cert"),
+					PrivateKey:        []byte("priv-key"),
+				}},
+				ClientValidation: &TLSClientValidation{Optional: true},
+			},
+			want: ErrTLSClientCACertEmpty,
+		},
+		{
+			name: "client validation with invalid subject alt name",
+			input: TLSListenerConfig{
+				Certificates: []TLSCertificate{{
+					ServerCertificate: []byte("server-cert"),
+					PrivateKey:        []byte("priv-key"),
+				}},
+				ClientValidation: &TLSClientValidation{
+					CACertificate:   []byte("ca-cert"),
+					SubjectAltNames: []SubjectAltName{{Type: "OTHER", Value: "client"}},
+				},
+			},
+			want: ErrTLSSubjectAltNameInvalid,
+		},
 	}
 	for _, test := range tests {
 		test := test
@@ -596,6 +1526,28 @@ func TestValidateUDPListener(t *testing.T) {
 			input: invalidPortUDPListenerT,
 			want:  []error{ErrListenerPortInvalid},
 		},
+		{
+			name: "udp source ip hash",
+			input: UDPListener{
+				Name:         "source-ip-hash",
+				Address:      "0.0.0.0",
+				Port:         80,
+				Destinations: []*RouteDestination{&happyRouteDestination},
+				LoadBalancer: &LoadBalancer{ConsistentHash: &ConsistentHash{SourceIP: true}},
+			},
+			want: nil,
+		},
+		{
+			name: "udp header hash",
+			input: UDPListener{
+				Name:         "header-hash",
+				Address:      "0.0.0.0",
+				Port:         80,
+				Destinations: []*RouteDestination{&happyRouteDestination},
+				LoadBalancer: &LoadBalancer{ConsistentHash: &ConsistentHash{Header: &HeaderHashPolicy{Name: "x-user"}}},
+			},
+			want: []error{ErrL4ConsistentHashInvalid},
+		},
 	}
 	for _, test := range tests {
 		test := test
@@ -721,6 +1673,189 @@ func TestValidateHTTPRoute(t *testing.T) {
 			input: addResponseHeaderEmptyHTTPRoute,
 			want:  []error{ErrAddHeaderEmptyName},
 		},
+		{
+			name:  "timeout-retry-httproute",
+			input: timeoutRetryHTTPRoute,
+			want:  nil,
+		},
+		{
+			name:  "timeout-negative",
+			input: timeoutNegativeHTTPRoute,
+			want:  []error{ErrTimeoutNegative},
+		},
+		{
+			name:  "retry-invalid",
+			input: retryInvalidHTTPRoute,
+			want:  []error{ErrRetryStatusCodeInvalid, ErrRetryBackOffIntervalInvalid, ErrRetryBudgetPercentInvalid},
+		},
+		{
+			name:  "retry-backoff-no-base-interval",
+			input: retryBackOffNoBaseHTTPRoute,
+			want:  []error{ErrRetryBackOffBaseIntervalEmpty},
+		},
+		{
+			name:  "health-check-httproute",
+			input: healthCheckHTTPRoute,
+			want:  nil,
+		},
+		{
+			name:  "health-check-invalid",
+			input: healthCheckInvalidHTTPRoute,
+			want:  []error{ErrHTTPHealthCheckPathEmpty, ErrHTTPHealthCheckStatusInvalid, ErrHealthCheckThresholdInvalid, ErrOutlierDetectionPercentInvalid},
+		},
+		{
+			name:  "health-check-multiple-checkers",
+			input: healthCheckMultipleCheckersHTTPRoute,
+			want:  []error{ErrHealthCheckerInvalid},
+		},
+		{
+			name:  "load-balancer-httproute",
+			input: loadBalancerHTTPRoute,
+			want:  nil,
+		},
+		{
+			name:  "load-balancer-multiple",
+			input: loadBalancerMultipleHTTPRoute,
+			want:  []error{ErrLoadBalancerInvalid},
+		},
+		{
+			name:  "consistent-hash-no-policy",
+			input: consistentHashInvalidHTTPRoute,
+			want:  []error{ErrConsistentHashPolicyEmpty},
+		},
+		{
+			name:  "consistent-hash-name-empty",
+			input: consistentHashNameEmptyHTTPRoute,
+			want:  []error{ErrConsistentHashNameEmpty},
+		},
+		{
+			name:  "local-rate-limit-httproute",
+			input: localRateLimitHTTPRoute,
+			want:  nil,
+		},
+		{
+			name:  "local-rate-limit-invalid",
+			input: localRateLimitInvalidHTTPRoute,
+			want: []error{ErrRateLimitRequestsInvalid, ErrRateLimitUnitInvalid, ErrLocalRateLimitRuleEmpty,
+				ErrRateLimitRegexInvalid, ErrRateLimitBurstInvalid, ErrRateLimitStatusCodeInvalid},
+		},
+		{
+			name:  "local-rate-limit-rule-unit",
+			input: localRateLimitRuleUnitHTTPRoute,
+			want:  []error{ErrLocalRateLimitRuleUnitInvalid},
+		},
+		{
+			name:  "global-rate-limit-httproute",
+			input: globalRateLimitHTTPRoute,
+			want:  nil,
+		},
+		{
+			name:  "global-rate-limit-invalid",
+			input: globalRateLimitInvalidHTTPRoute,
+			want:  []error{ErrRateLimitRegexInvalid, ErrRateLimitCIDRInvalid, ErrRateLimitBurstUnsupported},
+		},
+		{
+			name:  "ext-auth-httproute",
+			input: extAuthHTTPRoute,
+			want:  nil,
+		},
+		{
+			name:  "ext-auth-invalid",
+			input: extAuthInvalidHTTPRoute,
+			want:  []error{ErrExtAuthNameEmpty, ErrExtAuthServiceInvalid, ErrTimeoutNegative},
+		},
+		{
+			name:  "ext-auth-no-destination",
+			input: extAuthNoDestinationHTTPRoute,
+			want:  []error{ErrExtAuthDestinationEmpty},
+		},
+		{
+			name:  "oidc-httproute",
+			input: oidcHTTPRoute,
+			want:  nil,
+		},
+		{
+			name:  "oidc-invalid",
+			input: oidcInvalidHTTPRoute,
+			want: []error{ErrOIDCEndpointInvalid, ErrOIDCClientIDEmpty, ErrOIDCClientSecretEmpty,
+				ErrOIDCHMACSecretEmpty, ErrOIDCRedirectInvalid, ErrOIDCLogoutPathInvalid},
+		},
+		{
+			name:  "basic-auth-httproute",
+			input: basicAuthHTTPRoute,
+			want:  nil,
+		},
+		{
+			name:  "basic-auth-invalid",
+			input: basicAuthInvalidHTTPRoute,
+			want:  []error{ErrBasicAuthNameEmpty, ErrBasicAuthUserInvalid},
+		},
+		{
+			name:  "basic-auth-no-users",
+			input: basicAuthNoUsersHTTPRoute,
+			want:  []error{ErrBasicAuthUsersEmpty},
+		},
+		{
+			name:  "jwt-httproute",
+			input: jwtHTTPRoute,
+			want:  nil,
+		},
+		{
+			name:  "jwt-invalid",
+			input: jwtInvalidHTTPRoute,
+			want: []error{ErrJWTNameEmpty, ErrJWTRemoteJWKSURIInvalid, ErrJWTClaimToHeaderInvalid,
+				ErrJWTProviderNameInvalid, ErrJWTProviderJWKSInvalid, ErrJWTExtractorInvalid, ErrJWTClockSkewNegative},
+		},
+		{
+			name:  "jwt-no-providers",
+			input: jwtNoProvidersHTTPRoute,
+			want:  []error{ErrJWTProvidersEmpty},
+		},
+		{
+			name:  "ip-filter-httproute",
+			input: ipFilterHTTPRoute,
+			want:  nil,
+		},
+		{
+			name:  "ip-filter-invalid",
+			input: ipFilterInvalidHTTPRoute,
+			want:  []error{ErrIPFilterCIDRInvalid},
+		},
+		{
+			name:  "cors",
+			input: corsHTTPRoute,
+			want:  nil,
+		},
+		{
+			name:  "cors-invalid",
+			input: corsInvalidHTTPRoute,
+			want:  []error{ErrCORSOriginRegexInvalid, ErrCORSOriginInvalid, ErrCORSMaxAgeNegative},
+		},
+		{
+			name:  "cors-no-origins",
+			input: corsNoOriginsHTTPRoute,
+			want:  []error{ErrCORSAllowOriginsEmpty},
+		},
+		{
+			name:  "compression",
+			input: compressionHTTPRoute,
+			want:  nil,
+		},
+		{
+			name:  "compression-invalid",
+			input: compressionInvalidHTTPRoute,
+			want:  []error{ErrCompressionNameEmpty, ErrCompressionAlgorithmInvalid, ErrCompressionAlgorithmDuplicate},
+		},
+		{
+			name:  "fault-injection",
+			input: faultInjectionHTTPRoute,
+			want:  nil,
+		},
+		{
+			name:  "fault-injection-invalid",
+			input: faultInjectionInvalidHTTPRoute,
+			want:  []error{ErrFaultDelayInvalid, ErrFaultPercentageInvalid, ErrFaultAbortInvalid, ErrFaultAbortHTTPStatusInvalid},
+		},
 	}
 	for _, test := range tests {
 		test := test
@@ -763,6 +1898,44 @@ func TestValidateRouteDestination(t *testing.T) {
 			},
 			want: ErrRouteDestinationPortInvalid,
 		},
+		{
+			name: "tls",
+			input: RouteDestination{
+				Host: "10.11.12.13",
+				Port: 8443,
+				TLS: &TLSUpstreamConfig{
+					Name:          "backendtlspolicy/default/policy-1",
+					SNI:           "backend.example.com",
+					CACertificate: []byte("ca-cert"),
+					ClientCertificate: &TLSCertificate{
+						ServerCertificate: []byte("client-cert"),
+						PrivateKey:        []byte("client-key"),
+					},
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "tls without name",
+			input: RouteDestination{
+				Host: "10.11.12.13",
+				Port: 8443,
+				TLS:  &TLSUpstreamConfig{SNI: "backend.example.com"},
+			},
+			want: ErrTLSUpstreamNameEmpty,
+		},
+		{
+			name: "tls with invalid client certificate",
+			input: RouteDestination{
+				Host: "10.11.12.13",
+				Port: 8443,
+				TLS: &TLSUpstreamConfig{
+					Name:              "backendtlspolicy/default/policy-1",
+					ClientCertificate: &TLSCertificate{ServerCertificate: []byte("client-cert")},
+				},
+			},
+			want: ErrTLSPrivateKey,
+		},
 	}
 	for _, test := range tests {
 		test := test
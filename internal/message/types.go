@@ -11,6 +11,8 @@ import (
 	gwapiv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 	gwapiv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 
+	egcfgv1a1 "github.com/envoyproxy/gateway/api/config/v1alpha1"
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
 	"github.com/envoyproxy/gateway/internal/gatewayapi"
 	"github.com/envoyproxy/gateway/internal/ir"
 	xdstypes "github.com/envoyproxy/gateway/internal/xds/types"
@@ -26,6 +28,13 @@ type ProviderResources struct {
 	HTTPRouteStatuses watchable.Map[types.NamespacedName, *gwapiv1b1.HTTPRoute]
 	TLSRouteStatuses  watchable.Map[types.NamespacedName, *gwapiv1a2.TLSRoute]
 	UDPRouteStatuses  watchable.Map[types.NamespacedName, *gwapiv1a2.UDPRoute]
+
+	ClientTrafficPolicyStatuses  watchable.Map[types.NamespacedName, *egv1a1.ClientTrafficPolicy]
+	BackendTrafficPolicyStatuses watchable.Map[types.NamespacedName, *egv1a1.BackendTrafficPolicy]
+	BackendTLSPolicyStatuses     watchable.Map[types.NamespacedName, *egv1a1.BackendTLSPolicy]
+	SecurityPolicyStatuses       watchable.Map[types.NamespacedName, *egv1a1.SecurityPolicy]
+
+	EnvoyProxyStatuses watchable.Map[types.NamespacedName, *egcfgv1a1.EnvoyProxy]
 }
 
 func (p *ProviderResources) GetResources() *gatewayapi.Resources {
@@ -9,6 +9,8 @@ import (
 	"context"
 	"fmt"
 
+	egcfgv1a1 "github.com/envoyproxy/gateway/api/config/v1alpha1"
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
 	"github.com/envoyproxy/gateway/internal/envoygateway/config"
 	"github.com/envoyproxy/gateway/internal/gatewayapi"
 	"github.com/envoyproxy/gateway/internal/message"
@@ -35,13 +37,20 @@ import (
 )
 
 const (
-	classGatewayIndex        = "classGatewayIndex"
-	gatewayTLSRouteIndex     = "gatewayTLSRouteIndex"
-	gatewayHTTPRouteIndex    = "gatewayHTTPRouteIndex"
-	secretGatewayIndex       = "secretGatewayIndex"
-	targetRefGrantRouteIndex = "targetRefGrantRouteIndex"
-	serviceHTTPRouteIndex    = "serviceHTTPRouteIndex"
-	serviceTLSRouteIndex     = "serviceTLSRouteIndex"
+	classGatewayIndex         = "classGatewayIndex"
+	gatewayTLSRouteIndex      = "gatewayTLSRouteIndex"
+	gatewayHTTPRouteIndex     = "gatewayHTTPRouteIndex"
+	secretGatewayIndex        = "secretGatewayIndex"
+	configMapGatewayIndex     = "configMapGatewayIndex"
+	targetRefGrantRouteIndex  = "targetRefGrantRouteIndex"
+	serviceHTTPRouteIndex     = "serviceHTTPRouteIndex"
+	serviceTLSRouteIndex      = "serviceTLSRouteIndex"
+	secretAuthnFilterIndex    = "secretAuthnFilterIndex"
+	configMapAuthnFilterIndex = "configMapAuthnFilterIndex"
+	secretBackendTLSIndex     = "secretBackendTLSIndex"
+	configMapBackendTLSIndex  = "configMapBackendTLSIndex"
+	configMapCTPIndex         = "configMapCTPIndex"
+	serviceExtAuthIndex       = "serviceExtAuthIndex"
 )
 
 type gatewayAPIReconciler struct {
@@ -50,6 +59,7 @@ type gatewayAPIReconciler struct {
 	statusUpdater   status.Updater
 	classController gwapiv1b1.GatewayController
 	namespace       string
+	oidcDiscoverer  *oidcDiscoverer
 
 	resources *message.ProviderResources
 }
@@ -64,6 +74,7 @@ func newGatewayAPIController(mgr manager.Manager, cfg *config.Server, su status.
 		classController: gwapiv1b1.GatewayController(cfg.EnvoyGateway.Gateway.ControllerName),
 		namespace:       cfg.Namespace,
 		statusUpdater:   su,
+		oidcDiscoverer:  newOIDCDiscoverer(),
 		resources:       resources,
 	}
 
@@ -136,6 +147,15 @@ func newGatewayAPIController(mgr manager.Manager, cfg *config.Server, su status.
 		return err
 	}
 
+	// Watch ConfigMap CRUDs and process affected Gateways.
+	if err := c.Watch(
+		&source.Kind{Type: &corev1.ConfigMap{}},
+		&handler.EnqueueRequestForObject{},
+		predicate.NewPredicateFuncs(r.validateConfigMapForReconcile),
+	); err != nil {
+		return err
+	}
+
 	// Watch ReferenceGrant CRUDs and process affected Gateways.
 	if err := c.Watch(
 		&source.Kind{Type: &gwapiv1a2.ReferenceGrant{}},
@@ -147,6 +167,66 @@ func newGatewayAPIController(mgr manager.Manager, cfg *config.Server, su status.
 		return err
 	}
 
+	// Watch ClientTrafficPolicy CRUDs and process affected Gateways.
+	if err := c.Watch(
+		&source.Kind{Type: &egv1a1.ClientTrafficPolicy{}},
+		&handler.EnqueueRequestForObject{},
+	); err != nil {
+		return err
+	}
+	if err := addClientTrafficPolicyIndexers(ctx, mgr); err != nil {
+		return err
+	}
+
+	// Watch BackendTrafficPolicy CRUDs and process affected Gateways.
+	if err := c.Watch(
+		&source.Kind{Type: &egv1a1.BackendTrafficPolicy{}},
+		&handler.EnqueueRequestForObject{},
+	); err != nil {
+		return err
+	}
+
+	// Watch BackendTLSPolicy CRUDs and process affected Gateways.
+	if err := c.Watch(
+		&source.Kind{Type: &egv1a1.BackendTLSPolicy{}},
+		&handler.EnqueueRequestForObject{},
+	); err != nil {
+		return err
+	}
+	if err := addBackendTLSPolicyIndexers(ctx, mgr); err != nil {
+		return err
+	}
+
+	// Watch SecurityPolicy CRUDs and process affected Gateways.
+	if err := c.Watch(
+		&source.Kind{Type: &egv1a1.SecurityPolicy{}},
+		&handler.EnqueueRequestForObject{},
+	); err != nil {
+		return err
+	}
+	if err := addSecurityPolicyIndexers(ctx, mgr); err != nil {
+		return err
+	}
+
+	// Watch AuthenticationFilter CRUDs and process affected Gateways.
+	if err := c.Watch(
+		&source.Kind{Type: &egv1a1.AuthenticationFilter{}},
+		&handler.EnqueueRequestForObject{},
+	); err != nil {
+		return err
+	}
+	if err := addAuthenticationFilterIndexers(ctx, mgr); err != nil {
+		return err
+	}
+
+	// Watch EnvoyProxy CRUDs and process the GatewayClass referencing them.
+	if err := c.Watch(
+		&source.Kind{Type: &egcfgv1a1.EnvoyProxy{}},
+		&handler.EnqueueRequestForObject{},
+	); err != nil {
+		return err
+	}
+
 	// Watch Deployment CRUDs and process affected Gateways.
 	if err := c.Watch(
 		&source.Kind{Type: &appsv1.Deployment{}},
@@ -246,6 +326,12 @@ func (r *gatewayAPIReconciler) Reconcile(ctx context.Context, request reconcile.
 		Secrets:         []*corev1.Secret{},
 		ReferenceGrants: []*gwapiv1a2.ReferenceGrant{},
 		Namespaces:      []*corev1.Namespace{},
+
+		ClientTrafficPolicies:  []*egv1a1.ClientTrafficPolicy{},
+		BackendTrafficPolicies: []*egv1a1.BackendTrafficPolicy{},
+		BackendTLSPolicies:     []*egv1a1.BackendTLSPolicy{},
+		SecurityPolicies:       []*egv1a1.SecurityPolicy{},
+		AuthenticationFilters:  []*egv1a1.AuthenticationFilter{},
 	}
 
 	resourceMap := &resourceMappings{
@@ -254,6 +340,11 @@ func (r *gatewayAPIReconciler) Reconcile(ctx context.Context, request reconcile.
 		allAssociatedRefGrants:   map[types.NamespacedName]*gwapiv1a2.ReferenceGrant{},
 	}
 
+	// Add the EnvoyProxy referenced by the GatewayClass to the resourceTree.
+	if err := r.processParamsRef(ctx, acceptedGC, resourceMap, resourceTree); err != nil {
+		return reconcile.Result{}, err
+	}
+
 	// Find gateways for the acceptedGC
 	// Find the Gateways that reference this Class.
 	gatewayList := &gwapiv1b1.GatewayList{}
@@ -305,6 +396,12 @@ func (r *gatewayAPIReconciler) Reconcile(ctx context.Context, request reconcile.
 						resourceTree.Secrets = append(resourceTree.Secrets, secret)
 					}
 				}
+
+				// Get the Secret or ConfigMap holding the CA certificates validating
+				// the client certificates, if any.
+				if err := r.processClientCARefs(ctx, &gtw, &listener, resourceTree); err != nil {
+					return reconcile.Result{}, err
+				}
 			}
 		}
 
@@ -322,6 +419,24 @@ func (r *gatewayAPIReconciler) Reconcile(ctx context.Context, request reconcile.
 		resourceTree.Gateways = append(resourceTree.Gateways, &gtw)
 	}
 
+	// Add all SecurityPolicies to the resourceTree, along with the backends
+	// of their external authorization services.
+	if err := r.processSecurityPolicies(ctx, resourceMap, resourceTree); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	// Add all AuthenticationFilters to the resourceTree, along with the Secrets
+	// they reference.
+	if err := r.processAuthenticationFilters(ctx, resourceMap, resourceTree); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	// Add all BackendTLSPolicies to the resourceTree, along with the Services
+	// they target and the certificates they reference.
+	if err := r.processBackendTLSPolicies(ctx, resourceMap, resourceTree); err != nil {
+		return reconcile.Result{}, err
+	}
+
 	for serviceNamespaceName := range resourceMap.allAssociatedBackendRefs {
 		r.log.Info("processing Service", "namespace", serviceNamespaceName.Namespace,
 			"name", serviceNamespaceName.Name)
@@ -340,6 +455,16 @@ func (r *gatewayAPIReconciler) Reconcile(ctx context.Context, request reconcile.
 		resourceTree.Services = append(resourceTree.Services, service)
 	}
 
+	// Add all ClientTrafficPolicies to the resourceTree
+	if err := r.processClientTrafficPolicies(ctx, resourceTree); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	// Add all BackendTrafficPolicies to the resourceTree
+	if err := r.processBackendTrafficPolicies(ctx, resourceTree); err != nil {
+		return reconcile.Result{}, err
+	}
+
 	// Add all ReferenceGrants to the resourceTree
 	for _, referenceGrant := range resourceMap.allAssociatedRefGrants {
 		resourceTree.ReferenceGrants = append(resourceTree.ReferenceGrants, referenceGrant)
@@ -390,9 +515,377 @@ func (r *gatewayAPIReconciler) Reconcile(ctx context.Context, request reconcile.
 	r.resources.GatewayAPIResources.Store(acceptedGC.Name, resourceTree)
 
 	r.log.WithName(request.Name).Info("reconciled gatewayAPI object successfully", "namespace", request.Namespace, "name", request.Name)
+
+	// Retry the discovery of the OIDC providers that could not be reached, since
+	// no event triggers a reconciliation once they become reachable.
+	for _, provider := range resourceTree.OIDCProviders {
+		if provider.Error != "" {
+			return reconcile.Result{RequeueAfter: oidcDiscoveryRetryInterval}, nil
+		}
+	}
 	return reconcile.Result{}, nil
 }
 
+// envoyProxyKey returns the namespace and name of the EnvoyProxy referenced by
+// the parameters of the GatewayClass, which is in the namespace of Envoy Gateway
+// if the reference has no namespace.
+func (r *gatewayAPIReconciler) envoyProxyKey(gc *gwapiv1b1.GatewayClass) types.NamespacedName {
+	ref := gc.Spec.ParametersRef
+	key := types.NamespacedName{Namespace: r.namespace, Name: ref.Name}
+	if ref.Namespace != nil {
+		key.Namespace = string(*ref.Namespace)
+	}
+	return key
+}
+
+// processParamsRef adds the EnvoyProxy referenced by the parameters of the
+// GatewayClass to the resourceTree. The EnvoyProxy is looked up in the namespace
+// of Envoy Gateway if the reference has no namespace.
+func (r *gatewayAPIReconciler) processParamsRef(ctx context.Context, gc *gwapiv1b1.GatewayClass,
+	resourceMap *resourceMappings, resourceTree *gatewayapi.Resources) error {
+	if !refsEnvoyProxy(gc) {
+		return nil
+	}
+
+	key := r.envoyProxyKey(gc)
+	envoyProxy := new(egcfgv1a1.EnvoyProxy)
+	if err := r.client.Get(ctx, key, envoyProxy); err != nil {
+		if kerrors.IsNotFound(err) {
+			r.log.Info("envoyproxy referenced by gatewayclass not found", "namespace", key.Namespace,
+				"name", key.Name)
+			return nil
+		}
+		return fmt.Errorf("error getting envoyproxy %s: %w", key, err)
+	}
+	resourceTree.EnvoyProxy = envoyProxy
+
+	// Add the Service of the tracing collector, along with the ReferenceGrant
+	// allowing the reference if the Service is in another namespace.
+	telemetry := envoyProxy.Spec.Telemetry
+	if telemetry == nil || telemetry.Tracing == nil {
+		return nil
+	}
+	backendRef := telemetry.Tracing.Provider.BackendRef
+	if err := validateBackendRef(&gwapiv1b1.BackendRef{BackendObjectReference: backendRef}); err != nil {
+		r.log.Error(err, "invalid backendRef of the tracing provider")
+		return nil
+	}
+
+	backendNamespace := gatewayapi.NamespaceDerefOr(backendRef.Namespace, envoyProxy.Namespace)
+	resourceMap.allAssociatedBackendRefs[types.NamespacedName{
+		Namespace: backendNamespace,
+		Name:      string(backendRef.Name),
+	}] = struct{}{}
+
+	if backendNamespace != envoyProxy.Namespace {
+		from := ObjectKindNamespacedName{kind: egcfgv1a1.KindEnvoyProxy, namespace: envoyProxy.Namespace, name: envoyProxy.Name}
+		to := ObjectKindNamespacedName{kind: gatewayapi.KindService, namespace: backendNamespace, name: string(backendRef.Name)}
+		refGrant, err := r.findReferenceGrant(ctx, from, to)
+		if err != nil {
+			r.log.Error(err, "unable to find ReferenceGrant that links the Service to EnvoyProxy")
+			return nil
+		}
+
+		resourceMap.allAssociatedRefGrants[utils.NamespacedName(refGrant)] = refGrant
+	}
+
+	return nil
+}
+
+// processClientTrafficPolicies adds all ClientTrafficPolicies to the resourceTree,
+// along with the ConfigMaps they reference.
+// Target resolution is left to the translator.
+func (r *gatewayAPIReconciler) processClientTrafficPolicies(ctx context.Context, resourceTree *gatewayapi.Resources) error {
+	clientTrafficPolicies := egv1a1.ClientTrafficPolicyList{}
+	if err := r.client.List(ctx, &clientTrafficPolicies); err != nil {
+		return fmt.Errorf("error listing clienttrafficpolicies: %w", err)
+	}
+
+	for _, policy := range clientTrafficPolicies.Items {
+		policy := policy
+		// Discard the status so the translator computes it from scratch.
+		policy.Status = egv1a1.PolicyStatus{}
+		resourceTree.ClientTrafficPolicies = append(resourceTree.ClientTrafficPolicies, &policy)
+
+		for _, configMapName := range clientTrafficPolicyConfigMapNames(&policy) {
+			if err := r.processPolicyConfigMap(ctx, resourceTree, policy.Namespace, configMapName); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// processBackendTrafficPolicies adds all BackendTrafficPolicies to the resourceTree.
+// Target resolution is left to the translator.
+func (r *gatewayAPIReconciler) processBackendTrafficPolicies(ctx context.Context, resourceTree *gatewayapi.Resources) error {
+	backendTrafficPolicies := egv1a1.BackendTrafficPolicyList{}
+	if err := r.client.List(ctx, &backendTrafficPolicies); err != nil {
+		return fmt.Errorf("error listing backendtrafficpolicies: %w", err)
+	}
+
+	for _, policy := range backendTrafficPolicies.Items {
+		policy := policy
+		// Discard the status so the translator computes it from scratch.
+		policy.Status = egv1a1.PolicyStatus{}
+		resourceTree.BackendTrafficPolicies = append(resourceTree.BackendTrafficPolicies, &policy)
+	}
+
+	return nil
+}
+
+// processSecurityPolicies adds all SecurityPolicies to the resourceTree, and the
+// Services of their external authorization services to the resourceMap.
+// Target resolution is left to the translator.
+func (r *gatewayAPIReconciler) processSecurityPolicies(ctx context.Context, resourceMap *resourceMappings,
+	resourceTree *gatewayapi.Resources) error {
+	securityPolicies := egv1a1.SecurityPolicyList{}
+	if err := r.client.List(ctx, &securityPolicies); err != nil {
+		return fmt.Errorf("error listing securitypolicies: %w", err)
+	}
+
+	for _, policy := range securityPolicies.Items {
+		policy := policy
+		// Discard the status so the translator computes it from scratch.
+		policy.Status = egv1a1.PolicyStatus{}
+		resourceTree.SecurityPolicies = append(resourceTree.SecurityPolicies, &policy)
+
+		backendRef := securityPolicyExtAuthBackendRef(&policy)
+		if backendRef == nil {
+			continue
+		}
+		if err := validateBackendRef(&gwapiv1b1.BackendRef{BackendObjectReference: *backendRef}); err != nil {
+			r.log.Error(err, "invalid backendRef")
+			continue
+		}
+
+		backendNamespace := gatewayapi.NamespaceDerefOr(backendRef.Namespace, policy.Namespace)
+		resourceMap.allAssociatedBackendRefs[types.NamespacedName{
+			Namespace: backendNamespace,
+			Name:      string(backendRef.Name),
+		}] = struct{}{}
+
+		if backendNamespace != policy.Namespace {
+			from := ObjectKindNamespacedName{kind: egv1a1.KindSecurityPolicy, namespace: policy.Namespace, name: policy.Name}
+			to := ObjectKindNamespacedName{kind: gatewayapi.KindService, namespace: backendNamespace, name: string(backendRef.Name)}
+			refGrant, err := r.findReferenceGrant(ctx, from, to)
+			if err != nil {
+				r.log.Error(err, "unable to find ReferenceGrant that links the Service to SecurityPolicy")
+				continue
+			}
+
+			resourceMap.allAssociatedRefGrants[utils.NamespacedName(refGrant)] = refGrant
+		}
+	}
+
+	return nil
+}
+
+// processAuthenticationFilters adds all AuthenticationFilters to the resourceTree,
+// along with the Secrets they reference and the configurations of their OIDC providers.
+// Resolution of the filters referenced by HTTPRoutes is left to the translator.
+func (r *gatewayAPIReconciler) processAuthenticationFilters(ctx context.Context, resourceMap *resourceMappings,
+	resourceTree *gatewayapi.Resources) error {
+	authenticationFilters := egv1a1.AuthenticationFilterList{}
+	if err := r.client.List(ctx, &authenticationFilters); err != nil {
+		return fmt.Errorf("error listing authenticationfilters: %w", err)
+	}
+
+	for _, filter := range authenticationFilters.Items {
+		filter := filter
+		resourceTree.AuthenticationFilters = append(resourceTree.AuthenticationFilters, &filter)
+
+		from := ObjectKindNamespacedName{kind: egv1a1.KindAuthenticationFilter, namespace: filter.Namespace, name: filter.Name}
+		for _, secretRef := range authenticationFilterSecretRefs(&filter) {
+			if err := r.processPolicySecretRef(ctx, resourceMap, resourceTree, from, secretRef); err != nil {
+				return err
+			}
+		}
+
+		for _, configMapName := range authenticationFilterConfigMapNames(&filter) {
+			if err := r.processPolicyConfigMap(ctx, resourceTree, filter.Namespace, configMapName); err != nil {
+				return err
+			}
+		}
+
+		if filter.Spec.OIDC != nil {
+			if err := r.processOIDCProvider(ctx, resourceTree, filter.Spec.OIDC); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// processOIDCProvider adds the Secret holding the HMAC secret signing the cookies
+// of the OIDC authentication to the resourceTree, along with the configuration
+// discovered from the issuer if the endpoints of the provider are not all specified.
+func (r *gatewayAPIReconciler) processOIDCProvider(ctx context.Context, resourceTree *gatewayapi.Resources,
+	provider *egv1a1.OIDCAuthenticationFilterProvider) error {
+	if resourceTree.GetSecret(r.namespace, config.OIDCHMACSecretName) == nil {
+		secret := new(corev1.Secret)
+		err := r.client.Get(ctx, types.NamespacedName{Namespace: r.namespace, Name: config.OIDCHMACSecretName}, secret)
+		switch {
+		case err == nil:
+			resourceTree.Secrets = append(resourceTree.Secrets, secret)
+		case !kerrors.IsNotFound(err):
+			r.log.Error(err, "unable to find Secret")
+			return err
+		}
+	}
+
+	if (provider.AuthorizationEndpoint == nil || provider.TokenEndpoint == nil) &&
+		resourceTree.GetOIDCProvider(provider.Issuer) == nil {
+		resourceTree.OIDCProviders = append(resourceTree.OIDCProviders, r.oidcDiscoverer.discover(ctx, provider.Issuer))
+	}
+	return nil
+}
+
+// processBackendTLSPolicies adds all BackendTLSPolicies to the resourceTree,
+// along with the Services they target and the Secrets and ConfigMaps they reference.
+func (r *gatewayAPIReconciler) processBackendTLSPolicies(ctx context.Context, resourceMap *resourceMappings,
+	resourceTree *gatewayapi.Resources) error {
+	backendTLSPolicies := egv1a1.BackendTLSPolicyList{}
+	if err := r.client.List(ctx, &backendTLSPolicies); err != nil {
+		return fmt.Errorf("error listing backendtlspolicies: %w", err)
+	}
+
+	for _, policy := range backendTLSPolicies.Items {
+		policy := policy
+		// Discard the status so the translator computes it from scratch.
+		policy.Status = egv1a1.PolicyStatus{}
+		resourceTree.BackendTLSPolicies = append(resourceTree.BackendTLSPolicies, &policy)
+
+		if string(policy.Spec.TargetRef.Kind) == gatewayapi.KindService {
+			resourceMap.allAssociatedBackendRefs[types.NamespacedName{
+				Namespace: policy.Namespace,
+				Name:      string(policy.Spec.TargetRef.Name),
+			}] = struct{}{}
+		}
+
+		from := ObjectKindNamespacedName{kind: egv1a1.KindBackendTLSPolicy, namespace: policy.Namespace, name: policy.Name}
+		for _, secretRef := range backendTLSPolicySecretRefs(&policy) {
+			if err := r.processPolicySecretRef(ctx, resourceMap, resourceTree, from, secretRef); err != nil {
+				return err
+			}
+		}
+
+		for _, configMapName := range backendTLSPolicyConfigMapNames(&policy) {
+			if err := r.processPolicyConfigMap(ctx, resourceTree, policy.Namespace, configMapName); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// processPolicySecretRef adds the Secret referenced by the from object to the
+// resourceTree, along with the ReferenceGrant allowing the reference when the
+// Secret is in another namespace. Missing Secrets are reported by the translator.
+func (r *gatewayAPIReconciler) processPolicySecretRef(ctx context.Context, resourceMap *resourceMappings,
+	resourceTree *gatewayapi.Resources, from ObjectKindNamespacedName, secretRef gwapiv1b1.SecretObjectReference) error {
+	if !refsSecret(&secretRef) {
+		return nil
+	}
+	secretNamespace := gatewayapi.NamespaceDerefOr(secretRef.Namespace, from.namespace)
+	if resourceTree.GetSecret(secretNamespace, string(secretRef.Name)) != nil {
+		return nil
+	}
+
+	secret := new(corev1.Secret)
+	err := r.client.Get(ctx,
+		types.NamespacedName{Namespace: secretNamespace, Name: string(secretRef.Name)},
+		secret,
+	)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil
+		}
+		r.log.Error(err, "unable to find Secret")
+		return err
+	}
+
+	r.log.Info("processing Secret", "namespace", secretNamespace, "name", string(secretRef.Name))
+
+	if secretNamespace != from.namespace {
+		to := ObjectKindNamespacedName{kind: gatewayapi.KindSecret, namespace: secretNamespace, name: string(secretRef.Name)}
+		refGrant, err := r.findReferenceGrant(ctx, from, to)
+		if err != nil {
+			r.log.Error(err, "unable to find ReferenceGrant that links the Secret to "+from.kind)
+			return nil
+		}
+
+		resourceMap.allAssociatedRefGrants[utils.NamespacedName(refGrant)] = refGrant
+	}
+
+	resourceMap.allAssociatedNamespaces[secretNamespace] = struct{}{}
+	resourceTree.Secrets = append(resourceTree.Secrets, secret)
+	return nil
+}
+
+// processPolicyConfigMap adds the ConfigMap with the given namespace and name to
+// the resourceTree. Missing ConfigMaps are reported by the translator.
+func (r *gatewayAPIReconciler) processPolicyConfigMap(ctx context.Context, resourceTree *gatewayapi.Resources,
+	namespace, name string) error {
+	if resourceTree.GetConfigMap(namespace, name) != nil {
+		return nil
+	}
+
+	configMap := new(corev1.ConfigMap)
+	err := r.client.Get(ctx,
+		types.NamespacedName{Namespace: namespace, Name: name},
+		configMap,
+	)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil
+		}
+		r.log.Error(err, "unable to find ConfigMap")
+		return err
+	}
+
+	r.log.Info("processing ConfigMap", "namespace", namespace, "name", name)
+	resourceTree.ConfigMaps = append(resourceTree.ConfigMaps, configMap)
+	return nil
+}
+
+// processClientCARefs adds the Secret or ConfigMap holding the CA certificates
+// validating the client certificates of the listener to the resource tree.
+func (r *gatewayAPIReconciler) processClientCARefs(ctx context.Context, gtw *gwapiv1b1.Gateway,
+	listener *gwapiv1b1.Listener, resourceTree *gatewayapi.Resources) error {
+	secretName, configMapName := clientCARefs(listener)
+	if secretName != "" && resourceTree.GetSecret(gtw.Namespace, secretName) == nil {
+		secret := new(corev1.Secret)
+		err := r.client.Get(ctx, types.NamespacedName{Namespace: gtw.Namespace, Name: secretName}, secret)
+		if err != nil && !kerrors.IsNotFound(err) {
+			r.log.Error(err, "unable to find Secret")
+			return err
+		}
+		if err == nil {
+			r.log.Info("processing Secret", "namespace", gtw.Namespace, "name", secretName)
+			resourceTree.Secrets = append(resourceTree.Secrets, secret)
+		}
+	}
+
+	if configMapName != "" && resourceTree.GetConfigMap(gtw.Namespace, configMapName) == nil {
+		configMap := new(corev1.ConfigMap)
+		err := r.client.Get(ctx, types.NamespacedName{Namespace: gtw.Namespace, Name: configMapName}, configMap)
+		if err != nil && !kerrors.IsNotFound(err) {
+			r.log.Error(err, "unable to find ConfigMap")
+			return err
+		}
+		if err == nil {
+			r.log.Info("processing ConfigMap", "namespace", gtw.Namespace, "name", configMapName)
+			resourceTree.ConfigMaps = append(resourceTree.ConfigMaps, configMap)
+		}
+	}
+
+	return nil
+}
+
 func (r *gatewayAPIReconciler) getNamespace(ctx context.Context, name string) (*corev1.Namespace, error) {
 	nsKey := types.NamespacedName{Name: name}
 	ns := new(corev1.Namespace)
@@ -562,14 +1055,15 @@ func addTLSRouteIndexers(ctx context.Context, mgr manager.Manager) error {
 	return nil
 }
 
-// addGatewayIndexers adds indexing on Gateway, for Secret objects that are
-// referenced in Gateway objects. This helps in querying for Gateways that are
+// addGatewayIndexers adds indexing on Gateway, for Secret and ConfigMap objects
+// that are referenced in Gateway objects. This helps in querying for Gateways that are
 // affected by a particular Secret CRUD.
 func addGatewayIndexers(ctx context.Context, mgr manager.Manager) error {
 	if err := mgr.GetFieldIndexer().IndexField(ctx, &gwapiv1b1.Gateway{}, secretGatewayIndex, func(rawObj client.Object) []string {
 		gateway := rawObj.(*gwapiv1b1.Gateway)
 		var secretReferences []string
 		for _, listener := range gateway.Spec.Listeners {
+			listener := listener
 			if listener.TLS == nil || *listener.TLS.Mode != gwapiv1b1.TLSModeTerminate {
 				continue
 			}
@@ -585,12 +1079,31 @@ func addGatewayIndexers(ctx context.Context, mgr manager.Manager) error {
 					)
 				}
 			}
+			if secretName, _ := clientCARefs(&listener); secretName != "" {
+				secretReferences = append(secretReferences,
+					types.NamespacedName{Namespace: gateway.Namespace, Name: secretName}.String())
+			}
 		}
 		return secretReferences
 	}); err != nil {
 		return err
 	}
 
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &gwapiv1b1.Gateway{}, configMapGatewayIndex, func(rawObj client.Object) []string {
+		gateway := rawObj.(*gwapiv1b1.Gateway)
+		var configMapReferences []string
+		for _, listener := range gateway.Spec.Listeners {
+			listener := listener
+			if _, configMapName := clientCARefs(&listener); configMapName != "" {
+				configMapReferences = append(configMapReferences,
+					types.NamespacedName{Namespace: gateway.Namespace, Name: configMapName}.String())
+			}
+		}
+		return configMapReferences
+	}); err != nil {
+		return err
+	}
+
 	if err := mgr.GetFieldIndexer().IndexField(ctx, &gwapiv1b1.Gateway{}, classGatewayIndex, func(rawObj client.Object) []string {
 		gateway := rawObj.(*gwapiv1b1.Gateway)
 		return []string{string(gateway.Spec.GatewayClassName)}
@@ -600,6 +1113,126 @@ func addGatewayIndexers(ctx context.Context, mgr manager.Manager) error {
 	return nil
 }
 
+// addAuthenticationFilterIndexers adds indexing on AuthenticationFilter, for Secret
+// and ConfigMap objects that are referenced by their authentication providers. This helps in querying for
+// AuthenticationFilters that are affected by a particular Secret CRUD.
+func addAuthenticationFilterIndexers(ctx context.Context, mgr manager.Manager) error {
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &egv1a1.AuthenticationFilter{}, secretAuthnFilterIndex, func(rawObj client.Object) []string {
+		filter := rawObj.(*egv1a1.AuthenticationFilter)
+		var secretReferences []string
+		for _, secretRef := range authenticationFilterSecretRefs(filter) {
+			secretReferences = append(secretReferences,
+				types.NamespacedName{
+					Namespace: gatewayapi.NamespaceDerefOr(secretRef.Namespace, filter.Namespace),
+					Name:      string(secretRef.Name),
+				}.String(),
+			)
+		}
+		return secretReferences
+	}); err != nil {
+		return err
+	}
+
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &egv1a1.AuthenticationFilter{}, configMapAuthnFilterIndex, func(rawObj client.Object) []string {
+		filter := rawObj.(*egv1a1.AuthenticationFilter)
+		var configMapReferences []string
+		for _, name := range authenticationFilterConfigMapNames(filter) {
+			configMapReferences = append(configMapReferences,
+				types.NamespacedName{
+					Namespace: filter.Namespace,
+					Name:      name,
+				}.String(),
+			)
+		}
+		return configMapReferences
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+// addClientTrafficPolicyIndexers adds indexing on ClientTrafficPolicy, for ConfigMap
+// objects that are referenced by their local replies. This helps in querying for
+// ClientTrafficPolicies that are affected by a particular ConfigMap CRUD.
+func addClientTrafficPolicyIndexers(ctx context.Context, mgr manager.Manager) error {
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &egv1a1.ClientTrafficPolicy{}, configMapCTPIndex, func(rawObj client.Object) []string {
+		policy := rawObj.(*egv1a1.ClientTrafficPolicy)
+		var configMapReferences []string
+		for _, name := range clientTrafficPolicyConfigMapNames(policy) {
+			configMapReferences = append(configMapReferences,
+				types.NamespacedName{
+					Namespace: policy.Namespace,
+					Name:      name,
+				}.String(),
+			)
+		}
+		return configMapReferences
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+// addSecurityPolicyIndexers adds indexing on SecurityPolicy, for Service objects
+// that are referenced by their external authorization. This helps in querying for
+// SecurityPolicies that are affected by a particular Service CRUD.
+func addSecurityPolicyIndexers(ctx context.Context, mgr manager.Manager) error {
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &egv1a1.SecurityPolicy{}, serviceExtAuthIndex, func(rawObj client.Object) []string {
+		policy := rawObj.(*egv1a1.SecurityPolicy)
+		backendRef := securityPolicyExtAuthBackendRef(policy)
+		if backendRef == nil {
+			return nil
+		}
+		return []string{
+			types.NamespacedName{
+				Namespace: gatewayapi.NamespaceDerefOr(backendRef.Namespace, policy.Namespace),
+				Name:      string(backendRef.Name),
+			}.String(),
+		}
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+// addBackendTLSPolicyIndexers adds indexing on BackendTLSPolicy, for Secret and
+// ConfigMap objects that are referenced by their certificates. This helps in querying
+// for BackendTLSPolicies that are affected by a particular Secret or ConfigMap CRUD.
+func addBackendTLSPolicyIndexers(ctx context.Context, mgr manager.Manager) error {
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &egv1a1.BackendTLSPolicy{}, secretBackendTLSIndex, func(rawObj client.Object) []string {
+		policy := rawObj.(*egv1a1.BackendTLSPolicy)
+		var secretReferences []string
+		for _, secretRef := range backendTLSPolicySecretRefs(policy) {
+			secretReferences = append(secretReferences,
+				types.NamespacedName{
+					Namespace: gatewayapi.NamespaceDerefOr(secretRef.Namespace, policy.Namespace),
+					Name:      string(secretRef.Name),
+				}.String(),
+			)
+		}
+		return secretReferences
+	}); err != nil {
+		return err
+	}
+
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &egv1a1.BackendTLSPolicy{}, configMapBackendTLSIndex, func(rawObj client.Object) []string {
+		policy := rawObj.(*egv1a1.BackendTLSPolicy)
+		var configMapReferences []string
+		for _, name := range backendTLSPolicyConfigMapNames(policy) {
+			configMapReferences = append(configMapReferences,
+				types.NamespacedName{
+					Namespace: policy.Namespace,
+					Name:      name,
+				}.String(),
+			)
+		}
+		return configMapReferences
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
 // removeFinalizer removes the gatewayclass finalizer from the provided gc, if it exists.
 func (r *gatewayAPIReconciler) removeFinalizer(ctx context.Context, gc *gwapiv1b1.GatewayClass) error {
 	if err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
@@ -737,4 +1370,144 @@ func (r *gatewayAPIReconciler) subscribeAndUpdateStatus(ctx context.Context) {
 		r.log.Info("tlsRoute status subscriber shutting down")
 	}()
 
+	// ClientTrafficPolicy object status updater
+	go func() {
+		message.HandleSubscription(r.resources.ClientTrafficPolicyStatuses.Subscribe(ctx),
+			func(update message.Update[types.NamespacedName, *egv1a1.ClientTrafficPolicy]) {
+				// skip delete updates.
+				if update.Delete {
+					return
+				}
+				key := update.Key
+				val := update.Value
+				r.statusUpdater.Send(status.Update{
+					NamespacedName: key,
+					Resource:       new(egv1a1.ClientTrafficPolicy),
+					Mutator: status.MutatorFunc(func(obj client.Object) client.Object {
+						p, ok := obj.(*egv1a1.ClientTrafficPolicy)
+						if !ok {
+							panic(fmt.Sprintf("unsupported object type %T", obj))
+						}
+						pCopy := p.DeepCopy()
+						pCopy.Status = val.Status
+						return pCopy
+					}),
+				})
+			},
+		)
+		r.log.Info("clientTrafficPolicy status subscriber shutting down")
+	}()
+
+	// BackendTrafficPolicy object status updater
+	go func() {
+		message.HandleSubscription(r.resources.BackendTrafficPolicyStatuses.Subscribe(ctx),
+			func(update message.Update[types.NamespacedName, *egv1a1.BackendTrafficPolicy]) {
+				// skip delete updates.
+				if update.Delete {
+					return
+				}
+				key := update.Key
+				val := update.Value
+				r.statusUpdater.Send(status.Update{
+					NamespacedName: key,
+					Resource:       new(egv1a1.BackendTrafficPolicy),
+					Mutator: status.MutatorFunc(func(obj client.Object) client.Object {
+						p, ok := obj.(*egv1a1.BackendTrafficPolicy)
+						if !ok {
+							panic(fmt.Sprintf("unsupported object type %T", obj))
+						}
+						pCopy := p.DeepCopy()
+						pCopy.Status = val.Status
+						return pCopy
+					}),
+				})
+			},
+		)
+		r.log.Info("backendTrafficPolicy status subscriber shutting down")
+	}()
+
+	// BackendTLSPolicy object status updater
+	go func() {
+		message.HandleSubscription(r.resources.BackendTLSPolicyStatuses.Subscribe(ctx),
+			func(update message.Update[types.NamespacedName, *egv1a1.BackendTLSPolicy]) {
+				// skip delete updates.
+				if update.Delete {
+					return
+				}
+				key := update.Key
+				val := update.Value
+				r.statusUpdater.Send(status.Update{
+					NamespacedName: key,
+					Resource:       new(egv1a1.BackendTLSPolicy),
+					Mutator: status.MutatorFunc(func(obj client.Object) client.Object {
+						p, ok := obj.(*egv1a1.BackendTLSPolicy)
+						if !ok {
+							panic(fmt.Sprintf("unsupported object type %T", obj))
+						}
+						pCopy := p.DeepCopy()
+						pCopy.Status = val.Status
+						return pCopy
+					}),
+				})
+			},
+		)
+		r.log.Info("backendTLSPolicy status subscriber shutting down")
+	}()
+
+	// SecurityPolicy object status updater
+	go func() {
+		message.HandleSubscription(r.resources.SecurityPolicyStatuses.Subscribe(ctx),
+			func(update message.Update[types.NamespacedName, *egv1a1.SecurityPolicy]) {
+				// skip delete updates.
+				if update.Delete {
+					return
+				}
+				key := update.Key
+				val := update.Value
+				r.statusUpdater.Send(status.Update{
+					NamespacedName: key,
+					Resource:       new(egv1a1.SecurityPolicy),
+					Mutator: status.MutatorFunc(func(obj client.Object) client.Object {
+						p, ok := obj.(*egv1a1.SecurityPolicy)
+						if !ok {
+							panic(fmt.Sprintf("unsupported object type %T", obj))
+						}
+						pCopy := p.DeepCopy()
+						pCopy.Status = val.Status
+						return pCopy
+					}),
+				})
+			},
+		)
+		r.log.Info("securityPolicy status subscriber shutting down")
+	}()
+
+	// EnvoyProxy object status updater
+	go func() {
+		message.HandleSubscription(r.resources.EnvoyProxyStatuses.Subscribe(ctx),
+			func(update message.Update[types.NamespacedName, *egcfgv1a1.EnvoyProxy]) {
+				// skip delete updates.
+				if update.Delete {
+					return
+				}
+				key := update.Key
+				val := update.Value
+				r.statusUpdater.Send(status.Update{
+					NamespacedName: key,
+					Resource:       new(egcfgv1a1.EnvoyProxy),
+					Mutator: status.MutatorFunc(func(obj client.Object) client.Object {
+						p, ok := obj.(*egcfgv1a1.EnvoyProxy)
+						if !ok {
+							panic(fmt.Sprintf("unsupported object type %T", obj))
+						}
+						pCopy := p.DeepCopy()
+						pCopy.Status = val.Status
+						return pCopy
+					}),
+				})
+			},
+		)
+		r.log.Info("envoyProxy status subscriber shutting down")
+	}()
+
 }
@@ -9,6 +9,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -17,6 +18,7 @@ import (
 
 	"github.com/envoyproxy/gateway/api/config/v1alpha1"
 	"github.com/envoyproxy/gateway/internal/envoygateway"
+	"github.com/envoyproxy/gateway/internal/gatewayapi"
 )
 
 func TestAddGatewayClassFinalizer(t *testing.T) {
@@ -146,3 +148,112 @@ func TestRemoveGatewayClassFinalizer(t *testing.T) {
 		})
 	}
 }
+
+func TestProcessParamsRef(t *testing.T) {
+	envoyProxy := &v1alpha1.EnvoyProxy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "envoy-gateway-system",
+			Name:      "proxy-config",
+		},
+		Spec: v1alpha1.EnvoyProxySpec{
+			Telemetry: &v1alpha1.ProxyTelemetry{
+				Tracing: &v1alpha1.ProxyTracing{
+					Provider: v1alpha1.TracingProvider{
+						Type: v1alpha1.TracingProviderTypeZipkin,
+						BackendRef: gwapiv1b1.BackendObjectReference{
+							Name: "zipkin",
+							Port: gatewayapi.PortNumPtr(9411),
+						},
+					},
+				},
+			},
+		},
+	}
+	namespace := gwapiv1b1.Namespace("envoy-gateway-system")
+	tracingKey := types.NamespacedName{Namespace: "envoy-gateway-system", Name: "zipkin"}
+
+	testCases := []struct {
+		name   string
+		ref    *gwapiv1b1.ParametersReference
+		expect bool
+	}{
+		{
+			name:   "no parameters",
+			expect: false,
+		},
+		{
+			name: "envoyproxy reference",
+			ref: &gwapiv1b1.ParametersReference{
+				Group:     gwapiv1b1.Group(v1alpha1.GroupVersion.Group),
+				Kind:      v1alpha1.KindEnvoyProxy,
+				Name:      "proxy-config",
+				Namespace: &namespace,
+			},
+			expect: true,
+		},
+		{
+			name: "envoyproxy reference without namespace",
+			ref: &gwapiv1b1.ParametersReference{
+				Group: gwapiv1b1.Group(v1alpha1.GroupVersion.Group),
+				Kind:  v1alpha1.KindEnvoyProxy,
+				Name:  "proxy-config",
+			},
+			expect: true,
+		},
+		{
+			name: "missing envoyproxy",
+			ref: &gwapiv1b1.ParametersReference{
+				Group:     gwapiv1b1.Group(v1alpha1.GroupVersion.Group),
+				Kind:      v1alpha1.KindEnvoyProxy,
+				Name:      "missing",
+				Namespace: &namespace,
+			},
+			expect: false,
+		},
+		{
+			name: "configmap reference",
+			ref: &gwapiv1b1.ParametersReference{
+				Group:     "",
+				Kind:      "ConfigMap",
+				Name:      "proxy-config",
+				Namespace: &namespace,
+			},
+			expect: false,
+		},
+	}
+
+	// Create the reconciler.
+	r := &gatewayAPIReconciler{
+		log:       logr.Discard(),
+		namespace: "envoy-gateway-system",
+	}
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			gc := &gwapiv1b1.GatewayClass{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-gc",
+				},
+				Spec: gwapiv1b1.GatewayClassSpec{
+					ControllerName: v1alpha1.GatewayControllerName,
+					ParametersRef:  tc.ref,
+				},
+			}
+			r.client = fakeclient.NewClientBuilder().WithScheme(envoygateway.GetScheme()).WithObjects(gc, envoyProxy).Build()
+			resourceMap := &resourceMappings{allAssociatedBackendRefs: map[types.NamespacedName]struct{}{}}
+			resourceTree := &gatewayapi.Resources{}
+			err := r.processParamsRef(ctx, gc, resourceMap, resourceTree)
+			require.NoError(t, err)
+			if tc.expect {
+				require.NotNil(t, resourceTree.EnvoyProxy)
+				require.Equal(t, envoyProxy.Name, resourceTree.EnvoyProxy.Name)
+				require.Contains(t, resourceMap.allAssociatedBackendRefs, tracingKey)
+			} else {
+				require.Nil(t, resourceTree.EnvoyProxy)
+				require.Empty(t, resourceMap.allAssociatedBackendRefs)
+			}
+		})
+	}
+}
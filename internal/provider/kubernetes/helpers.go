@@ -15,6 +15,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	gwapiv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 
+	egcfgv1a1 "github.com/envoyproxy/gateway/api/config/v1alpha1"
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
 	"github.com/envoyproxy/gateway/internal/envoygateway/config"
 	"github.com/envoyproxy/gateway/internal/gatewayapi"
 	"github.com/envoyproxy/gateway/internal/provider/utils"
@@ -188,12 +190,118 @@ func terminatesTLS(listener *gwapiv1b1.Listener) bool {
 	return false
 }
 
+// clientCARefs returns the names of the Secret and ConfigMap, in the namespace
+// of the Gateway, holding the CA certificates validating the client certificates
+// of the listener. The names are empty if the TLS options do not set them.
+func clientCARefs(listener *gwapiv1b1.Listener) (secretName, configMapName string) {
+	if listener.TLS == nil {
+		return "", ""
+	}
+	return string(listener.TLS.Options[gatewayapi.TLSOptionClientCASecret]),
+		string(listener.TLS.Options[gatewayapi.TLSOptionClientCAConfigMap])
+}
+
 // refsSecret returns true if ref refers to a Secret.
 func refsSecret(ref *gwapiv1b1.SecretObjectReference) bool {
 	return (ref.Group == nil || *ref.Group == corev1.GroupName) &&
 		(ref.Kind == nil || *ref.Kind == gatewayapi.KindSecret)
 }
 
+// refsEnvoyProxy returns true if the parameters of the GatewayClass refer to an
+// EnvoyProxy.
+func refsEnvoyProxy(gc *gwapiv1b1.GatewayClass) bool {
+	ref := gc.Spec.ParametersRef
+	return ref != nil &&
+		string(ref.Group) == egcfgv1a1.GroupVersion.Group &&
+		string(ref.Kind) == egcfgv1a1.KindEnvoyProxy
+}
+
+// authenticationFilterSecretRefs returns the Secrets referenced by the
+// authentication providers of the AuthenticationFilter.
+func authenticationFilterSecretRefs(filter *egv1a1.AuthenticationFilter) []gwapiv1b1.SecretObjectReference {
+	var secretRefs []gwapiv1b1.SecretObjectReference
+	if filter.Spec.OIDC != nil {
+		secretRefs = append(secretRefs, filter.Spec.OIDC.ClientSecret)
+	}
+	if filter.Spec.BasicAuth != nil {
+		secretRefs = append(secretRefs, filter.Spec.BasicAuth.Users)
+	}
+	for _, provider := range filter.Spec.JwtProviders {
+		if provider.LocalJWKS != nil && provider.LocalJWKS.SecretRef != nil {
+			secretRefs = append(secretRefs, *provider.LocalJWKS.SecretRef)
+		}
+	}
+	return secretRefs
+}
+
+// authenticationFilterConfigMapNames returns the names of the ConfigMaps referenced
+// by the authentication providers of the AuthenticationFilter, which are in the
+// namespace of the filter.
+func authenticationFilterConfigMapNames(filter *egv1a1.AuthenticationFilter) []string {
+	var names []string
+	for _, provider := range filter.Spec.JwtProviders {
+		if provider.LocalJWKS != nil && provider.LocalJWKS.ConfigMapRef != nil {
+			names = append(names, string(provider.LocalJWKS.ConfigMapRef.Name))
+		}
+	}
+	return names
+}
+
+// backendTLSPolicySecretRefs returns the references to the Secrets holding the
+// certificates of the BackendTLSPolicy.
+func backendTLSPolicySecretRefs(policy *egv1a1.BackendTLSPolicy) []gwapiv1b1.SecretObjectReference {
+	var secretRefs []gwapiv1b1.SecretObjectReference
+	if policy.Spec.CACertificate != nil && policy.Spec.CACertificate.SecretRef != nil {
+		secretRefs = append(secretRefs, *policy.Spec.CACertificate.SecretRef)
+	}
+	if policy.Spec.ClientCertificateRef != nil {
+		secretRefs = append(secretRefs, *policy.Spec.ClientCertificateRef)
+	}
+	return secretRefs
+}
+
+// securityPolicyExtAuthBackendRef returns the reference to the Service of the
+// external authorization of the SecurityPolicy, or nil if it has none.
+func securityPolicyExtAuthBackendRef(policy *egv1a1.SecurityPolicy) *gwapiv1b1.BackendObjectReference {
+	extAuth := policy.Spec.ExtAuth
+	switch {
+	case extAuth == nil:
+		return nil
+	case extAuth.GRPC != nil:
+		return &extAuth.GRPC.BackendRef
+	case extAuth.HTTP != nil:
+		return &extAuth.HTTP.BackendRef
+	default:
+		return nil
+	}
+}
+
+// backendTLSPolicyConfigMapNames returns the names of the ConfigMaps holding the
+// CA certificates of the BackendTLSPolicy, which are in the namespace of the policy.
+func backendTLSPolicyConfigMapNames(policy *egv1a1.BackendTLSPolicy) []string {
+	var names []string
+	if policy.Spec.CACertificate != nil && policy.Spec.CACertificate.ConfigMapRef != nil {
+		names = append(names, string(policy.Spec.CACertificate.ConfigMapRef.Name))
+	}
+	return names
+}
+
+// clientTrafficPolicyConfigMapNames returns the names of the ConfigMaps holding
+// the bodies of the local replies and direct responses of the ClientTrafficPolicy,
+// which are in the namespace of the policy.
+func clientTrafficPolicyConfigMapNames(policy *egv1a1.ClientTrafficPolicy) []string {
+	var names []string
+	for _, mapper := range policy.Spec.LocalReplies {
+		if mapper.Body != nil && mapper.Body.ConfigMapRef != nil {
+			names = append(names, string(mapper.Body.ConfigMapRef.Name))
+		}
+	}
+	if body := policy.Spec.DirectResponseBody; body != nil && body.ConfigMapRef != nil {
+		names = append(names, string(body.ConfigMapRef.Name))
+	}
+	return names
+}
+
 func infraServiceName(gateway *gwapiv1b1.Gateway) string {
 	infraName := utils.GetHashedName(fmt.Sprintf("%s-%s", gateway.Namespace, gateway.Name))
 	return fmt.Sprintf("%s-%s", config.EnvoyPrefix, infraName)
@@ -0,0 +1,118 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/envoyproxy/gateway/internal/gatewayapi"
+)
+
+const (
+	// oidcDiscoveryTimeout is the timeout of the requests retrieving the
+	// configuration of an OIDC provider.
+	oidcDiscoveryTimeout = 5 * time.Second
+	// oidcDiscoveryTTL is how long the configuration retrieved from an OIDC
+	// provider is used before being retrieved again.
+	oidcDiscoveryTTL = time.Hour
+	// oidcDiscoveryRetryInterval is how long a failure to retrieve the
+	// configuration of an OIDC provider is cached before retrying.
+	oidcDiscoveryRetryInterval = time.Minute
+	// oidcDiscoveryMaxSize is the size limit of the configurations retrieved
+	// from the OIDC providers, beyond which they are truncated and rejected.
+	oidcDiscoveryMaxSize = 1 << 20
+)
+
+// oidcDiscoveryEntry is a configuration of an OIDC provider cached until its expiry.
+type oidcDiscoveryEntry struct {
+	config *gatewayapi.OIDCProviderConfig
+	expiry time.Time
+}
+
+// oidcDiscoverer retrieves the configurations published by the OIDC providers,
+// as defined by OpenID Connect Discovery 1.0. The configurations, and the
+// failures to retrieve them, are cached so that the providers are not queried
+// on every reconciliation.
+type oidcDiscoverer struct {
+	client *http.Client
+	now    func() time.Time
+
+	mu    sync.Mutex
+	cache map[string]oidcDiscoveryEntry
+}
+
+func newOIDCDiscoverer() *oidcDiscoverer {
+	return &oidcDiscoverer{
+		client: &http.Client{Timeout: oidcDiscoveryTimeout},
+		now:    time.Now,
+		cache:  map[string]oidcDiscoveryEntry{},
+	}
+}
+
+// discover returns the configuration of the OIDC provider of the issuer. The
+// Error of the configuration is set if it could not be retrieved.
+func (d *oidcDiscoverer) discover(ctx context.Context, issuer string) *gatewayapi.OIDCProviderConfig {
+	d.mu.Lock()
+	entry, ok := d.cache[issuer]
+	d.mu.Unlock()
+	if ok && d.now().Before(entry.expiry) {
+		return entry.config
+	}
+
+	config, err := d.fetch(ctx, issuer)
+	ttl := oidcDiscoveryTTL
+	if err != nil {
+		config = &gatewayapi.OIDCProviderConfig{Issuer: issuer, Error: err.Error()}
+		ttl = oidcDiscoveryRetryInterval
+	}
+
+	d.mu.Lock()
+	d.cache[issuer] = oidcDiscoveryEntry{config: config, expiry: d.now().Add(ttl)}
+	d.mu.Unlock()
+	return config
+}
+
+// fetch retrieves the configuration of the OIDC provider of the issuer.
+func (d *oidcDiscoverer) fetch(ctx context.Context, issuer string) (*gatewayapi.OIDCProviderConfig, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		strings.TrimSuffix(issuer, "/")+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return nil, fmt.Errorf("invalid issuer of the OIDC provider: %w", err)
+	}
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve the configuration of the OIDC provider: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to retrieve the configuration of the OIDC provider: status %d", resp.StatusCode)
+	}
+
+	var published struct {
+		Issuer                string `json:"issuer"`
+		AuthorizationEndpoint string `json:"authorization_endpoint"`
+		TokenEndpoint         string `json:"token_endpoint"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, oidcDiscoveryMaxSize)).Decode(&published); err != nil {
+		return nil, fmt.Errorf("invalid configuration of the OIDC provider: %w", err)
+	}
+	if strings.TrimSuffix(published.Issuer, "/") != strings.TrimSuffix(issuer, "/") {
+		return nil, fmt.Errorf("issuer %s of the OIDC provider does not match %s", published.Issuer, issuer)
+	}
+
+	return &gatewayapi.OIDCProviderConfig{
+		Issuer:                issuer,
+		AuthorizationEndpoint: published.AuthorizationEndpoint,
+		TokenEndpoint:         published.TokenEndpoint,
+	}, nil
+}
@@ -0,0 +1,103 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/envoyproxy/gateway/internal/gatewayapi"
+)
+
+// newOIDCProvider starts a stand-in OIDC provider publishing its configuration,
+// and returns it along with the number of configuration requests it received.
+// The provider fails while unavailable is set.
+func newOIDCProvider(t *testing.T, issuer string, unavailable *bool) (*httptest.Server, *int) {
+	var requests int
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/.well-known/openid-configuration" {
+			http.NotFound(w, r)
+			return
+		}
+		requests++
+		if unavailable != nil && *unavailable {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		configIssuer := issuer
+		if configIssuer == "" {
+			configIssuer = server.URL
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 configIssuer,
+			"authorization_endpoint": server.URL + "/auth",
+			"token_endpoint":         server.URL + "/token",
+			"jwks_uri":               server.URL + "/keys",
+		}))
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func TestOIDCDiscoverer(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	discoverer := newOIDCDiscoverer()
+	discoverer.now = func() time.Time { return now }
+
+	server, requests := newOIDCProvider(t, "", nil)
+	config := discoverer.discover(ctx, server.URL+"/")
+	require.Equal(t, &gatewayapi.OIDCProviderConfig{
+		Issuer:                server.URL + "/",
+		AuthorizationEndpoint: server.URL + "/auth",
+		TokenEndpoint:         server.URL + "/token",
+	}, config)
+
+	// The configuration is only retrieved again once expired.
+	require.Equal(t, config, discoverer.discover(ctx, server.URL+"/"))
+	require.Equal(t, 1, *requests)
+	now = now.Add(oidcDiscoveryTTL)
+	require.Equal(t, config, discoverer.discover(ctx, server.URL+"/"))
+	require.Equal(t, 2, *requests)
+
+	mismatchServer, _ := newOIDCProvider(t, "https://other.example.com", nil)
+	config = discoverer.discover(ctx, mismatchServer.URL)
+	require.Contains(t, config.Error, "issuer https://other.example.com of the OIDC provider does not match")
+
+	// The failures are cached for a shorter time.
+	unavailable := true
+	unavailableServer, unavailableRequests := newOIDCProvider(t, "", &unavailable)
+	config = discoverer.discover(ctx, unavailableServer.URL)
+	require.Equal(t, "failed to retrieve the configuration of the OIDC provider: status 503", config.Error)
+	require.Equal(t, config, discoverer.discover(ctx, unavailableServer.URL))
+	require.Equal(t, 1, *unavailableRequests)
+
+	unavailable = false
+	now = now.Add(oidcDiscoveryRetryInterval)
+	config = discoverer.discover(ctx, unavailableServer.URL)
+	require.Empty(t, config.Error)
+	require.Equal(t, unavailableServer.URL+"/token", config.TokenEndpoint)
+	require.Equal(t, 2, *unavailableRequests)
+
+	// The configurations larger than the size limit are rejected.
+	largeServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The write fails once the discoverer stops reading.
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer": "https://" + strings.Repeat("a", oidcDiscoveryMaxSize),
+		})
+	}))
+	t.Cleanup(largeServer.Close)
+	config = discoverer.discover(ctx, largeServer.URL)
+	require.Contains(t, config.Error, "invalid configuration of the OIDC provider")
+}
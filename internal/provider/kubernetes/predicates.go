@@ -17,6 +17,9 @@ import (
 	gwapiv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 	gwapiv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 
+	egcfgv1a1 "github.com/envoyproxy/gateway/api/config/v1alpha1"
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/envoygateway/config"
 	"github.com/envoyproxy/gateway/internal/gatewayapi"
 	"github.com/envoyproxy/gateway/internal/provider/utils"
 )
@@ -67,7 +70,8 @@ func (r *gatewayAPIReconciler) validateGatewayForReconcile(obj client.Object) bo
 	return true
 }
 
-// validateSecretForReconcile checks whether the Secret belongs to a valid Gateway.
+// validateSecretForReconcile checks whether the Secret belongs to a valid Gateway,
+// or is referenced by an AuthenticationFilter or a BackendTLSPolicy.
 func (r *gatewayAPIReconciler) validateSecretForReconcile(obj client.Object) bool {
 	secret, ok := obj.(*corev1.Secret)
 	if !ok {
@@ -75,6 +79,38 @@ func (r *gatewayAPIReconciler) validateSecretForReconcile(obj client.Object) boo
 		return false
 	}
 
+	// The HMAC secret of the OIDC authentication is regenerated along with the
+	// certificates of Envoy Gateway.
+	if secret.Namespace == r.namespace && secret.Name == config.OIDCHMACSecretName {
+		return true
+	}
+
+	// Secrets referenced by AuthenticationFilters are always reconciled, since
+	// the HTTPRoutes referencing the filters are resolved by the translator.
+	filterList := &egv1a1.AuthenticationFilterList{}
+	if err := r.client.List(context.Background(), filterList, &client.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector(secretAuthnFilterIndex, utils.NamespacedName(secret).String()),
+	}); err != nil {
+		r.log.Error(err, "unable to find associated AuthenticationFilters")
+		return false
+	}
+
+	if len(filterList.Items) > 0 {
+		return true
+	}
+
+	backendTLSPolicyList := &egv1a1.BackendTLSPolicyList{}
+	if err := r.client.List(context.Background(), backendTLSPolicyList, &client.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector(secretBackendTLSIndex, utils.NamespacedName(secret).String()),
+	}); err != nil {
+		r.log.Error(err, "unable to find associated BackendTLSPolicies")
+		return false
+	}
+
+	if len(backendTLSPolicyList.Items) > 0 {
+		return true
+	}
+
 	gwList := &gwapiv1b1.GatewayList{}
 	if err := r.client.List(context.Background(), gwList, &client.ListOptions{
 		FieldSelector: fields.OneTermEqualSelector(secretGatewayIndex, utils.NamespacedName(secret).String()),
@@ -97,9 +133,75 @@ func (r *gatewayAPIReconciler) validateSecretForReconcile(obj client.Object) boo
 	return true
 }
 
+// validateConfigMapForReconcile checks whether the ConfigMap is referenced by
+// an AuthenticationFilter, a BackendTLSPolicy, or by a Gateway for the validation of
+// client certificates.
+func (r *gatewayAPIReconciler) validateConfigMapForReconcile(obj client.Object) bool {
+	configMap, ok := obj.(*corev1.ConfigMap)
+	if !ok {
+		r.log.Info("unexpected object type, bypassing reconciliation", "object", obj)
+		return false
+	}
+
+	filterList := &egv1a1.AuthenticationFilterList{}
+	if err := r.client.List(context.Background(), filterList, &client.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector(configMapAuthnFilterIndex, utils.NamespacedName(configMap).String()),
+	}); err != nil {
+		r.log.Error(err, "unable to find associated AuthenticationFilters")
+		return false
+	}
+
+	if len(filterList.Items) > 0 {
+		return true
+	}
+
+	backendTLSPolicyList := &egv1a1.BackendTLSPolicyList{}
+	if err := r.client.List(context.Background(), backendTLSPolicyList, &client.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector(configMapBackendTLSIndex, utils.NamespacedName(configMap).String()),
+	}); err != nil {
+		r.log.Error(err, "unable to find associated BackendTLSPolicies")
+		return false
+	}
+
+	if len(backendTLSPolicyList.Items) > 0 {
+		return true
+	}
+
+	clientTrafficPolicyList := &egv1a1.ClientTrafficPolicyList{}
+	if err := r.client.List(context.Background(), clientTrafficPolicyList, &client.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector(configMapCTPIndex, utils.NamespacedName(configMap).String()),
+	}); err != nil {
+		r.log.Error(err, "unable to find associated ClientTrafficPolicies")
+		return false
+	}
+
+	if len(clientTrafficPolicyList.Items) > 0 {
+		return true
+	}
+
+	gwList := &gwapiv1b1.GatewayList{}
+	if err := r.client.List(context.Background(), gwList, &client.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector(configMapGatewayIndex, utils.NamespacedName(configMap).String()),
+	}); err != nil {
+		r.log.Error(err, "unable to find associated Gateways")
+		return false
+	}
+
+	for _, gw := range gwList.Items {
+		gw := gw
+		if r.validateGatewayForReconcile(&gw) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // validateServiceForReconcile tries finding the owning Gateway of the Service
 // if it exists, finds the Gateway's Deployment, and further updates the Gateway
-// status Ready condition. All Services are pushed for reconciliation.
+// status Ready condition. The Services referenced by routes, by the external
+// authorization of SecurityPolicies or as tracing collector are pushed for
+// reconciliation.
 func (r *gatewayAPIReconciler) validateServiceForReconcile(obj client.Object) bool {
 	ctx := context.Background()
 	svc, ok := obj.(*corev1.Service)
@@ -143,8 +245,59 @@ func (r *gatewayAPIReconciler) validateServiceForReconcile(obj client.Object) bo
 	// Check how many Route objects refer this Service
 	allAssociatedRoutes := len(httpRouteList.Items) +
 		len(tlsRouteList.Items)
+	if allAssociatedRoutes != 0 {
+		return true
+	}
 
-	return allAssociatedRoutes != 0
+	// Services of the external authorization of SecurityPolicies are reconciled,
+	// so that the policies are updated when the Services are created or changed.
+	securityPolicyList := &egv1a1.SecurityPolicyList{}
+	if err := r.client.List(ctx, securityPolicyList, &client.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector(serviceExtAuthIndex, utils.NamespacedName(svc).String()),
+	}); err != nil {
+		r.log.Error(err, "unable to find associated SecurityPolicies")
+		return false
+	}
+	if len(securityPolicyList.Items) != 0 {
+		return true
+	}
+
+	return r.isTracingCollectorService(ctx, svc)
+}
+
+// isTracingCollectorService returns true if the Service is the tracing collector
+// of the EnvoyProxy referenced by the accepted GatewayClass.
+func (r *gatewayAPIReconciler) isTracingCollectorService(ctx context.Context, svc *corev1.Service) bool {
+	var gatewayClasses gwapiv1b1.GatewayClassList
+	if err := r.client.List(ctx, &gatewayClasses); err != nil {
+		r.log.Error(err, "unable to list GatewayClasses")
+		return false
+	}
+	var cc controlledClasses
+	for i := range gatewayClasses.Items {
+		if gatewayClasses.Items[i].Spec.ControllerName == r.classController {
+			cc.addMatch(&gatewayClasses.Items[i])
+		}
+	}
+	acceptedGC := cc.acceptedClass()
+	if acceptedGC == nil || !refsEnvoyProxy(acceptedGC) {
+		return false
+	}
+
+	envoyProxy := new(egcfgv1a1.EnvoyProxy)
+	if err := r.client.Get(ctx, r.envoyProxyKey(acceptedGC), envoyProxy); err != nil {
+		if !kerrors.IsNotFound(err) {
+			r.log.Error(err, "unable to get the EnvoyProxy of the accepted GatewayClass")
+		}
+		return false
+	}
+	telemetry := envoyProxy.Spec.Telemetry
+	if telemetry == nil || telemetry.Tracing == nil {
+		return false
+	}
+	backendRef := telemetry.Tracing.Provider.BackendRef
+	return string(backendRef.Name) == svc.Name &&
+		gatewayapi.NamespaceDerefOr(backendRef.Namespace, envoyProxy.Namespace) == svc.Namespace
 }
 
 // validateDeploymentForReconcile tries finding the owning Gateway of the Deployment
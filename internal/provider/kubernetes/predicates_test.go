@@ -6,13 +6,20 @@
 package kubernetes
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/envoyproxy/gateway/api/config/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/envoygateway"
+	"github.com/envoyproxy/gateway/internal/gatewayapi"
 	"github.com/envoyproxy/gateway/internal/log"
+	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 	gwapiv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 )
 
@@ -85,6 +92,75 @@ func TestValidateServiceForReconcile(t *testing.T) {
 
 }
 
+// TestIsTracingCollectorService tests the isTracingCollectorService function.
+func TestIsTracingCollectorService(t *testing.T) {
+	namespace := gwapiv1b1.Namespace("envoy-gateway-system")
+	gatewayClass := func(name string, created time.Time, proxyName string) *gwapiv1b1.GatewayClass {
+		return &gwapiv1b1.GatewayClass{
+			ObjectMeta: metav1.ObjectMeta{Name: name, CreationTimestamp: metav1.NewTime(created)},
+			Spec: gwapiv1b1.GatewayClassSpec{
+				ControllerName: v1alpha1.GatewayControllerName,
+				ParametersRef: &gwapiv1b1.ParametersReference{
+					Group:     gwapiv1b1.Group(v1alpha1.GroupVersion.Group),
+					Kind:      v1alpha1.KindEnvoyProxy,
+					Name:      proxyName,
+					Namespace: &namespace,
+				},
+			},
+		}
+	}
+	envoyProxy := func(name, collector string) *v1alpha1.EnvoyProxy {
+		return &v1alpha1.EnvoyProxy{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "envoy-gateway-system", Name: name},
+			Spec: v1alpha1.EnvoyProxySpec{
+				Telemetry: &v1alpha1.ProxyTelemetry{
+					Tracing: &v1alpha1.ProxyTracing{
+						Provider: v1alpha1.TracingProvider{
+							Type: v1alpha1.TracingProviderTypeZipkin,
+							BackendRef: gwapiv1b1.BackendObjectReference{
+								Name: gwapiv1b1.ObjectName(collector),
+								Port: gatewayapi.PortNumPtr(9411),
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	now := time.Now()
+	objs := []client.Object{
+		gatewayClass("accepted", now, "accepted-config"),
+		gatewayClass("not-accepted", now.Add(time.Hour), "not-accepted-config"),
+		envoyProxy("accepted-config", "zipkin"),
+		envoyProxy("not-accepted-config", "jaeger"),
+	}
+
+	testCases := []struct {
+		name      string
+		namespace string
+		expect    bool
+	}{
+		{name: "zipkin", namespace: "envoy-gateway-system", expect: true},
+		{name: "zipkin", namespace: "default", expect: false},
+		{name: "jaeger", namespace: "envoy-gateway-system", expect: false},
+		{name: "other", namespace: "envoy-gateway-system", expect: false},
+	}
+
+	r := gatewayAPIReconciler{
+		classController: v1alpha1.GatewayControllerName,
+		log:             logr.Discard(),
+		namespace:       "envoy-gateway-system",
+		client:          fakeclient.NewClientBuilder().WithScheme(envoygateway.GetScheme()).WithObjects(objs...).Build(),
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.namespace+"/"+tc.name, func(t *testing.T) {
+			svc := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: tc.namespace, Name: tc.name}}
+			require.Equal(t, tc.expect, r.isTracingCollectorService(context.Background(), svc))
+		})
+	}
+}
+
 // TestValidateDeploymentForReconcile tests the validateDeploymentForReconcile
 // predicate function.
 func TestValidateDeploymentForReconcile(t *testing.T) {
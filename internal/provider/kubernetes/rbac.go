@@ -8,6 +8,21 @@ package kubernetes
 // +kubebuilder:rbac:groups="gateway.networking.k8s.io",resources=gatewayclasses;gateways;httproutes;tlsroutes;referencepolicies;referencegrants,verbs=get;list;watch;update
 // +kubebuilder:rbac:groups="gateway.networking.k8s.io",resources=gatewayclasses/status;gateways/status;httproutes/status;tlsroutes/status,verbs=update
 
+// RBAC for Envoy Gateway policies.
+// +kubebuilder:rbac:groups="gateway.envoyproxy.io",resources=backendtrafficpolicies,verbs=get;list;watch;update
+// +kubebuilder:rbac:groups="gateway.envoyproxy.io",resources=backendtrafficpolicies/status,verbs=update
+// +kubebuilder:rbac:groups="gateway.envoyproxy.io",resources=backendtlspolicies,verbs=get;list;watch;update
+// +kubebuilder:rbac:groups="gateway.envoyproxy.io",resources=backendtlspolicies/status,verbs=update
+// +kubebuilder:rbac:groups="gateway.envoyproxy.io",resources=clienttrafficpolicies,verbs=get;list;watch;update
+// +kubebuilder:rbac:groups="gateway.envoyproxy.io",resources=clienttrafficpolicies/status,verbs=update
+// +kubebuilder:rbac:groups="gateway.envoyproxy.io",resources=securitypolicies,verbs=get;list;watch;update
+// +kubebuilder:rbac:groups="gateway.envoyproxy.io",resources=securitypolicies/status,verbs=update
+// +kubebuilder:rbac:groups="gateway.envoyproxy.io",resources=authenticationfilters,verbs=get;list;watch
+
+// RBAC for Envoy Gateway configuration.
+// +kubebuilder:rbac:groups="config.gateway.envoyproxy.io",resources=envoyproxies,verbs=get;list;watch
+// +kubebuilder:rbac:groups="config.gateway.envoyproxy.io",resources=envoyproxies/status,verbs=update
+
 // RBAC for watched resources of Gateway API controllers.
-// +kubebuilder:rbac:groups="",resources=secrets;services;namespaces,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets;services;namespaces;configmaps,verbs=get;list;watch
 // +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch
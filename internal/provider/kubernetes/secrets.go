@@ -17,6 +17,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/envoyproxy/gateway/internal/crypto"
+	"github.com/envoyproxy/gateway/internal/envoygateway/config"
 )
 
 // caCertificateKey is the key name for accessing TLS CA certificate bundles
@@ -62,6 +63,13 @@ func CertsToSecret(namespace string, certs *crypto.Certificates) []corev1.Secret
 				corev1.TLSCertKey:       certs.EnvoyCertificate,
 				corev1.TLSPrivateKeyKey: certs.EnvoyPrivateKey,
 			}),
+		newSecret(
+			corev1.SecretTypeOpaque,
+			config.OIDCHMACSecretName,
+			namespace,
+			map[string][]byte{
+				config.OIDCHMACSecretKey: certs.OIDCHMACSecret,
+			}),
 	}
 }
 
@@ -26,6 +26,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	gwapiv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 	gwapiv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	egcfgv1a1 "github.com/envoyproxy/gateway/api/config/v1alpha1"
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
 )
 
 // Update contains an all the information needed to update an object's status.
@@ -160,6 +163,11 @@ func (u *UpdateWriter) Send(update Update) {
 //	Gateway
 //	HTTPRoute
 //	TLSRoute
+//	ClientTrafficPolicy
+//	BackendTrafficPolicy
+//	BackendTLSPolicy
+//	SecurityPolicy
+//	EnvoyProxy
 func isStatusEqual(objA, objB interface{}) bool {
 	opts := cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime", "ObservedGeneration")
 	switch a := objA.(type) {
@@ -187,6 +195,36 @@ func isStatusEqual(objA, objB interface{}) bool {
 				return true
 			}
 		}
+	case *egv1a1.ClientTrafficPolicy:
+		if b, ok := objB.(*egv1a1.ClientTrafficPolicy); ok {
+			if cmp.Equal(a.Status, b.Status, opts) {
+				return true
+			}
+		}
+	case *egv1a1.BackendTrafficPolicy:
+		if b, ok := objB.(*egv1a1.BackendTrafficPolicy); ok {
+			if cmp.Equal(a.Status, b.Status, opts) {
+				return true
+			}
+		}
+	case *egv1a1.BackendTLSPolicy:
+		if b, ok := objB.(*egv1a1.BackendTLSPolicy); ok {
+			if cmp.Equal(a.Status, b.Status, opts) {
+				return true
+			}
+		}
+	case *egv1a1.SecurityPolicy:
+		if b, ok := objB.(*egv1a1.SecurityPolicy); ok {
+			if cmp.Equal(a.Status, b.Status, opts) {
+				return true
+			}
+		}
+	case *egcfgv1a1.EnvoyProxy:
+		if b, ok := objB.(*egcfgv1a1.EnvoyProxy); ok {
+			if cmp.Equal(a.Status, b.Status, opts) {
+				return true
+			}
+		}
 	}
 	return false
 }
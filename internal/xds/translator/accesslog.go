@@ -6,8 +6,42 @@
 package translator
 
 import (
+	"fmt"
+	"sort"
+
 	accesslog "github.com/envoyproxy/go-control-plane/envoy/config/accesslog/v3"
+	cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	fileaccesslog "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/file/v3"
+	grpcaccesslog "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/grpc/v3"
+	otelaccesslog "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/open_telemetry/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	otlpcommon "go.opentelemetry.io/proto/otlp/common/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+const (
+	tcpGRPCAccessLog          = "envoy.access_loggers.tcp_grpc"
+	openTelemetryAccessLog    = "envoy.access_loggers.open_telemetry"
+	openTelemetryAccessLogKey = "otel_envoy_accesslog"
+
+	// The runtime keys of the access log filters. The runtime is not expected
+	// to override them.
+	accessLogStatusCodeRuntimeKey = "access_log.min_status_code"
+	accessLogDurationRuntimeKey   = "access_log.min_duration"
+	accessLogSamplingRuntimeKey   = "access_log.sampling"
+
+	// defaultAccessLogTextFormat is the default Envoy access log format, used
+	// for the bodies of the OpenTelemetry log records of text access logs
+	// without a format.
+	defaultAccessLogTextFormat = `[%START_TIME%] "%REQ(:METHOD)% %REQ(X-ENVOY-ORIGINAL-PATH?:PATH)% %PROTOCOL%" ` +
+		`%RESPONSE_CODE% %RESPONSE_FLAGS% %BYTES_RECEIVED% %BYTES_SENT% %DURATION% ` +
+		`%RESP(X-ENVOY-UPSTREAM-SERVICE-TIME)% "%REQ(X-FORWARDED-FOR)%" "%REQ(USER-AGENT)%" ` +
+		`"%REQ(X-REQUEST-ID)%" "%REQ(:AUTHORITY)%" "%UPSTREAM_HOST%"` + "\n"
 )
 
 var (
@@ -22,3 +56,294 @@ var (
 		},
 	}
 )
+
+// accessLogType is the type of the traffic logged by an access logger.
+type accessLogType int
+
+const (
+	// httpAccessLog loggers log the requests of the HTTP connection managers.
+	httpAccessLog accessLogType = iota
+	// tcpAccessLog loggers log the connections of the listeners and of the
+	// TCP and UDP proxies.
+	tcpAccessLog
+)
+
+// buildXdsAccessLogs returns the access loggers writing the access logs. Every
+// request or connection is logged to stdout in the default format if the
+// access logs are nil.
+func buildXdsAccessLogs(accessLog *ir.AccessLog, logType accessLogType) ([]*accesslog.AccessLog, error) {
+	if accessLog == nil {
+		accessLogAny, err := anypb.New(stdoutFileAccessLog)
+		if err != nil {
+			return nil, err
+		}
+		return []*accesslog.AccessLog{{
+			Name:       wellknown.FileAccessLog,
+			ConfigType: &accesslog.AccessLog_TypedConfig{TypedConfig: accessLogAny},
+		}}, nil
+	}
+
+	var accessLogs []*accesslog.AccessLog
+	for _, setting := range accessLog.Settings {
+		filter := buildXdsAccessLogFilter(setting.Filter)
+		for _, sink := range setting.Sinks {
+			name, config, err := buildXdsAccessLogSink(setting, sink, logType)
+			if err != nil {
+				return nil, err
+			}
+			configAny, err := anypb.New(config)
+			if err != nil {
+				return nil, err
+			}
+			accessLogs = append(accessLogs, &accesslog.AccessLog{
+				Name:       name,
+				Filter:     filter,
+				ConfigType: &accesslog.AccessLog_TypedConfig{TypedConfig: configAny},
+			})
+		}
+	}
+	return accessLogs, nil
+}
+
+// buildXdsListenerAccessLogs returns the access loggers of a TCP listener,
+// which only log the connections that match no route.
+func buildXdsListenerAccessLogs(accessLog *ir.AccessLog) ([]*accesslog.AccessLog, error) {
+	accessLogs, err := buildXdsAccessLogs(accessLog, tcpAccessLog)
+	if err != nil {
+		return nil, err
+	}
+	for _, xdsAccessLog := range accessLogs {
+		xdsAccessLog.Filter = buildXdsAndFilter([]*accesslog.AccessLogFilter{listenerAccessLogFilter, xdsAccessLog.Filter})
+	}
+	return accessLogs, nil
+}
+
+// buildXdsAccessLogSink returns the name and the config of the access logger
+// writing the entries of the access log setting to the sink.
+func buildXdsAccessLogSink(setting *ir.AccessLogSetting, sink *ir.AccessLogSink, logType accessLogType) (string, proto.Message, error) {
+	switch {
+	case sink.File != nil:
+		fileAccessLog := &fileaccesslog.FileAccessLog{Path: sink.File.Path}
+		logFormat, err := buildXdsAccessLogFormat(setting)
+		if err != nil {
+			return "", nil, err
+		}
+		if logFormat != nil {
+			fileAccessLog.AccessLogFormat = &fileaccesslog.FileAccessLog_LogFormat{LogFormat: logFormat}
+		}
+		return wellknown.FileAccessLog, fileAccessLog, nil
+	case sink.ALS != nil:
+		commonConfig := buildXdsGRPCAccessLogConfig(sink.ALS.LogName, accessLogClusterName(sink.ALS.Host, sink.ALS.Port))
+		if logType == httpAccessLog {
+			return wellknown.HTTPGRPCAccessLog, &grpcaccesslog.HttpGrpcAccessLogConfig{CommonConfig: commonConfig}, nil
+		}
+		return tcpGRPCAccessLog, &grpcaccesslog.TcpGrpcAccessLogConfig{CommonConfig: commonConfig}, nil
+	case sink.OpenTelemetry != nil:
+		otelAccessLog := &otelaccesslog.OpenTelemetryAccessLogConfig{
+			CommonConfig: buildXdsGRPCAccessLogConfig(openTelemetryAccessLogKey,
+				accessLogClusterName(sink.OpenTelemetry.Host, sink.OpenTelemetry.Port)),
+			ResourceAttributes: buildOTLPKeyValues(sink.OpenTelemetry.Resources),
+		}
+		switch setting.Format {
+		case ir.AccessLogFormatText:
+			text := setting.Text
+			if text == "" {
+				text = defaultAccessLogTextFormat
+			}
+			otelAccessLog.Body = &otlpcommon.AnyValue{
+				Value: &otlpcommon.AnyValue_StringValue{StringValue: text},
+			}
+		case ir.AccessLogFormatJSON:
+			otelAccessLog.Attributes = buildOTLPKeyValues(setting.JSON)
+		}
+		return openTelemetryAccessLog, otelAccessLog, nil
+	}
+	return "", nil, fmt.Errorf("access log sink has no file, ALS or OpenTelemetry destination")
+}
+
+// buildXdsAccessLogFormat returns the format of the entries of the access log
+// setting, or nil for the default Envoy format.
+func buildXdsAccessLogFormat(setting *ir.AccessLogSetting) (*core.SubstitutionFormatString, error) {
+	switch setting.Format {
+	case ir.AccessLogFormatText:
+		if setting.Text == "" {
+			return nil, nil
+		}
+		return &core.SubstitutionFormatString{
+			Format: &core.SubstitutionFormatString_TextFormatSource{
+				TextFormatSource: &core.DataSource{
+					Specifier: &core.DataSource_InlineString{InlineString: setting.Text},
+				},
+			},
+		}, nil
+	case ir.AccessLogFormatJSON:
+		fields := map[string]interface{}{}
+		for key, value := range setting.JSON {
+			fields[key] = value
+		}
+		jsonFormat, err := structpb.NewStruct(fields)
+		if err != nil {
+			return nil, err
+		}
+		return &core.SubstitutionFormatString{
+			Format: &core.SubstitutionFormatString_JsonFormat{JsonFormat: jsonFormat},
+		}, nil
+	}
+	return nil, fmt.Errorf("unsupported access log format %s", setting.Format)
+}
+
+func buildXdsGRPCAccessLogConfig(logName, clusterName string) *grpcaccesslog.CommonGrpcAccessLogConfig {
+	return &grpcaccesslog.CommonGrpcAccessLogConfig{
+		LogName: logName,
+		GrpcService: &core.GrpcService{
+			TargetSpecifier: &core.GrpcService_EnvoyGrpc_{
+				EnvoyGrpc: &core.GrpcService_EnvoyGrpc{ClusterName: clusterName},
+			},
+		},
+		TransportApiVersion: core.ApiVersion_V3,
+	}
+}
+
+// buildOTLPKeyValues returns the OpenTelemetry attributes of the map, sorted by
+// key so that the config does not change between translations.
+func buildOTLPKeyValues(values map[string]string) *otlpcommon.KeyValueList {
+	if len(values) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	keyValues := &otlpcommon.KeyValueList{}
+	for _, key := range keys {
+		keyValues.Values = append(keyValues.Values, &otlpcommon.KeyValue{
+			Key: key,
+			Value: &otlpcommon.AnyValue{
+				Value: &otlpcommon.AnyValue_StringValue{StringValue: values[key]},
+			},
+		})
+	}
+	return keyValues
+}
+
+// buildXdsAccessLogFilter returns the filter selecting the requests and
+// connections that are logged, or nil if all of them are.
+func buildXdsAccessLogFilter(filter *ir.AccessLogFilter) *accesslog.AccessLogFilter {
+	if filter == nil {
+		return nil
+	}
+
+	var filters []*accesslog.AccessLogFilter
+	if filter.MinStatusCode != nil {
+		filters = append(filters, &accesslog.AccessLogFilter{
+			FilterSpecifier: &accesslog.AccessLogFilter_StatusCodeFilter{
+				StatusCodeFilter: &accesslog.StatusCodeFilter{
+					Comparison: &accesslog.ComparisonFilter{
+						Op: accesslog.ComparisonFilter_GE,
+						Value: &core.RuntimeUInt32{
+							DefaultValue: *filter.MinStatusCode,
+							RuntimeKey:   accessLogStatusCodeRuntimeKey,
+						},
+					},
+				},
+			},
+		})
+	}
+	if filter.MinDuration != nil {
+		filters = append(filters, &accesslog.AccessLogFilter{
+			FilterSpecifier: &accesslog.AccessLogFilter_DurationFilter{
+				DurationFilter: &accesslog.DurationFilter{
+					Comparison: &accesslog.ComparisonFilter{
+						Op: accesslog.ComparisonFilter_GE,
+						Value: &core.RuntimeUInt32{
+							DefaultValue: uint32(filter.MinDuration.Milliseconds()),
+							RuntimeKey:   accessLogDurationRuntimeKey,
+						},
+					},
+				},
+			},
+		})
+	}
+	if filter.SamplingPercent != nil {
+		filters = append(filters, &accesslog.AccessLogFilter{
+			FilterSpecifier: &accesslog.AccessLogFilter_RuntimeFilter{
+				RuntimeFilter: &accesslog.RuntimeFilter{
+					RuntimeKey:     accessLogSamplingRuntimeKey,
+					PercentSampled: buildXdsPercent(*filter.SamplingPercent),
+				},
+			},
+		})
+	}
+	return buildXdsAndFilter(filters)
+}
+
+// buildXdsAndFilter returns the filter matching the entries that all the
+// filters match, the nil filters are skipped and the and filters flattened.
+func buildXdsAndFilter(filters []*accesslog.AccessLogFilter) *accesslog.AccessLogFilter {
+	var andFilters []*accesslog.AccessLogFilter
+	for _, filter := range filters {
+		switch {
+		case filter == nil:
+		case filter.GetAndFilter() != nil:
+			andFilters = append(andFilters, filter.GetAndFilter().Filters...)
+		default:
+			andFilters = append(andFilters, filter)
+		}
+	}
+
+	// The and filter requires at least two filters.
+	switch len(andFilters) {
+	case 0:
+		return nil
+	case 1:
+		return andFilters[0]
+	}
+	return &accesslog.AccessLogFilter{
+		FilterSpecifier: &accesslog.AccessLogFilter_AndFilter{
+			AndFilter: &accesslog.AndFilter{Filters: andFilters},
+		},
+	}
+}
+
+// accessLogClusterName returns the name of the cluster of the gRPC service
+// receiving access logs.
+func accessLogClusterName(host string, port uint32) string {
+	return fmt.Sprintf("accesslog/%s/%d", host, port)
+}
+
+// listAccessLogServices returns the clusters of the gRPC services receiving
+// the access logs, without duplicates, in the order in which they first appear.
+func listAccessLogServices(accessLog *ir.AccessLog) []*ir.RouteDestination {
+	if accessLog == nil {
+		return nil
+	}
+
+	var services []*ir.RouteDestination
+	found := map[string]bool{}
+	for _, setting := range accessLog.Settings {
+		for _, sink := range setting.Sinks {
+			var service *ir.RouteDestination
+			switch {
+			case sink.ALS != nil:
+				service = &ir.RouteDestination{Host: sink.ALS.Host, Port: sink.ALS.Port}
+			case sink.OpenTelemetry != nil:
+				service = &ir.RouteDestination{Host: sink.OpenTelemetry.Host, Port: sink.OpenTelemetry.Port}
+			default:
+				continue
+			}
+			if name := accessLogClusterName(service.Host, service.Port); !found[name] {
+				found[name] = true
+				services = append(services, service)
+			}
+		}
+	}
+	return services
+}
+
+// buildXdsAccessLogCluster returns the cluster of a gRPC service receiving
+// access logs.
+func buildXdsAccessLogCluster(service *ir.RouteDestination) *cluster.Cluster {
+	return buildXdsGRPCServiceCluster(accessLogClusterName(service.Host, service.Port), service.Host, service.Port)
+}
@@ -0,0 +1,94 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	_ "embed"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	lua "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/lua/v3"
+	hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+// basicAuthLua is the Lua code authenticating the requests. Envoy has no
+// basic authentication filter in the supported go-control-plane version.
+//
+//go:embed basicauth.lua
+var basicAuthLua string
+
+// basicAuthFilterName returns the name of the Lua filter authenticating the users.
+func basicAuthFilterName(basicAuth *ir.BasicAuth) string {
+	return fmt.Sprintf("%s/%s", wellknown.Lua, basicAuth.Name)
+}
+
+// basicAuthFilters are the Lua filters of the basic authentications.
+var basicAuthFilters = &routeFilters[ir.BasicAuth]{
+	settings:     func(irRoute *ir.HTTPRoute) *ir.BasicAuth { return irRoute.BasicAuth },
+	name:         func(basicAuth *ir.BasicAuth) string { return basicAuth.Name },
+	filterNames:  func(basicAuth *ir.BasicAuth) []string { return []string{basicAuthFilterName(basicAuth)} },
+	buildFilters: buildSingleFilter(buildHCMBasicAuthFilter),
+	disabledConfig: &lua.LuaPerRoute{
+		Override: &lua.LuaPerRoute_Disabled{Disabled: true},
+	},
+}
+
+// buildHCMBasicAuthFilter returns the Lua filter rejecting the requests without
+// the credentials of one of the users.
+func buildHCMBasicAuthFilter(basicAuth *ir.BasicAuth) (*hcm.HttpFilter, error) {
+	configAny, err := anypb.New(&lua.Lua{
+		DefaultSourceCode: &core.DataSource{
+			Specifier: &core.DataSource_InlineString{InlineString: buildBasicAuthLuaCode(basicAuth)},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &hcm.HttpFilter{
+		Name:       basicAuthFilterName(basicAuth),
+		ConfigType: &hcm.HttpFilter_TypedConfig{TypedConfig: configAny},
+	}, nil
+}
+
+// buildBasicAuthLuaCode returns the Lua code authenticating the users, which
+// are defined ahead of the code shared by all the basic authentications.
+func buildBasicAuthLuaCode(basicAuth *ir.BasicAuth) string {
+	var code strings.Builder
+	code.WriteString("local users = {\n")
+	for _, user := range basicAuth.Users {
+		fmt.Fprintf(&code, "  [%s] = \"%s\",\n", luaQuote(user.Name), hex.EncodeToString(user.PasswordSHA1))
+	}
+	code.WriteString("}\n\n")
+	code.WriteString(basicAuthLua)
+	return code.String()
+}
+
+// luaQuote returns the value as a Lua string literal. Characters other than
+// printable ASCII are written as decimal escapes, which all Lua versions support.
+func luaQuote(value string) string {
+	var quoted strings.Builder
+	quoted.WriteByte('"')
+	for i := 0; i < len(value); i++ {
+		switch c := value[i]; {
+		case c == '"' || c == '\\':
+			quoted.WriteByte('\\')
+			quoted.WriteByte(c)
+		case c < ' ' || c > '~':
+			fmt.Fprintf(&quoted, "\\%03d", c)
+		default:
+			quoted.WriteByte(c)
+		}
+	}
+	quoted.WriteByte('"')
+	return quoted.String()
+}
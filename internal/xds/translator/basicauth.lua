@@ -0,0 +1,127 @@
+-- Authenticates the requests with the HTTP basic authentication scheme.
+--
+-- The users table, mapping the user names to the hexadecimal SHA1 digests of
+-- their passwords, is defined by the xDS translator ahead of this code.
+
+local bit = require("bit")
+local band, bor, bxor, bnot = bit.band, bit.bor, bit.bxor, bit.bnot
+local lshift, rshift, rol, tobit, tohex = bit.lshift, bit.rshift, bit.rol, bit.tobit, bit.tohex
+
+-- sha1 returns the hexadecimal SHA1 digest of the message.
+local function sha1(message)
+  local h0, h1, h2, h3, h4 = 0x67452301, 0xEFCDAB89, 0x98BADCFE, 0x10325476, 0xC3D2E1F0
+
+  local length = #message
+  local bits = length * 8
+  message = message .. "\128" .. string.rep("\0", (55 - length) % 64) .. "\0\0\0\0" ..
+    string.char(band(rshift(bits, 24), 0xff), band(rshift(bits, 16), 0xff), band(rshift(bits, 8), 0xff), band(bits, 0xff))
+
+  local w = {}
+  for chunk = 1, #message, 64 do
+    for i = 0, 15 do
+      local b1, b2, b3, b4 = message:byte(chunk + i * 4, chunk + i * 4 + 3)
+      w[i] = bor(lshift(b1, 24), lshift(b2, 16), lshift(b3, 8), b4)
+    end
+    for i = 16, 79 do
+      w[i] = rol(bxor(w[i - 3], w[i - 8], w[i - 14], w[i - 16]), 1)
+    end
+
+    local a, b, c, d, e = h0, h1, h2, h3, h4
+    for i = 0, 79 do
+      local f, k
+      if i < 20 then
+        f, k = bor(band(b, c), band(bnot(b), d)), 0x5A827999
+      elseif i < 40 then
+        f, k = bxor(b, c, d), 0x6ED9EBA1
+      elseif i < 60 then
+        f, k = bor(band(b, c), band(b, d), band(c, d)), 0x8F1BBCDC
+      else
+        f, k = bxor(b, c, d), 0xCA62C1D6
+      end
+      local temp = tobit(rol(a, 5) + f + e + k + w[i])
+      e = d
+      d = c
+      c = rol(b, 30)
+      b = a
+      a = temp
+    end
+
+    h0, h1, h2, h3, h4 = tobit(h0 + a), tobit(h1 + b), tobit(h2 + c), tobit(h3 + d), tobit(h4 + e)
+  end
+
+  return tohex(h0) .. tohex(h1) .. tohex(h2) .. tohex(h3) .. tohex(h4)
+end
+
+local base64_values = {}
+local base64_alphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+/"
+for i = 1, #base64_alphabet do
+  base64_values[base64_alphabet:byte(i)] = i - 1
+end
+
+-- base64_decode returns the decoded value, or nil if the value is not valid base64.
+local function base64_decode(value)
+  value = value:gsub("=*$", "", 1)
+  local decoded, buffer, buffered_bits = {}, 0, 0
+  for i = 1, #value do
+    local sextet = base64_values[value:byte(i)]
+    if sextet == nil then
+      return nil
+    end
+    buffer = bor(lshift(buffer, 6), sextet)
+    buffered_bits = buffered_bits + 6
+    if buffered_bits >= 8 then
+      buffered_bits = buffered_bits - 8
+      decoded[#decoded + 1] = string.char(band(rshift(buffer, buffered_bits), 0xff))
+    end
+  end
+  return table.concat(decoded)
+end
+
+-- unknown_user_digest is compared to the digest of the password of unknown
+-- users, so that they take as long to reject as known users. It never matches,
+-- as it is not hexadecimal.
+local unknown_user_digest = string.rep("-", 40)
+
+-- digests_equal returns true if the digests are equal. Its duration does not
+-- depend on how much of the digests match, so that it does not reveal it.
+local function digests_equal(a, b)
+  if #a ~= #b then
+    return false
+  end
+  local difference = 0
+  for i = 1, #a do
+    difference = bor(difference, bxor(a:byte(i), b:byte(i)))
+  end
+  return difference == 0
+end
+
+-- authenticated returns true if the authorization header holds the
+-- credentials of one of the users.
+local function authenticated(authorization)
+  if authorization == nil then
+    return false
+  end
+  local encoded = authorization:match("^[Bb][Aa][Ss][Ii][Cc]%s+(%S+)%s*$")
+  if encoded == nil then
+    return false
+  end
+  local credentials = base64_decode(encoded)
+  if credentials == nil then
+    return false
+  end
+  local user, password = credentials:match("^([^:]*):(.*)$")
+  if user == nil then
+    return false
+  end
+  return digests_equal(sha1(password), users[user] or unknown_user_digest)
+end
+
+function envoy_on_request(request_handle)
+  if authenticated(request_handle:headers():get("authorization")) then
+    return
+  end
+  request_handle:respond({
+    [":status"] = "401",
+    ["www-authenticate"] = 'Basic realm="http", charset="UTF-8"',
+  }, "User authentication failed. Missing or invalid credentials.")
+end
@@ -0,0 +1,182 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	"crypto/sha1" // nolint:gosec
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	glua "github.com/yuin/gopher-lua"
+
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+// basicAuthTestUsers are the htpasswd entries of the users of the tests, with
+// their passwords.
+var basicAuthTestUsers = []struct {
+	htpasswd string
+	password string
+}{
+	{htpasswd: "user1:{SHA}qUqP5cyxm6YcTAhz05Hph5gvu9M=", password: "test"},
+	{htpasswd: "user2:{SHA}W6ph5Mm5Pz8GgiULbPgzG37mj9g=", password: "password"},
+	{htpasswd: "user3:{SHA}cRQ3iPZN/cUFUyAxdC91S5hcXvA=", password: "pa:ss wörd"},
+	{
+		htpasswd: "user4:{SHA}Q/FKpAo9EuI1PdRC67cOO6Qna0E=",
+		password: "correct horse battery staple, correct horse battery staple, correct horse",
+	},
+}
+
+// TestBasicAuthLua runs the Lua code of the basic authentication filter against
+// the htpasswd entries of the test users.
+func TestBasicAuthLua(t *testing.T) {
+	basicAuth := &ir.BasicAuth{Name: "test"}
+	for _, user := range basicAuthTestUsers {
+		name, hash, _ := strings.Cut(user.htpasswd, ":")
+		passwordSHA1, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(hash, "{SHA}"))
+		require.NoError(t, err)
+		basicAuth.Users = append(basicAuth.Users, &ir.BasicAuthUser{Name: name, PasswordSHA1: passwordSHA1})
+	}
+
+	basic := func(credentials string) string {
+		return "Basic " + base64.StdEncoding.EncodeToString([]byte(credentials))
+	}
+	tests := []struct {
+		name          string
+		authorization string
+		authenticated bool
+	}{
+		{name: "missing header"},
+		{name: "other scheme", authorization: "Bearer dXNlcjE6dGVzdA=="},
+		{name: "invalid base64", authorization: "Basic dXNlcjE6d*Rlc3Q="},
+		{name: "missing colon", authorization: basic("user1test")},
+		{name: "unknown user", authorization: basic("user5:test")},
+		{name: "wrong password", authorization: basic("user1:password")},
+		{name: "password prefix", authorization: basic("user1:tes")},
+		{name: "empty password", authorization: basic("user1:")},
+		{name: "lower case scheme", authorization: "basic dXNlcjE6dGVzdA==", authenticated: true},
+		{name: "unpadded base64", authorization: "Basic dXNlcjE6dGVzdA", authenticated: true},
+	}
+	for _, user := range basicAuthTestUsers {
+		name, _, _ := strings.Cut(user.htpasswd, ":")
+		tests = append(tests, struct {
+			name          string
+			authorization string
+			authenticated bool
+		}{name: name, authorization: basic(name + ":" + user.password), authenticated: true})
+	}
+
+	code := buildBasicAuthLuaCode(basicAuth)
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.authenticated, runBasicAuthLua(t, code, tc.authorization))
+		})
+	}
+}
+
+// TestBasicAuthLuaPasswordLengths checks the SHA1 digests computed by the Lua code
+// for passwords spanning one to three blocks, around the lengths where the
+// padding of the message needs an extra block.
+func TestBasicAuthLuaPasswordLengths(t *testing.T) {
+	for length := 0; length <= 130; length++ {
+		password := strings.Repeat("x", length)
+		passwordSHA1 := sha1.Sum([]byte(password)) // nolint:gosec
+		basicAuth := &ir.BasicAuth{
+			Name:  "test",
+			Users: []*ir.BasicAuthUser{{Name: "user", PasswordSHA1: passwordSHA1[:]}},
+		}
+		authorization := "Basic " + base64.StdEncoding.EncodeToString([]byte("user:"+password))
+		require.True(t, runBasicAuthLua(t, buildBasicAuthLuaCode(basicAuth), authorization),
+			"password of %d bytes", length)
+	}
+}
+
+// runBasicAuthLua runs the Lua code on a request with the authorization header,
+// which is omitted if empty, and returns true if the request was authenticated.
+func runBasicAuthLua(t *testing.T, code, authorization string) bool {
+	t.Helper()
+
+	state := glua.NewState()
+	defer state.Close()
+	state.PreloadModule("bit", loadLuaJITBit)
+	require.NoError(t, state.DoString(code))
+
+	headers := state.NewTable()
+	state.SetField(headers, "get", state.NewFunction(func(l *glua.LState) int {
+		if strings.EqualFold(l.CheckString(2), "authorization") && authorization != "" {
+			l.Push(glua.LString(authorization))
+		} else {
+			l.Push(glua.LNil)
+		}
+		return 1
+	}))
+	var status string
+	handle := state.NewTable()
+	state.SetField(handle, "headers", state.NewFunction(func(l *glua.LState) int {
+		l.Push(headers)
+		return 1
+	}))
+	state.SetField(handle, "respond", state.NewFunction(func(l *glua.LState) int {
+		status = l.GetField(l.CheckTable(2), ":status").String()
+		return 0
+	}))
+
+	require.NoError(t, state.CallByParam(glua.P{Fn: state.GetGlobal("envoy_on_request"), Protect: true}, handle))
+	if status != "" {
+		require.Equal(t, "401", status)
+		return false
+	}
+	return true
+}
+
+// loadLuaJITBit loads the functions of the bit module of LuaJIT used by the
+// basic authentication, which is not available in gopher-lua. As in LuaJIT,
+// the results are signed 32-bit integers.
+func loadLuaJITBit(l *glua.LState) int {
+	toBit := func(l *glua.LState, n int) uint32 {
+		return uint32(int64(l.CheckNumber(n)))
+	}
+	result := func(l *glua.LState, value uint32) int {
+		l.Push(glua.LNumber(int32(value)))
+		return 1
+	}
+	fold := func(op func(a, b uint32) uint32) glua.LGFunction {
+		return func(l *glua.LState) int {
+			value := toBit(l, 1)
+			for n := 2; n <= l.GetTop(); n++ {
+				value = op(value, toBit(l, n))
+			}
+			return result(l, value)
+		}
+	}
+
+	l.Push(l.SetFuncs(l.NewTable(), map[string]glua.LGFunction{
+		"tobit": func(l *glua.LState) int { return result(l, toBit(l, 1)) },
+		"bnot":  func(l *glua.LState) int { return result(l, ^toBit(l, 1)) },
+		"band":  fold(func(a, b uint32) uint32 { return a & b }),
+		"bor":   fold(func(a, b uint32) uint32 { return a | b }),
+		"bxor":  fold(func(a, b uint32) uint32 { return a ^ b }),
+		"lshift": func(l *glua.LState) int {
+			return result(l, toBit(l, 1)<<(toBit(l, 2)&31))
+		},
+		"rshift": func(l *glua.LState) int {
+			return result(l, toBit(l, 1)>>(toBit(l, 2)&31))
+		},
+		"rol": func(l *glua.LState) int {
+			value, n := toBit(l, 1), toBit(l, 2)&31
+			return result(l, value<<n|value>>((32-n)&31))
+		},
+		"tohex": func(l *glua.LState) int {
+			l.Push(glua.LString(fmt.Sprintf("%08x", toBit(l, 1))))
+			return 1
+		},
+	}))
+	return 1
+}
@@ -6,32 +6,62 @@
 package translator
 
 import (
+	"strings"
 	"time"
 
 	cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	endpoint "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"github.com/envoyproxy/gateway/internal/ir"
 )
 
-func buildXdsCluster(routeName string, destinations []*ir.RouteDestination, isHTTP2 bool) (*cluster.Cluster, error) {
+const (
+	// defaultConnectTimeout is the connect timeout used when none is specified.
+	defaultConnectTimeout = 5 * time.Second
+
+	// Default values of the active health check settings that Envoy requires.
+	defaultHealthCheckTimeout            = time.Second
+	defaultHealthCheckInterval           = 3 * time.Second
+	defaultHealthCheckUnhealthyThreshold = 3
+	defaultHealthCheckHealthyThreshold   = 1
+)
+
+// xdsClusterArgs holds the parameters used to build an xDS cluster.
+type xdsClusterArgs struct {
+	name           string
+	destinations   []*ir.RouteDestination
+	isHTTP2        bool
+	timeout        *ir.Timeout
+	retry          *ir.Retry
+	healthCheck    *ir.HealthCheck
+	circuitBreaker *ir.CircuitBreaker
+	loadBalancer   *ir.LoadBalancer
+	http1          *ir.HTTP1Settings
+}
+
+func buildXdsCluster(args *xdsClusterArgs) (*cluster.Cluster, error) {
 	localities := make([]*endpoint.LocalityLbEndpoints, 0, 1)
 	locality := &endpoint.LocalityLbEndpoints{
 		Locality:    &core.Locality{},
-		LbEndpoints: buildXdsEndpoints(destinations),
+		LbEndpoints: buildXdsEndpoints(args.destinations),
 		Priority:    0,
 		// Each locality gets the same weight 1. There is a single locality
 		// per priority, so the weight value does not really matter, but some
 		// load balancers need the value to be set.
 		LoadBalancingWeight: &wrapperspb.UInt32Value{Value: 1}}
 	localities = append(localities, locality)
-	clusterName := routeName
+	clusterName := args.name
+	connectTimeout := defaultConnectTimeout
+	if args.timeout != nil && args.timeout.Connect != nil {
+		connectTimeout = args.timeout.Connect.Duration
+	}
 	cluster := &cluster.Cluster{
 		Name:                 clusterName,
-		ConnectTimeout:       durationpb.New(5 * time.Second),
+		ConnectTimeout:       durationpb.New(connectTimeout),
 		ClusterDiscoveryType: &cluster.Cluster_Type{Type: cluster.Cluster_STATIC},
 		LbPolicy:             cluster.Cluster_ROUND_ROBIN,
 		LoadAssignment:       &endpoint.ClusterLoadAssignment{ClusterName: clusterName, Endpoints: localities},
@@ -40,16 +70,201 @@ func buildXdsCluster(routeName string, destinations []*ir.RouteDestination, isHT
 			LocalityConfigSpecifier: &cluster.Cluster_CommonLbConfig_LocalityWeightedLbConfig_{
 				LocalityWeightedLbConfig: &cluster.Cluster_CommonLbConfig_LocalityWeightedLbConfig{}}},
 		OutlierDetection: &cluster.OutlierDetection{},
+		// Cluster names contain the route hostname, whose dots would be split
+		// into separate stats name segments. Use a dot free stats prefix so that
+		// the stats of the clusters, e.g. the circuit breaker overflows, can be
+		// alerted on.
+		AltStatName: strings.ReplaceAll(clusterName, ".", "_"),
+	}
+
+	if args.loadBalancer != nil {
+		setXdsClusterLbPolicy(cluster, args.loadBalancer)
 	}
 
+	// The gRPC health checks are sent over HTTP/2, which the backends must then
+	// serve whatever the protocol of the listener.
+	isHTTP2 := args.isHTTP2 ||
+		(args.healthCheck != nil && args.healthCheck.Active != nil && args.healthCheck.Active.GRPC != nil)
 	if isHTTP2 {
 		cluster.Http2ProtocolOptions = &core.Http2ProtocolOptions{}
+	} else if args.http1 != nil && args.http1.PreserveHeaderCase {
+		headerKeyFormat, err := buildXdsPreserveCaseHeaderKeyFormat()
+		if err != nil {
+			return nil, err
+		}
+		cluster.HttpProtocolOptions = &core.Http1ProtocolOptions{HeaderKeyFormat: headerKeyFormat}
+	}
+
+	if err := setXdsClusterTransportSocketMatches(cluster, args.destinations, isHTTP2); err != nil {
+		return nil, err
+	}
+
+	if args.healthCheck != nil {
+		if args.healthCheck.Active != nil {
+			cluster.HealthChecks = buildXdsHealthChecks(args.healthCheck.Active, isHTTP2)
+		}
+		if args.healthCheck.Passive != nil {
+			cluster.OutlierDetection = buildXdsOutlierDetection(args.healthCheck.Passive)
+		}
+	}
+
+	var budget *ir.RetryBudget
+	if args.retry != nil {
+		budget = args.retry.Budget
+	}
+	if args.circuitBreaker != nil || budget != nil {
+		cluster.CircuitBreakers = buildXdsCircuitBreakers(args.circuitBreaker, budget)
 	}
 
 	return cluster, nil
 
 }
 
+// setXdsClusterLbPolicy sets the load balancing policy of the cluster, keeping
+// the default round robin policy when no other policy is specified.
+func setXdsClusterLbPolicy(xdsCluster *cluster.Cluster, loadBalancer *ir.LoadBalancer) {
+	switch {
+	case loadBalancer.LeastRequest != nil:
+		xdsCluster.LbPolicy = cluster.Cluster_LEAST_REQUEST
+		if loadBalancer.LeastRequest.ChoiceCount != nil {
+			xdsCluster.LbConfig = &cluster.Cluster_LeastRequestLbConfig_{
+				LeastRequestLbConfig: &cluster.Cluster_LeastRequestLbConfig{
+					ChoiceCount: &wrapperspb.UInt32Value{Value: *loadBalancer.LeastRequest.ChoiceCount},
+				},
+			}
+		}
+	case loadBalancer.Random != nil:
+		xdsCluster.LbPolicy = cluster.Cluster_RANDOM
+	case loadBalancer.ConsistentHash != nil:
+		if loadBalancer.ConsistentHash.Algorithm == ir.MaglevAlgorithm {
+			xdsCluster.LbPolicy = cluster.Cluster_MAGLEV
+		} else {
+			xdsCluster.LbPolicy = cluster.Cluster_RING_HASH
+		}
+	}
+}
+
+func buildXdsHealthChecks(active *ir.ActiveHealthCheck, isHTTP2 bool) []*core.HealthCheck {
+	hc := &core.HealthCheck{
+		Timeout:            durationpb.New(defaultHealthCheckTimeout),
+		Interval:           durationpb.New(defaultHealthCheckInterval),
+		UnhealthyThreshold: &wrapperspb.UInt32Value{Value: defaultHealthCheckUnhealthyThreshold},
+		HealthyThreshold:   &wrapperspb.UInt32Value{Value: defaultHealthCheckHealthyThreshold},
+	}
+	if active.Timeout != nil {
+		hc.Timeout = durationpb.New(active.Timeout.Duration)
+	}
+	if active.Interval != nil {
+		hc.Interval = durationpb.New(active.Interval.Duration)
+	}
+	if active.UnhealthyThreshold != nil {
+		hc.UnhealthyThreshold = &wrapperspb.UInt32Value{Value: *active.UnhealthyThreshold}
+	}
+	if active.HealthyThreshold != nil {
+		hc.HealthyThreshold = &wrapperspb.UInt32Value{Value: *active.HealthyThreshold}
+	}
+
+	switch {
+	case active.HTTP != nil:
+		httpChecker := &core.HealthCheck_HttpHealthCheck{
+			Path: active.HTTP.Path,
+		}
+		// Each expected status is translated into the half-open range [status, status+1).
+		for _, status := range active.HTTP.ExpectedStatuses {
+			httpChecker.ExpectedStatuses = append(httpChecker.ExpectedStatuses, &typev3.Int64Range{
+				Start: int64(status),
+				End:   int64(status) + 1,
+			})
+		}
+		if isHTTP2 {
+			httpChecker.CodecClientType = typev3.CodecClientType_HTTP2
+		}
+		hc.HealthChecker = &core.HealthCheck_HttpHealthCheck_{HttpHealthCheck: httpChecker}
+	case active.TCP != nil:
+		tcpChecker := &core.HealthCheck_TcpHealthCheck{}
+		if len(active.TCP.Send) > 0 {
+			tcpChecker.Send = &core.HealthCheck_Payload{
+				Payload: &core.HealthCheck_Payload_Binary{Binary: active.TCP.Send},
+			}
+		}
+		if len(active.TCP.Receive) > 0 {
+			tcpChecker.Receive = []*core.HealthCheck_Payload{{
+				Payload: &core.HealthCheck_Payload_Binary{Binary: active.TCP.Receive},
+			}}
+		}
+		hc.HealthChecker = &core.HealthCheck_TcpHealthCheck_{TcpHealthCheck: tcpChecker}
+	case active.GRPC != nil:
+		grpcChecker := &core.HealthCheck_GrpcHealthCheck{}
+		if active.GRPC.Service != nil {
+			grpcChecker.ServiceName = *active.GRPC.Service
+		}
+		hc.HealthChecker = &core.HealthCheck_GrpcHealthCheck_{GrpcHealthCheck: grpcChecker}
+	}
+
+	return []*core.HealthCheck{hc}
+}
+
+func buildXdsOutlierDetection(passive *ir.OutlierDetection) *cluster.OutlierDetection {
+	ret := &cluster.OutlierDetection{}
+	if passive.Interval != nil {
+		ret.Interval = durationpb.New(passive.Interval.Duration)
+	}
+	if passive.Consecutive5xxErrors != nil {
+		ret.Consecutive_5Xx = &wrapperspb.UInt32Value{Value: *passive.Consecutive5xxErrors}
+	}
+	if passive.ConsecutiveGatewayErrors != nil {
+		ret.ConsecutiveGatewayFailure = &wrapperspb.UInt32Value{Value: *passive.ConsecutiveGatewayErrors}
+		// Ejections due to gateway errors are not enforced by default.
+		ret.EnforcingConsecutiveGatewayFailure = &wrapperspb.UInt32Value{Value: 100}
+	}
+	if passive.BaseEjectionTime != nil {
+		ret.BaseEjectionTime = durationpb.New(passive.BaseEjectionTime.Duration)
+	}
+	if passive.MaxEjectionPercent != nil {
+		ret.MaxEjectionPercent = &wrapperspb.UInt32Value{Value: *passive.MaxEjectionPercent}
+	}
+	return ret
+}
+
+func buildXdsCircuitBreakers(circuitBreaker *ir.CircuitBreaker, budget *ir.RetryBudget) *cluster.CircuitBreakers {
+	thresholds := &cluster.CircuitBreakers_Thresholds{
+		Priority: core.RoutingPriority_DEFAULT,
+	}
+
+	if circuitBreaker != nil {
+		// Expose the remaining_* gauges so that the headroom left before the
+		// thresholds overflow can be observed.
+		thresholds.TrackRemaining = true
+		if circuitBreaker.MaxConnections != nil {
+			thresholds.MaxConnections = &wrapperspb.UInt32Value{Value: *circuitBreaker.MaxConnections}
+		}
+		if circuitBreaker.MaxPendingRequests != nil {
+			thresholds.MaxPendingRequests = &wrapperspb.UInt32Value{Value: *circuitBreaker.MaxPendingRequests}
+		}
+		if circuitBreaker.MaxParallelRequests != nil {
+			thresholds.MaxRequests = &wrapperspb.UInt32Value{Value: *circuitBreaker.MaxParallelRequests}
+		}
+		if circuitBreaker.MaxParallelRetries != nil {
+			thresholds.MaxRetries = &wrapperspb.UInt32Value{Value: *circuitBreaker.MaxParallelRetries}
+		}
+	}
+
+	if budget != nil {
+		retryBudget := &cluster.CircuitBreakers_Thresholds_RetryBudget{}
+		if budget.Percent != nil {
+			retryBudget.BudgetPercent = &typev3.Percent{Value: float64(*budget.Percent)}
+		}
+		if budget.MinRetryConcurrency != nil {
+			retryBudget.MinRetryConcurrency = &wrapperspb.UInt32Value{Value: *budget.MinRetryConcurrency}
+		}
+		thresholds.RetryBudget = retryBudget
+	}
+
+	return &cluster.CircuitBreakers{
+		Thresholds: []*cluster.CircuitBreakers_Thresholds{thresholds},
+	}
+}
+
 func buildXdsEndpoints(destinations []*ir.RouteDestination) []*endpoint.LbEndpoint {
 	endpoints := make([]*endpoint.LbEndpoint, 0, len(destinations))
 	for _, destination := range destinations {
@@ -73,7 +288,45 @@ func buildXdsEndpoints(destinations []*ir.RouteDestination) []*endpoint.LbEndpoi
 		if destination.Weight != 0 {
 			lbEndpoint.LoadBalancingWeight = &wrapperspb.UInt32Value{Value: destination.Weight}
 		}
+		if destination.TLS != nil {
+			lbEndpoint.Metadata = buildXdsEndpointTransportSocketMatch(destination.TLS)
+		}
 		endpoints = append(endpoints, lbEndpoint)
 	}
 	return endpoints
 }
+
+// buildXdsGRPCServiceCluster returns the cluster of a gRPC service resolving
+// the host through DNS.
+func buildXdsGRPCServiceCluster(name, host string, port uint32) *cluster.Cluster {
+	return &cluster.Cluster{
+		Name:                 name,
+		ConnectTimeout:       durationpb.New(defaultConnectTimeout),
+		ClusterDiscoveryType: &cluster.Cluster_Type{Type: cluster.Cluster_STRICT_DNS},
+		LbPolicy:             cluster.Cluster_ROUND_ROBIN,
+		DnsLookupFamily:      cluster.Cluster_V4_ONLY,
+		Http2ProtocolOptions: &core.Http2ProtocolOptions{},
+		LoadAssignment: &endpoint.ClusterLoadAssignment{
+			ClusterName: name,
+			Endpoints: []*endpoint.LocalityLbEndpoints{{
+				LbEndpoints: []*endpoint.LbEndpoint{{
+					HostIdentifier: &endpoint.LbEndpoint_Endpoint{
+						Endpoint: &endpoint.Endpoint{
+							Address: &core.Address{
+								Address: &core.Address_SocketAddress{
+									SocketAddress: &core.SocketAddress{
+										Protocol: core.SocketAddress_TCP,
+										Address:  host,
+										PortSpecifier: &core.SocketAddress_PortValue{
+											PortValue: port,
+										},
+									},
+								},
+							},
+						},
+					},
+				}},
+			}},
+		},
+	}
+}
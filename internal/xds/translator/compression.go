@@ -0,0 +1,104 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	"fmt"
+	"strings"
+
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	brotli "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/brotli/compressor/v3"
+	gzip "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/gzip/compressor/v3"
+	compressor "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/compressor/v3"
+	hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+const compressorFilter = "envoy.filters.http.compressor"
+
+// compressionFilterName returns the name of the compressor filter of the algorithm.
+func compressionFilterName(compression *ir.Compression, algorithm ir.CompressionAlgorithm) string {
+	return fmt.Sprintf("%s.%s/%s", compressorFilter, strings.ToLower(string(algorithm)), compression.Name)
+}
+
+// compressionFilters are the compressor filters of the compression settings,
+// one for each of their algorithms. The filters are added in the order of
+// preference of the algorithms, which Envoy follows when the client accepts
+// several algorithms with the same weight.
+var compressionFilters = &routeFilters[ir.Compression]{
+	settings: func(irRoute *ir.HTTPRoute) *ir.Compression { return irRoute.Compression },
+	name:     func(compression *ir.Compression) string { return compression.Name },
+	filterNames: func(compression *ir.Compression) []string {
+		var names []string
+		for _, algorithm := range compression.Algorithms {
+			names = append(names, compressionFilterName(compression, algorithm))
+		}
+		return names
+	},
+	buildFilters: buildHCMCompressorFilters,
+	disabledConfig: &compressor.CompressorPerRoute{
+		Override: &compressor.CompressorPerRoute_Disabled{Disabled: true},
+	},
+}
+
+// buildHCMCompressorFilters returns the compressor filters of the algorithms of
+// the compression settings.
+func buildHCMCompressorFilters(compression *ir.Compression) ([]*hcm.HttpFilter, error) {
+	var filters []*hcm.HttpFilter
+	for _, algorithm := range compression.Algorithms {
+		filter, err := buildHCMCompressorFilter(compression, algorithm)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, filter)
+	}
+	return filters, nil
+}
+
+// buildHCMCompressorFilter returns the compressor filter of the algorithm.
+func buildHCMCompressorFilter(compression *ir.Compression, algorithm ir.CompressionAlgorithm) (*hcm.HttpFilter, error) {
+	var library *core.TypedExtensionConfig
+	switch algorithm {
+	case ir.GzipCompressionAlgorithm:
+		libraryAny, err := anypb.New(&gzip.Gzip{})
+		if err != nil {
+			return nil, err
+		}
+		library = &core.TypedExtensionConfig{Name: "envoy.compression.gzip.compressor", TypedConfig: libraryAny}
+	case ir.BrotliCompressionAlgorithm:
+		libraryAny, err := anypb.New(&brotli.Brotli{})
+		if err != nil {
+			return nil, err
+		}
+		library = &core.TypedExtensionConfig{Name: "envoy.compression.brotli.compressor", TypedConfig: libraryAny}
+	default:
+		return nil, fmt.Errorf("unsupported compression algorithm %s", algorithm)
+	}
+
+	commonConfig := &compressor.Compressor_CommonDirectionConfig{
+		ContentType: compression.ContentTypes,
+	}
+	if compression.MinContentLength != nil {
+		commonConfig.MinContentLength = wrapperspb.UInt32(*compression.MinContentLength)
+	}
+	configAny, err := anypb.New(&compressor.Compressor{
+		ResponseDirectionConfig: &compressor.Compressor_ResponseDirectionConfig{
+			CommonConfig: commonConfig,
+		},
+		CompressorLibrary: library,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &hcm.HttpFilter{
+		Name:       compressionFilterName(compression, algorithm),
+		ConfigType: &hcm.HttpFilter_TypedConfig{TypedConfig: configAny},
+	}, nil
+}
@@ -0,0 +1,70 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	cors "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/cors/v3"
+	hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+// listenerContainsCORS returns true if any route of the listener has a CORS policy.
+func listenerContainsCORS(irListener *ir.HTTPListener) bool {
+	for _, irRoute := range irListener.Routes {
+		if irRoute.CORS != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// buildHCMCORSFilter returns the CORS filter added to the connection manager.
+// It only handles the requests of the routes with a CORS policy.
+func buildHCMCORSFilter() (*hcm.HttpFilter, error) {
+	configAny, err := anypb.New(&cors.Cors{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &hcm.HttpFilter{
+		Name:       wellknown.CORS,
+		ConfigType: &hcm.HttpFilter_TypedConfig{TypedConfig: configAny},
+	}, nil
+}
+
+// patchRouteWithCORS sets the CORS policy of the route. The policy is part of
+// the route action, so that it does not apply to redirects and direct responses.
+func patchRouteWithCORS(xdsRoute *route.Route, irRoute *ir.HTTPRoute) {
+	routeAction := xdsRoute.GetRoute()
+	if irRoute.CORS == nil || routeAction == nil {
+		return
+	}
+
+	policy := &route.CorsPolicy{
+		AllowMethods:  strings.Join(irRoute.CORS.AllowMethods, ", "),
+		AllowHeaders:  strings.Join(irRoute.CORS.AllowHeaders, ", "),
+		ExposeHeaders: strings.Join(irRoute.CORS.ExposeHeaders, ", "),
+	}
+	for _, origin := range irRoute.CORS.AllowOrigins {
+		policy.AllowOriginStringMatch = append(policy.AllowOriginStringMatch, buildXdsStringMatcher(origin))
+	}
+	if irRoute.CORS.MaxAge != nil {
+		policy.MaxAge = strconv.FormatInt(int64(irRoute.CORS.MaxAge.Duration/time.Second), 10)
+	}
+	if irRoute.CORS.AllowCredentials {
+		policy.AllowCredentials = wrapperspb.Bool(true)
+	}
+	routeAction.Cors = policy
+}
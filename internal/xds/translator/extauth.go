@@ -0,0 +1,140 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	extauthz "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_authz/v3"
+	hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	matcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+const (
+	// extAuthDefaultTimeout is the timeout of the authorization requests
+	// when the external authorization service does not set one.
+	extAuthDefaultTimeout = 10 * time.Second
+)
+
+// extAuthFilterName returns the name of the ext_authz filter of the external
+// authorization service.
+func extAuthFilterName(extAuth *ir.ExtAuth) string {
+	return fmt.Sprintf("%s/%s", wellknown.HTTPExternalAuthorization, extAuth.Name)
+}
+
+// extAuthClusterName returns the name of the cluster of the external authorization service.
+func extAuthClusterName(extAuth *ir.ExtAuth) string {
+	return extAuth.Name
+}
+
+// extAuthFilters are the ext_authz filters of the external authorization services.
+var extAuthFilters = &routeFilters[ir.ExtAuth]{
+	settings:     func(irRoute *ir.HTTPRoute) *ir.ExtAuth { return irRoute.ExtAuth },
+	name:         func(extAuth *ir.ExtAuth) string { return extAuth.Name },
+	filterNames:  func(extAuth *ir.ExtAuth) []string { return []string{extAuthFilterName(extAuth)} },
+	buildFilters: buildSingleFilter(buildHCMExtAuthFilter),
+	disabledConfig: &extauthz.ExtAuthzPerRoute{
+		Override: &extauthz.ExtAuthzPerRoute_Disabled{Disabled: true},
+	},
+}
+
+// buildHCMExtAuthFilter returns the ext_authz filter sending the requests to
+// the external authorization service.
+func buildHCMExtAuthFilter(extAuth *ir.ExtAuth) (*hcm.HttpFilter, error) {
+	timeout := durationpb.New(extAuthDefaultTimeout)
+	if extAuth.Timeout != nil {
+		timeout = durationpb.New(extAuth.Timeout.Duration)
+	}
+
+	config := &extauthz.ExtAuthz{
+		TransportApiVersion: core.ApiVersion_V3,
+		FailureModeAllow:    extAuth.FailOpen,
+	}
+	switch {
+	case extAuth.GRPC != nil:
+		config.Services = &extauthz.ExtAuthz_GrpcService{
+			GrpcService: &core.GrpcService{
+				TargetSpecifier: &core.GrpcService_EnvoyGrpc_{
+					EnvoyGrpc: &core.GrpcService_EnvoyGrpc{ClusterName: extAuthClusterName(extAuth)},
+				},
+				Timeout: timeout,
+			},
+		}
+	case extAuth.HTTP != nil:
+		destination := extAuth.HTTP.Destination
+		service := &extauthz.HttpService{
+			ServerUri: &core.HttpUri{
+				Uri: "http://" + net.JoinHostPort(destination.Host, strconv.Itoa(int(destination.Port))),
+				HttpUpstreamType: &core.HttpUri_Cluster{
+					Cluster: extAuthClusterName(extAuth),
+				},
+				Timeout: timeout,
+			},
+			PathPrefix: extAuth.HTTP.Path,
+		}
+		if len(extAuth.HTTP.HeadersToExtAuth) > 0 {
+			service.AuthorizationRequest = &extauthz.AuthorizationRequest{
+				AllowedHeaders: buildXdsHeaderNamesMatcher(extAuth.HTTP.HeadersToExtAuth),
+			}
+		}
+		if len(extAuth.HTTP.HeadersToBackend) > 0 || len(extAuth.HTTP.HeadersToClient) > 0 {
+			service.AuthorizationResponse = &extauthz.AuthorizationResponse{
+				AllowedUpstreamHeaders: buildXdsHeaderNamesMatcher(extAuth.HTTP.HeadersToBackend),
+				AllowedClientHeaders:   buildXdsHeaderNamesMatcher(extAuth.HTTP.HeadersToClient),
+			}
+		}
+		config.Services = &extauthz.ExtAuthz_HttpService{HttpService: service}
+	}
+
+	configAny, err := anypb.New(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hcm.HttpFilter{
+		Name:       extAuthFilterName(extAuth),
+		ConfigType: &hcm.HttpFilter_TypedConfig{TypedConfig: configAny},
+	}, nil
+}
+
+// buildXdsHeaderNamesMatcher returns a matcher matching the header names,
+// ignoring case. It returns nil if there are no header names.
+func buildXdsHeaderNamesMatcher(names []string) *matcher.ListStringMatcher {
+	if len(names) == 0 {
+		return nil
+	}
+	ret := &matcher.ListStringMatcher{}
+	for _, name := range names {
+		ret.Patterns = append(ret.Patterns, &matcher.StringMatcher{
+			MatchPattern: &matcher.StringMatcher_Exact{Exact: name},
+			IgnoreCase:   true,
+		})
+	}
+	return ret
+}
+
+// buildXdsExtAuthCluster returns the cluster of the external authorization service.
+func buildXdsExtAuthCluster(extAuth *ir.ExtAuth) (*cluster.Cluster, error) {
+	args := &xdsClusterArgs{name: extAuthClusterName(extAuth)}
+	switch {
+	case extAuth.GRPC != nil:
+		args.destinations = []*ir.RouteDestination{extAuth.GRPC.Destination}
+		args.isHTTP2 = true
+	case extAuth.HTTP != nil:
+		args.destinations = []*ir.RouteDestination{extAuth.HTTP.Destination}
+	}
+	return buildXdsCluster(args)
+}
@@ -0,0 +1,99 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	faultcommon "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/common/fault/v3"
+	fault "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/fault/v3"
+	hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+// listenerContainsFaultInjection returns true if any route of the listener injects faults.
+func listenerContainsFaultInjection(irListener *ir.HTTPListener) bool {
+	for _, route := range irListener.Routes {
+		if route.FaultInjection != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// buildHCMFaultFilter returns the fault filter added to the connection manager.
+// It injects no fault, so it only affects the routes that configure faults
+// through their per filter config.
+func buildHCMFaultFilter() (*hcm.HttpFilter, error) {
+	faultAny, err := anypb.New(&fault.HTTPFault{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &hcm.HttpFilter{
+		Name:       wellknown.Fault,
+		ConfigType: &hcm.HttpFilter_TypedConfig{TypedConfig: faultAny},
+	}, nil
+}
+
+// patchRouteWithFaultInjection sets the per filter config holding the faults
+// injected into the requests of the route.
+func patchRouteWithFaultInjection(xdsRoute *route.Route, irRoute *ir.HTTPRoute) error {
+	faultInjection := irRoute.FaultInjection
+	if faultInjection == nil {
+		return nil
+	}
+
+	config := &fault.HTTPFault{}
+	if delay := faultInjection.Delay; delay != nil {
+		config.Delay = &faultcommon.FaultDelay{
+			FaultDelaySecifier: &faultcommon.FaultDelay_FixedDelay{
+				FixedDelay: durationpb.New(delay.FixedDelay.Duration),
+			},
+			Percentage: buildXdsPercent(delay.Percentage),
+		}
+	}
+	if abort := faultInjection.Abort; abort != nil {
+		config.Abort = &fault.FaultAbort{
+			Percentage: buildXdsPercent(abort.Percentage),
+		}
+		if abort.GRPCStatus != nil {
+			config.Abort.ErrorType = &fault.FaultAbort_GrpcStatus{GrpcStatus: *abort.GRPCStatus}
+		} else if abort.HTTPStatus != nil {
+			config.Abort.ErrorType = &fault.FaultAbort_HttpStatus{HttpStatus: *abort.HTTPStatus}
+		}
+	}
+	for _, headerMatch := range faultInjection.HeaderMatches {
+		config.Headers = append(config.Headers, &route.HeaderMatcher{
+			Name: headerMatch.Name,
+			HeaderMatchSpecifier: &route.HeaderMatcher_StringMatch{
+				StringMatch: buildXdsStringMatcher(headerMatch),
+			},
+		})
+	}
+
+	configAny, err := anypb.New(config)
+	if err != nil {
+		return err
+	}
+	if xdsRoute.TypedPerFilterConfig == nil {
+		xdsRoute.TypedPerFilterConfig = map[string]*anypb.Any{}
+	}
+	xdsRoute.TypedPerFilterConfig[wellknown.Fault] = configAny
+
+	return nil
+}
+
+func buildXdsPercent(percentage uint32) *typev3.FractionalPercent {
+	return &typev3.FractionalPercent{
+		Numerator:   percentage,
+		Denominator: typev3.FractionalPercent_HUNDRED,
+	}
+}
@@ -0,0 +1,241 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	"errors"
+
+	listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+// patchHCMWithFilters adds the HTTP filters needed by the routes of the
+// listener to the HTTP connection manager, ahead of the router filter.
+// Filters that already exist are not added twice, so that it can be called
+// for each IR listener sharing the same connection manager.
+func patchHCMWithFilters(mgr *hcm.HttpConnectionManager, irListener *ir.HTTPListener, rateLimitService *ir.RateLimitService) error {
+	// The preflight requests are answered before anything else, since the
+	// browsers send them without credentials.
+	if listenerContainsCORS(irListener) && !hcmContainsFilter(mgr, wellknown.CORS) {
+		filter, err := buildHCMCORSFilter()
+		if err != nil {
+			return err
+		}
+		addHCMFilter(mgr, filter)
+	}
+
+	// The requests of denied clients are rejected before they are authenticated.
+	if listenerContainsIPFilter(irListener) && !hcmContainsFilter(mgr, wellknown.HTTPRoleBasedAccessControl) {
+		filter, err := buildHCMRBACFilter()
+		if err != nil {
+			return err
+		}
+		addHCMFilter(mgr, filter)
+	}
+
+	// The users must be authenticated before their requests are authorized.
+	if err := oidcFilters.patchHCM(mgr, irListener); err != nil {
+		return err
+	}
+
+	if err := basicAuthFilters.patchHCM(mgr, irListener); err != nil {
+		return err
+	}
+
+	if err := jwtFilters.patchHCM(mgr, irListener); err != nil {
+		return err
+	}
+
+	if err := extAuthFilters.patchHCM(mgr, irListener); err != nil {
+		return err
+	}
+
+	if listenerContainsLocalRateLimit(irListener) && !hcmContainsFilter(mgr, localRateLimitFilter) {
+		filter, err := buildHCMLocalRateLimitFilter()
+		if err != nil {
+			return err
+		}
+		addHCMFilter(mgr, filter)
+	}
+
+	if rateLimitService != nil && listenerContainsGlobalRateLimit(irListener) && !hcmContainsFilter(mgr, wellknown.HTTPRateLimit) {
+		filter, err := buildHCMRateLimitFilter(rateLimitService)
+		if err != nil {
+			return err
+		}
+		addHCMFilter(mgr, filter)
+	}
+
+	// The faults are injected into the requests allowed through, right before
+	// they are forwarded.
+	if listenerContainsFaultInjection(irListener) && !hcmContainsFilter(mgr, wellknown.Fault) {
+		filter, err := buildHCMFaultFilter()
+		if err != nil {
+			return err
+		}
+		addHCMFilter(mgr, filter)
+	}
+
+	// The responses are compressed before they go through the other filters,
+	// right after the router.
+	if err := compressionFilters.patchHCM(mgr, irListener); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// patchXdsHTTPFilterChain adds the HTTP filters needed by the routes of the
+// listener to the connection manager of an existing filter chain.
+func patchXdsHTTPFilterChain(filterChain *listener.FilterChain, irListener *ir.HTTPListener, rateLimitService *ir.RateLimitService) error {
+	for _, filter := range filterChain.GetFilters() {
+		if filter.Name != wellknown.HTTPConnectionManager {
+			continue
+		}
+
+		mgr := &hcm.HttpConnectionManager{}
+		if err := filter.GetTypedConfig().UnmarshalTo(mgr); err != nil {
+			return err
+		}
+		if err := patchHCMWithFilters(mgr, irListener, rateLimitService); err != nil {
+			return err
+		}
+		mgrAny, err := anypb.New(mgr)
+		if err != nil {
+			return err
+		}
+		filter.ConfigType = &listener.Filter_TypedConfig{TypedConfig: mgrAny}
+		return nil
+	}
+
+	return errors.New("http connection manager not found in filter chain")
+}
+
+// addHCMFilter adds the filter right before the router filter, which must stay last.
+func addHCMFilter(mgr *hcm.HttpConnectionManager, filter *hcm.HttpFilter) {
+	for i, existing := range mgr.HttpFilters {
+		if existing.Name == wellknown.Router {
+			mgr.HttpFilters = append(mgr.HttpFilters[:i], append([]*hcm.HttpFilter{filter}, mgr.HttpFilters[i:]...)...)
+			return
+		}
+	}
+	mgr.HttpFilters = append(mgr.HttpFilters, filter)
+}
+
+// hcmContainsFilter returns true if the connection manager contains a filter named name.
+func hcmContainsFilter(mgr *hcm.HttpConnectionManager, name string) bool {
+	for _, existing := range mgr.HttpFilters {
+		if existing.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// routeFilters describes the HTTP filters of the settings of type T that the
+// routes of the IR may set, e.g. their external authorization service. Each
+// settings get their own filters, named after them. Since the filters of a
+// connection manager apply to all its routes, each route disables the filters
+// of the settings it does not use.
+type routeFilters[T any] struct {
+	// settings returns the settings of the route, or nil if it has none.
+	settings func(irRoute *ir.HTTPRoute) *T
+	// name returns the name identifying the settings among the ones of the IR.
+	name func(settings *T) string
+	// filterNames returns the names of the filters of the settings.
+	filterNames func(settings *T) []string
+	// buildFilters returns the filters of the settings, in the order of filterNames.
+	buildFilters func(settings *T) ([]*hcm.HttpFilter, error)
+	// disabledConfig is the per route config disabling one of the filters, or
+	// nil if the routes do not disable them.
+	disabledConfig proto.Message
+}
+
+// list returns the settings of the routes of the IR, without duplicates, in the
+// order in which they first appear.
+func (f *routeFilters[T]) list(xdsIR *ir.Xds) []*T {
+	var list []*T
+	found := map[string]bool{}
+	for _, httpListener := range xdsIR.HTTP {
+		for _, httpRoute := range httpListener.Routes {
+			if settings := f.settings(httpRoute); settings != nil && !found[f.name(settings)] {
+				found[f.name(settings)] = true
+				list = append(list, settings)
+			}
+		}
+	}
+	return list
+}
+
+// patchHCM adds to the connection manager the filters of the settings used by
+// the routes of the listener, unless already added.
+func (f *routeFilters[T]) patchHCM(mgr *hcm.HttpConnectionManager, irListener *ir.HTTPListener) error {
+	for _, irRoute := range irListener.Routes {
+		settings := f.settings(irRoute)
+		if settings == nil || hcmContainsFilters(mgr, f.filterNames(settings)) {
+			continue
+		}
+		filters, err := f.buildFilters(settings)
+		if err != nil {
+			return err
+		}
+		for _, filter := range filters {
+			addHCMFilter(mgr, filter)
+		}
+	}
+	return nil
+}
+
+// patchRoute disables on the route the filters of the listed settings other
+// than the ones of the route.
+func (f *routeFilters[T]) patchRoute(xdsRoute *route.Route, irRoute *ir.HTTPRoute, list []*T) error {
+	settings := f.settings(irRoute)
+	for _, other := range list {
+		if settings != nil && f.name(settings) == f.name(other) {
+			continue
+		}
+		configAny, err := anypb.New(f.disabledConfig)
+		if err != nil {
+			return err
+		}
+		if xdsRoute.TypedPerFilterConfig == nil {
+			xdsRoute.TypedPerFilterConfig = map[string]*anypb.Any{}
+		}
+		for _, filterName := range f.filterNames(other) {
+			xdsRoute.TypedPerFilterConfig[filterName] = configAny
+		}
+	}
+	return nil
+}
+
+// hcmContainsFilters returns true if the connection manager contains all the
+// filters named names.
+func hcmContainsFilters(mgr *hcm.HttpConnectionManager, names []string) bool {
+	for _, name := range names {
+		if !hcmContainsFilter(mgr, name) {
+			return false
+		}
+	}
+	return true
+}
+
+// buildSingleFilter adapts the builder of the single filter of some settings
+// to routeFilters.buildFilters.
+func buildSingleFilter[T any](build func(settings *T) (*hcm.HttpFilter, error)) func(settings *T) ([]*hcm.HttpFilter, error) {
+	return func(settings *T) ([]*hcm.HttpFilter, error) {
+		filter, err := build(settings)
+		if err != nil {
+			return nil, err
+		}
+		return []*hcm.HttpFilter{filter}, nil
+	}
+}
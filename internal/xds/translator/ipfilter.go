@@ -0,0 +1,179 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	"fmt"
+	"net"
+
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	rbacconfig "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	httprbac "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/rbac/v3"
+	hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	networkrbac "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/rbac/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+const (
+	// ipFilterPolicyName is the name of the RBAC policy allowing the clients
+	// of an IP filter.
+	ipFilterPolicyName = "ip-filter"
+	// ipFilterStatPrefix is the stat prefix of the network RBAC filters of the
+	// IP filters of the TCP listeners.
+	ipFilterStatPrefix = "ip_filter"
+)
+
+// listenerContainsIPFilter returns true if any route of the listener has an IP filter.
+func listenerContainsIPFilter(irListener *ir.HTTPListener) bool {
+	for _, irRoute := range irListener.Routes {
+		if irRoute.IPFilter != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// buildHCMRBACFilter returns the HTTP RBAC filter enforcing the IP filters
+// of the routes. It has no rules of its own, so it only denies requests on the
+// routes configuring one, see patchRouteWithIPFilter.
+func buildHCMRBACFilter() (*hcm.HttpFilter, error) {
+	configAny, err := anypb.New(&httprbac.RBAC{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &hcm.HttpFilter{
+		Name:       wellknown.HTTPRoleBasedAccessControl,
+		ConfigType: &hcm.HttpFilter_TypedConfig{TypedConfig: configAny},
+	}, nil
+}
+
+// patchRouteWithIPFilter sets the RBAC rules of the IP filter of the route.
+func patchRouteWithIPFilter(xdsRoute *route.Route, irRoute *ir.HTTPRoute) error {
+	if irRoute.IPFilter == nil {
+		return nil
+	}
+	rules, err := buildXdsIPFilterRBAC(irRoute.IPFilter, irRoute.IPFilter.UseXForwardedFor)
+	if err != nil {
+		return err
+	}
+	configAny, err := anypb.New(&httprbac.RBACPerRoute{
+		Rbac: &httprbac.RBAC{Rules: rules},
+	})
+	if err != nil {
+		return err
+	}
+	if xdsRoute.TypedPerFilterConfig == nil {
+		xdsRoute.TypedPerFilterConfig = map[string]*anypb.Any{}
+	}
+	xdsRoute.TypedPerFilterConfig[wellknown.HTTPRoleBasedAccessControl] = configAny
+	return nil
+}
+
+// buildXdsIPFilterNetworkFilter returns the network RBAC filter closing the
+// connections of the clients denied by the IP filter of a TCP listener.
+func buildXdsIPFilterNetworkFilter(ipFilter *ir.IPFilter) (*listener.Filter, error) {
+	// The X-Forwarded-For header cannot be inspected on TCP listeners.
+	rules, err := buildXdsIPFilterRBAC(ipFilter, false)
+	if err != nil {
+		return nil, err
+	}
+	configAny, err := anypb.New(&networkrbac.RBAC{
+		Rules:      rules,
+		StatPrefix: ipFilterStatPrefix,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &listener.Filter{
+		Name:       wellknown.RoleBasedAccessControl,
+		ConfigType: &listener.Filter_TypedConfig{TypedConfig: configAny},
+	}, nil
+}
+
+// buildXdsIPFilterRBAC returns the RBAC rules allowing the clients of the allowed
+// CIDRs, or all the clients if there are none, unless they belong to a denied CIDR.
+// The client IP address is the one derived from the X-Forwarded-For header by the
+// connection manager if useXForwardedFor is set, or the peer address otherwise.
+func buildXdsIPFilterRBAC(ipFilter *ir.IPFilter, useXForwardedFor bool) (*rbacconfig.RBAC, error) {
+	allowed, err := buildXdsCIDRPrincipals(ipFilter.Allow, useXForwardedFor)
+	if err != nil {
+		return nil, err
+	}
+	principal := &rbacconfig.Principal{Identifier: &rbacconfig.Principal_Any{Any: true}}
+	if len(allowed) > 0 {
+		principal = &rbacconfig.Principal{
+			Identifier: &rbacconfig.Principal_OrIds{OrIds: &rbacconfig.Principal_Set{Ids: allowed}},
+		}
+	}
+
+	denied, err := buildXdsCIDRPrincipals(ipFilter.Deny, useXForwardedFor)
+	if err != nil {
+		return nil, err
+	}
+	if len(denied) > 0 {
+		principal = &rbacconfig.Principal{
+			Identifier: &rbacconfig.Principal_AndIds{AndIds: &rbacconfig.Principal_Set{Ids: []*rbacconfig.Principal{
+				principal,
+				{Identifier: &rbacconfig.Principal_NotId{NotId: &rbacconfig.Principal{
+					Identifier: &rbacconfig.Principal_OrIds{OrIds: &rbacconfig.Principal_Set{Ids: denied}},
+				}}},
+			}}},
+		}
+	}
+
+	return &rbacconfig.RBAC{
+		Action: rbacconfig.RBAC_ALLOW,
+		Policies: map[string]*rbacconfig.Policy{
+			ipFilterPolicyName: {
+				Permissions: []*rbacconfig.Permission{{Rule: &rbacconfig.Permission_Any{Any: true}}},
+				Principals:  []*rbacconfig.Principal{principal},
+			},
+		},
+	}, nil
+}
+
+// buildXdsCIDRPrincipals returns the RBAC principals matching the client IP
+// addresses of the CIDRs.
+func buildXdsCIDRPrincipals(cidrs []string, useXForwardedFor bool) ([]*rbacconfig.Principal, error) {
+	var principals []*rbacconfig.Principal
+	for _, cidr := range cidrs {
+		cidrRange, err := buildXdsCIDRRange(cidr)
+		if err != nil {
+			return nil, err
+		}
+		if useXForwardedFor {
+			principals = append(principals, &rbacconfig.Principal{
+				Identifier: &rbacconfig.Principal_RemoteIp{RemoteIp: cidrRange},
+			})
+		} else {
+			principals = append(principals, &rbacconfig.Principal{
+				Identifier: &rbacconfig.Principal_DirectRemoteIp{DirectRemoteIp: cidrRange},
+			})
+		}
+	}
+	return principals, nil
+}
+
+// buildXdsCIDRRange returns the xDS CIDR range of the CIDR.
+func buildXdsCIDRRange(cidr string) (*core.CidrRange, error) {
+	_, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid CIDR %s: %w", cidr, err)
+	}
+	prefixLen, _ := ipNet.Mask.Size()
+	return &core.CidrRange{
+		AddressPrefix: ipNet.IP.String(),
+		PrefixLen:     wrapperspb.UInt32(uint32(prefixLen)),
+	}, nil
+}
@@ -0,0 +1,178 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	"fmt"
+	"time"
+
+	cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	jwtauthn "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/jwt_authn/v3"
+	hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+const (
+	// jwtAuthnFilter is the name of the Envoy jwt_authn HTTP filter.
+	jwtAuthnFilter = "envoy.filters.http.jwt_authn"
+	// remoteJWKSTimeout is the timeout of the requests fetching a remote JWKS.
+	remoteJWKSTimeout = 5 * time.Second
+	// remoteJWKSCacheDuration is the duration a remote JWKS is cached for.
+	remoteJWKSCacheDuration = 5 * time.Minute
+)
+
+// jwtFilterName returns the name of the jwt_authn filter of the JWT providers.
+// Each set of providers gets its own filter, whose requirement is only enabled
+// on the routes it protects.
+func jwtFilterName(jwt *ir.JWT) string {
+	return fmt.Sprintf("%s/%s", jwtAuthnFilter, jwt.Name)
+}
+
+// jwtFilters are the jwt_authn filters of the JWT authentications. Rather than
+// being disabled, they only verify JWTs on the routes enabling them, see
+// patchRouteWithJWT.
+var jwtFilters = &routeFilters[ir.JWT]{
+	settings:     func(irRoute *ir.HTTPRoute) *ir.JWT { return irRoute.JWT },
+	name:         func(jwt *ir.JWT) string { return jwt.Name },
+	filterNames:  func(jwt *ir.JWT) []string { return []string{jwtFilterName(jwt)} },
+	buildFilters: buildSingleFilter(buildHCMJWTFilter),
+}
+
+// jwtProviderName returns the name of the provider within its jwt_authn filter,
+// which is also the name of the cluster of its remote JWKS.
+func jwtProviderName(jwt *ir.JWT, provider *ir.JWTProvider) string {
+	return fmt.Sprintf("%s/%s", jwt.Name, provider.Name)
+}
+
+// buildHCMJWTFilter returns the jwt_authn filter verifying the JWTs of the
+// providers. Its single requirement, named after the JWT authentication, is
+// satisfied by a JWT verified by any of the providers.
+func buildHCMJWTFilter(jwt *ir.JWT) (*hcm.HttpFilter, error) {
+	config := &jwtauthn.JwtAuthentication{
+		Providers:      map[string]*jwtauthn.JwtProvider{},
+		RequirementMap: map[string]*jwtauthn.JwtRequirement{},
+	}
+
+	var requirements []*jwtauthn.JwtRequirement
+	for _, provider := range jwt.Providers {
+		name := jwtProviderName(jwt, provider)
+		config.Providers[name] = buildXdsJWTProvider(name, provider)
+		requirements = append(requirements, &jwtauthn.JwtRequirement{
+			RequiresType: &jwtauthn.JwtRequirement_ProviderName{ProviderName: name},
+		})
+	}
+	if len(requirements) == 1 {
+		config.RequirementMap[jwt.Name] = requirements[0]
+	} else {
+		config.RequirementMap[jwt.Name] = &jwtauthn.JwtRequirement{
+			RequiresType: &jwtauthn.JwtRequirement_RequiresAny{
+				RequiresAny: &jwtauthn.JwtRequirementOrList{Requirements: requirements},
+			},
+		}
+	}
+
+	configAny, err := anypb.New(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hcm.HttpFilter{
+		Name:       jwtFilterName(jwt),
+		ConfigType: &hcm.HttpFilter_TypedConfig{TypedConfig: configAny},
+	}, nil
+}
+
+// buildXdsJWTProvider returns the jwt_authn provider verifying the JWTs of the
+// IR provider.
+func buildXdsJWTProvider(name string, provider *ir.JWTProvider) *jwtauthn.JwtProvider {
+	xdsProvider := &jwtauthn.JwtProvider{
+		Issuer:     provider.Issuer,
+		Audiences:  provider.Audiences,
+		Forward:    provider.ForwardToken,
+		FromParams: provider.FromParams,
+	}
+
+	if provider.RemoteJWKS != nil {
+		xdsProvider.JwksSourceSpecifier = &jwtauthn.JwtProvider_RemoteJwks{
+			RemoteJwks: &jwtauthn.RemoteJwks{
+				HttpUri: &core.HttpUri{
+					Uri: provider.RemoteJWKS.URI,
+					HttpUpstreamType: &core.HttpUri_Cluster{
+						Cluster: name,
+					},
+					Timeout: durationpb.New(remoteJWKSTimeout),
+				},
+				CacheDuration: durationpb.New(remoteJWKSCacheDuration),
+			},
+		}
+	} else {
+		xdsProvider.JwksSourceSpecifier = &jwtauthn.JwtProvider_LocalJwks{
+			LocalJwks: &core.DataSource{
+				Specifier: &core.DataSource_InlineString{InlineString: provider.LocalJWKS},
+			},
+		}
+	}
+
+	for _, claimToHeader := range provider.ClaimToHeaders {
+		xdsProvider.ClaimToHeaders = append(xdsProvider.ClaimToHeaders, &jwtauthn.JwtClaimToHeader{
+			HeaderName: claimToHeader.Header,
+			ClaimName:  claimToHeader.Claim,
+		})
+	}
+	for _, header := range provider.FromHeaders {
+		xdsProvider.FromHeaders = append(xdsProvider.FromHeaders, &jwtauthn.JwtHeader{
+			Name:        header.Name,
+			ValuePrefix: header.ValuePrefix,
+		})
+	}
+	if provider.ClockSkew != nil {
+		xdsProvider.ClockSkewSeconds = uint32(provider.ClockSkew.Duration / time.Second)
+	}
+
+	return xdsProvider
+}
+
+// patchRouteWithJWT enables on the route the requirement of its JWT
+// authentication. The jwt_authn filters verify no JWTs on the other routes,
+// since they have no rules of their own.
+func patchRouteWithJWT(xdsRoute *route.Route, irRoute *ir.HTTPRoute) error {
+	if irRoute.JWT == nil {
+		return nil
+	}
+	configAny, err := anypb.New(&jwtauthn.PerRouteConfig{
+		RequirementSpecifier: &jwtauthn.PerRouteConfig_RequirementName{RequirementName: irRoute.JWT.Name},
+	})
+	if err != nil {
+		return err
+	}
+	if xdsRoute.TypedPerFilterConfig == nil {
+		xdsRoute.TypedPerFilterConfig = map[string]*anypb.Any{}
+	}
+	xdsRoute.TypedPerFilterConfig[jwtFilterName(irRoute.JWT)] = configAny
+	return nil
+}
+
+// buildXdsJWTClusters returns the clusters of the remote JWKS endpoints of the
+// JWT providers.
+func buildXdsJWTClusters(jwt *ir.JWT) ([]*cluster.Cluster, error) {
+	var xdsClusters []*cluster.Cluster
+	for _, provider := range jwt.Providers {
+		if provider.RemoteJWKS == nil {
+			continue
+		}
+		xdsCluster, err := buildXdsURLCluster(jwtProviderName(jwt, provider), provider.RemoteJWKS.URI)
+		if err != nil {
+			return nil, err
+		}
+		xdsClusters = append(xdsClusters, xdsCluster)
+	}
+	return xdsClusters, nil
+}
@@ -0,0 +1,33 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+// TestBuildXdsJWTProviderClaimToHeaders checks the claims of the provider are
+// forwarded in the headers, in order.
+func TestBuildXdsJWTProviderClaimToHeaders(t *testing.T) {
+	provider := buildXdsJWTProvider("provider", &ir.JWTProvider{
+		Name:      "provider",
+		LocalJWKS: `{"keys":[]}`,
+		ClaimToHeaders: []ir.ClaimToHeader{
+			{Header: "x-user-email", Claim: "email"},
+			{Header: "x-user-country", Claim: "address.country"},
+		},
+	})
+
+	require.Len(t, provider.ClaimToHeaders, 2)
+	require.Equal(t, "x-user-email", provider.ClaimToHeaders[0].HeaderName)
+	require.Equal(t, "email", provider.ClaimToHeaders[0].ClaimName)
+	require.Equal(t, "x-user-country", provider.ClaimToHeaders[1].HeaderName)
+	require.Equal(t, "address.country", provider.ClaimToHeaders[1].ClaimName)
+}
@@ -7,35 +7,41 @@ package translator
 
 import (
 	"errors"
+	"fmt"
 
 	xdscore "github.com/cncf/xds/go/xds/core/v3"
 	matcher "github.com/cncf/xds/go/xds/type/matcher/v3"
-	accesslog "github.com/envoyproxy/go-control-plane/envoy/config/accesslog/v3"
 	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	router "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/router/v3"
+	proxy_protocol "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/listener/proxy_protocol/v3"
 	tls_inspector "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/listener/tls_inspector/v3"
 	hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
 	tcp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
 	udp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/udp/udp_proxy/v3"
+	preserve_case "github.com/envoyproxy/go-control-plane/envoy/extensions/http/header_formatters/preserve_case/v3"
+	custom_header "github.com/envoyproxy/go-control-plane/envoy/extensions/http/original_ip_detection/custom_header/v3"
+	xff "github.com/envoyproxy/go-control-plane/envoy/extensions/http/original_ip_detection/xff/v3"
+	quic "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/quic/v3"
 	tls "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	matcherv3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
 	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"github.com/envoyproxy/gateway/internal/ir"
 )
 
-func buildXdsTCPListener(name, address string, port uint32) *listener.Listener {
-	accesslogAny, _ := anypb.New(stdoutFileAccessLog)
+func buildXdsTCPListener(name, address string, port uint32, accessLog *ir.AccessLog) (*listener.Listener, error) {
+	accessLogs, err := buildXdsListenerAccessLogs(accessLog)
+	if err != nil {
+		return nil, err
+	}
 	return &listener.Listener{
-		Name: name,
-		AccessLog: []*accesslog.AccessLog{
-			{
-				Name:       wellknown.FileAccessLog,
-				ConfigType: &accesslog.AccessLog_TypedConfig{TypedConfig: accesslogAny},
-				Filter:     listenerAccessLogFilter,
-			},
-		},
+		Name:      name,
+		AccessLog: accessLogs,
 		Address: &core.Address{
 			Address: &core.Address_SocketAddress{
 				SocketAddress: &core.SocketAddress{
@@ -47,34 +53,137 @@ func buildXdsTCPListener(name, address string, port uint32) *listener.Listener {
 				},
 			},
 		},
+	}, nil
+}
+
+// buildXdsQuicListener returns the UDP listener terminating the QUIC connections
+// of the HTTP/3 clients.
+func buildXdsQuicListener(name, address string, port uint32, accessLog *ir.AccessLog) (*listener.Listener, error) {
+	xdsListener, err := buildXdsTCPListener(name+"-quic", address, port, accessLog)
+	if err != nil {
+		return nil, err
+	}
+	xdsListener.Address.GetSocketAddress().Protocol = core.SocketAddress_UDP
+	xdsListener.UdpListenerConfig = &listener.UdpListenerConfig{
+		QuicOptions: &listener.QuicProtocolOptions{},
+		DownstreamSocketConfig: &core.UdpSocketConfig{
+			PreferGro: wrapperspb.Bool(true),
+		},
 	}
+	return xdsListener, nil
 }
 
-func addXdsHTTPFilterChain(xdsListener *listener.Listener, irListener *ir.HTTPListener) error {
-	routerAny, err := anypb.New(&router.Router{})
+// buildXdsAltSvcHeader returns the alt-svc response header advertising the
+// HTTP/3 endpoint of the listener to the clients, which remember it for a day.
+func buildXdsAltSvcHeader(irListener *ir.HTTPListener) *core.HeaderValueOption {
+	port := irListener.HTTP3.AdvertisedPort
+	if port == 0 {
+		port = irListener.Port
+	}
+	return &core.HeaderValueOption{
+		Header: &core.HeaderValue{
+			Key:   "alt-svc",
+			Value: fmt.Sprintf(`h3=":%d"; ma=86400`, port),
+		},
+		Append: &wrapperspb.BoolValue{Value: false},
+	}
+}
+
+// addXdsHTTPFilterChain adds the filter chain of the HTTP listener to the xDS
+// listener. The filter chain serves HTTP/3 when http3 is set, the xDS listener
+// must then be a QUIC listener. The telemetry and the rate limit service of
+// the connection manager are the ones of the xDS IR.
+//
+// When the client IP detection trusts only some proxies, the connections of
+// these proxies are matched by a second filter chain detecting the client IP
+// address, while the other connections use their peer address.
+func addXdsHTTPFilterChain(xdsListener *listener.Listener, irListener *ir.HTTPListener, xdsIR *ir.Xds, http3 bool) error {
+	clientIPDetection := irListener.ClientIPDetection
+	var trustedFilterChain *listener.FilterChain
+	if clientIPDetection != nil && len(clientIPDetection.TrustedCIDRs) > 0 {
+		var err error
+		trustedFilterChain, err = buildXdsHTTPFilterChain(irListener, xdsIR, clientIPDetection, http3)
+		if err != nil {
+			return err
+		}
+		trustedFilterChain.Name = trustedProxiesFilterChainName(irListener.Name)
+		clientIPDetection = nil
+	}
+	filterChain, err := buildXdsHTTPFilterChain(irListener, xdsIR, clientIPDetection, http3)
 	if err != nil {
 		return err
 	}
 
-	accesslogAny, err := anypb.New(stdoutFileAccessLog)
+	if http3 {
+		// QUIC listeners read the server name from the handshake, they do not
+		// need a TLS inspector.
+		if len(irListener.Hostnames) > 0 && irListener.Hostnames[0] != "*" {
+			filterChain.FilterChainMatch = &listener.FilterChainMatch{
+				ServerNames: irListener.Hostnames,
+			}
+		}
+		xdsListener.FilterChains = append(xdsListener.FilterChains, filterChain)
+	} else if irListener.TLS != nil {
+		if err := addServerNamesMatch(xdsListener, filterChain, irListener.Hostnames); err != nil {
+			return err
+		}
+		xdsListener.FilterChains = append(xdsListener.FilterChains, filterChain)
+	} else {
+		// Add the HTTP filter chain as the default filter chain
+		// Make sure one does not exist
+		if xdsListener.DefaultFilterChain != nil {
+			return errors.New("default filter chain already exists")
+		}
+		xdsListener.DefaultFilterChain = filterChain
+	}
+
+	if trustedFilterChain == nil {
+		return nil
+	}
+	// Envoy matches the source addresses after the server names, the trusted
+	// filter chain is thus only preferred for the same server names.
+	trustedFilterChain.FilterChainMatch = &listener.FilterChainMatch{}
+	if filterChain.FilterChainMatch != nil {
+		trustedFilterChain.FilterChainMatch.ServerNames = filterChain.FilterChainMatch.ServerNames
+	}
+	for _, cidr := range irListener.ClientIPDetection.TrustedCIDRs {
+		cidrRange, err := buildXdsCIDRRange(cidr)
+		if err != nil {
+			return err
+		}
+		trustedFilterChain.FilterChainMatch.SourcePrefixRanges = append(trustedFilterChain.FilterChainMatch.SourcePrefixRanges, cidrRange)
+	}
+	xdsListener.FilterChains = append(xdsListener.FilterChains, trustedFilterChain)
+
+	return nil
+}
+
+// buildXdsHTTPFilterChain returns the filter chain serving the HTTP listener,
+// without any filter chain match, which detects the client IP address as set
+// by clientIPDetection.
+func buildXdsHTTPFilterChain(irListener *ir.HTTPListener, xdsIR *ir.Xds, clientIPDetection *ir.ClientIPDetectionSettings, http3 bool) (*listener.FilterChain, error) {
+	routerAny, err := anypb.New(&router.Router{})
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	accessLogs, err := buildXdsAccessLogs(xdsIR.AccessLog, httpAccessLog)
+	if err != nil {
+		return nil, err
 	}
 
 	// HTTP filter configuration
 	var statPrefix string
-	if irListener.TLS != nil {
+	switch {
+	case http3:
+		statPrefix = "http3"
+	case irListener.TLS != nil:
 		statPrefix = "https"
-	} else {
+	default:
 		statPrefix = "http"
 	}
 	mgr := &hcm.HttpConnectionManager{
-		AccessLog: []*accesslog.AccessLog{
-			{
-				Name:       wellknown.FileAccessLog,
-				ConfigType: &accesslog.AccessLog_TypedConfig{TypedConfig: accesslogAny},
-			},
-		},
+		AccessLog:  accessLogs,
 		CodecType:  hcm.HttpConnectionManager_AUTO,
 		StatPrefix: statPrefix,
 		RouteSpecifier: &hcm.HttpConnectionManager_Rds{
@@ -90,10 +199,40 @@ func addXdsHTTPFilterChain(xdsListener *listener.Listener, irListener *ir.HTTPLi
 			ConfigType: &hcm.HttpFilter_TypedConfig{TypedConfig: routerAny},
 		}},
 	}
+	// The details of the verified client certificates replace the ones the
+	// clients may have sent, which are otherwise removed.
+	if irListener.TLS != nil && irListener.TLS.ClientValidation != nil && irListener.TLS.ClientValidation.ForwardClientCert {
+		mgr.ForwardClientCertDetails = hcm.HttpConnectionManager_SANITIZE_SET
+		mgr.SetCurrentClientCertDetails = &hcm.HttpConnectionManager_SetCurrentClientCertDetails{
+			Subject: wrapperspb.Bool(true),
+			Uri:     true,
+			Dns:     true,
+		}
+	}
+	if err := patchHCMWithClientIPDetection(mgr, clientIPDetection); err != nil {
+		return nil, err
+	}
+	if err := patchHCMWithHTTPSettings(mgr, irListener, http3); err != nil {
+		return nil, err
+	}
+	if err := patchHCMWithLocalReplies(mgr, irListener); err != nil {
+		return nil, err
+	}
+	if err := patchHCMWithTracing(mgr, xdsIR.Tracing); err != nil {
+		return nil, err
+	}
+	patchHCMWithRequestID(mgr, xdsIR.RequestID)
+	if http3 {
+		mgr.CodecType = hcm.HttpConnectionManager_HTTP3
+		mgr.Http3ProtocolOptions = &core.Http3ProtocolOptions{}
+	}
+	if err := patchHCMWithFilters(mgr, irListener, xdsIR.RateLimitService); err != nil {
+		return nil, err
+	}
 
 	mgrAny, err := anypb.New(mgr)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	filterChain := &listener.FilterChain{
@@ -105,29 +244,162 @@ func addXdsHTTPFilterChain(xdsListener *listener.Listener, irListener *ir.HTTPLi
 		}},
 	}
 
-	if irListener.TLS != nil {
+	if http3 {
+		tSocket, err := buildXdsDownstreamQuicSocket(irListener.Name, irListener.TLS)
+		if err != nil {
+			return nil, err
+		}
+		filterChain.TransportSocket = tSocket
+	} else if irListener.TLS != nil {
 		tSocket, err := buildXdsDownstreamTLSSocket(irListener.Name, irListener.TLS)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		filterChain.TransportSocket = tSocket
-		if err := addServerNamesMatch(xdsListener, filterChain, irListener.Hostnames); err != nil {
-			return err
+	}
+
+	return filterChain, nil
+}
+
+// trustedProxiesFilterChainName returns the name of the filter chain matching
+// the connections of the trusted proxies of the HTTP listener.
+func trustedProxiesFilterChainName(listenerName string) string {
+	return listenerName + "-trusted-proxies"
+}
+
+// findXdsFilterChain finds the filter chain with the name among the filter chains
+// of the xDS listener and returns nil if not found.
+func findXdsFilterChain(xdsListener *listener.Listener, name string) *listener.FilterChain {
+	for _, filterChain := range xdsListener.GetFilterChains() {
+		if filterChain.Name == name {
+			return filterChain
 		}
+	}
+	return nil
+}
 
-		xdsListener.FilterChains = append(xdsListener.FilterChains, filterChain)
-	} else {
-		// Add the HTTP filter chain as the default filter chain
-		// Make sure one does not exist
-		if xdsListener.DefaultFilterChain != nil {
-			return errors.New("default filter chain already exists")
+// patchHCMWithClientIPDetection configures how the connection manager determines
+// the client IP address. Without any trusted proxy, the peer address of the
+// downstream connection is used, so that the clients cannot choose their address
+// with the X-Forwarded-For header, e.g. to evade the rate limits.
+func patchHCMWithClientIPDetection(mgr *hcm.HttpConnectionManager, clientIPDetection *ir.ClientIPDetectionSettings) error {
+	if clientIPDetection == nil {
+		mgr.UseRemoteAddress = wrapperspb.Bool(true)
+		return nil
+	}
+
+	// The original IP detection extensions cannot be used along with the
+	// use_remote_address and xff_num_trusted_hops fields, the XFF extension
+	// is then the fallback of the custom header one.
+	if clientIPDetection.CustomHeader == nil {
+		mgr.UseRemoteAddress = wrapperspb.Bool(true)
+		mgr.XffNumTrustedHops = clientIPDetection.XForwardedForNumTrustedHops
+		return nil
+	}
+
+	customHeader := &custom_header.CustomHeaderConfig{
+		HeaderName: clientIPDetection.CustomHeader.Name,
+	}
+	if clientIPDetection.CustomHeader.FailClosed {
+		customHeader.RejectWithStatus = &typev3.HttpStatus{Code: typev3.StatusCode_Forbidden}
+	}
+	customHeaderAny, err := anypb.New(customHeader)
+	if err != nil {
+		return err
+	}
+	xffAny, err := anypb.New(&xff.XffConfig{
+		XffNumTrustedHops: clientIPDetection.XForwardedForNumTrustedHops,
+	})
+	if err != nil {
+		return err
+	}
+	mgr.OriginalIpDetectionExtensions = []*core.TypedExtensionConfig{
+		{
+			Name:        "envoy.extensions.http.original_ip_detection.custom_header",
+			TypedConfig: customHeaderAny,
+		},
+		{
+			Name:        "envoy.extensions.http.original_ip_detection.xff",
+			TypedConfig: xffAny,
+		},
+	}
+
+	return nil
+}
+
+// patchHCMWithHTTPSettings applies the timeouts, header, path and HTTP/1 settings
+// of the listener to the connection manager. The HTTP/1 settings are ignored when
+// serving HTTP/3.
+func patchHCMWithHTTPSettings(mgr *hcm.HttpConnectionManager, irListener *ir.HTTPListener, http3 bool) error {
+	if timeout := irListener.Timeout; timeout != nil {
+		if timeout.Request != nil {
+			mgr.RequestTimeout = durationpb.New(timeout.Request.Duration)
 		}
-		xdsListener.DefaultFilterChain = filterChain
+		if timeout.StreamIdle != nil {
+			mgr.StreamIdleTimeout = durationpb.New(timeout.StreamIdle.Duration)
+		}
+		if timeout.Idle != nil {
+			mgr.CommonHttpProtocolOptions = &core.HttpProtocolOptions{
+				IdleTimeout: durationpb.New(timeout.Idle.Duration),
+			}
+		}
+	}
+
+	if headers := irListener.Headers; headers != nil {
+		if headers.MaxRequestHeadersKiB != nil {
+			mgr.MaxRequestHeadersKb = wrapperspb.UInt32(*headers.MaxRequestHeadersKiB)
+		}
+		switch headers.ServerHeaderTransformation {
+		case ir.ServerHeaderAppendIfAbsent:
+			mgr.ServerHeaderTransformation = hcm.HttpConnectionManager_APPEND_IF_ABSENT
+		case ir.ServerHeaderPassThrough:
+			mgr.ServerHeaderTransformation = hcm.HttpConnectionManager_PASS_THROUGH
+		}
+	}
+
+	if path := irListener.Path; path != nil {
+		if path.Normalize {
+			mgr.NormalizePath = wrapperspb.Bool(true)
+		}
+		mgr.MergeSlashes = path.MergeSlashes
+	}
+
+	if http1 := irListener.HTTP1; http1 != nil && !http3 {
+		options := &core.Http1ProtocolOptions{
+			AcceptHttp_10:         http1.EnableHTTP10,
+			DefaultHostForHttp_10: http1.DefaultHostForHTTP10,
+		}
+		if http1.PreserveHeaderCase {
+			headerKeyFormat, err := buildXdsPreserveCaseHeaderKeyFormat()
+			if err != nil {
+				return err
+			}
+			options.HeaderKeyFormat = headerKeyFormat
+		}
+		mgr.HttpProtocolOptions = options
 	}
 
 	return nil
 }
 
+// buildXdsPreserveCaseHeaderKeyFormat returns the HTTP/1 header key format keeping
+// the case of the header names. It must be set on both the connection manager and
+// the clusters, to respectively record and restore the case of the headers.
+func buildXdsPreserveCaseHeaderKeyFormat() (*core.Http1ProtocolOptions_HeaderKeyFormat, error) {
+	preserveCaseAny, err := anypb.New(&preserve_case.PreserveCaseFormatterConfig{})
+	if err != nil {
+		return nil, err
+	}
+	return &core.Http1ProtocolOptions_HeaderKeyFormat{
+		HeaderFormat: &core.Http1ProtocolOptions_HeaderKeyFormat_StatefulFormatter{
+			StatefulFormatter: &core.TypedExtensionConfig{
+				Name:        "envoy.http.stateful_header_formatters.preserve_case",
+				TypedConfig: preserveCaseAny,
+			},
+		},
+	}, nil
+}
+
 func addServerNamesMatch(xdsListener *listener.Listener, filterChain *listener.FilterChain, hostnames []string) error {
 	// Dont add a filter chain match if the hostname is a wildcard character.
 	if len(hostnames) > 0 && hostnames[0] != "*" {
@@ -167,7 +439,7 @@ func findXdsHTTPRouteConfigName(xdsListener *listener.Listener) string {
 	return ""
 }
 
-func addXdsTCPFilterChain(xdsListener *listener.Listener, irListener *ir.TCPListener, clusterName string) error {
+func addXdsTCPFilterChain(xdsListener *listener.Listener, irListener *ir.TCPListener, clusterName string, accessLog *ir.AccessLog) error {
 	if irListener == nil {
 		return errors.New("tcp listener is nil")
 	}
@@ -177,23 +449,23 @@ func addXdsTCPFilterChain(xdsListener *listener.Listener, irListener *ir.TCPList
 		statPrefix = "passthrough"
 	}
 
-	accesslogAny, err := anypb.New(stdoutFileAccessLog)
+	accessLogs, err := buildXdsAccessLogs(accessLog, tcpAccessLog)
 	if err != nil {
 		return err
 	}
 
 	mgr := &tcp.TcpProxy{
-		AccessLog: []*accesslog.AccessLog{
-			{
-				Name:       wellknown.FileAccessLog,
-				ConfigType: &accesslog.AccessLog_TypedConfig{TypedConfig: accesslogAny},
-			},
-		},
+		AccessLog:  accessLogs,
 		StatPrefix: statPrefix,
 		ClusterSpecifier: &tcp.TcpProxy_Cluster{
 			Cluster: clusterName,
 		},
 	}
+	if hasSourceIPHash(irListener.LoadBalancer) {
+		mgr.HashPolicy = []*typev3.HashPolicy{{
+			PolicySpecifier: &typev3.HashPolicy_SourceIp_{SourceIp: &typev3.HashPolicy_SourceIp{}},
+		}}
+	}
 	mgrAny, err := anypb.New(mgr)
 	if err != nil {
 		return err
@@ -208,6 +480,15 @@ func addXdsTCPFilterChain(xdsListener *listener.Listener, irListener *ir.TCPList
 		}},
 	}
 
+	// The connections of denied clients are closed before being proxied.
+	if irListener.IPFilter != nil {
+		rbacFilter, err := buildXdsIPFilterNetworkFilter(irListener.IPFilter)
+		if err != nil {
+			return err
+		}
+		filterChain.Filters = append([]*listener.Filter{rbacFilter}, filterChain.Filters...)
+	}
+
 	if irListener.TLS != nil {
 		if err := addServerNamesMatch(xdsListener, filterChain, irListener.TLS.SNIs); err != nil {
 			return err
@@ -246,20 +527,38 @@ func addXdsTLSInspectorFilter(xdsListener *listener.Listener) error {
 	return nil
 }
 
-func buildXdsDownstreamTLSSocket(listenerName string,
-	tlsConfig *ir.TLSListenerConfig) (*core.TransportSocket, error) {
-	tlsCtx := &tls.DownstreamTlsContext{
-		CommonTlsContext: &tls.CommonTlsContext{
-			TlsCertificateSdsSecretConfigs: []*tls.SdsSecretConfig{{
-				// Generate key name for this listener. The actual key will be
-				// delivered to Envoy via SDS.
-				Name:      listenerName,
-				SdsConfig: makeConfigSource(),
-			}},
+// addXdsProxyProtocolFilter adds a Proxy Protocol filter if it does not yet exist.
+// The filter is inserted first, as the PROXY protocol header precedes the data
+// inspected by the other listener filters, e.g. the TLS ClientHello.
+func addXdsProxyProtocolFilter(xdsListener *listener.Listener) error {
+	// Return early if it exists
+	for _, filter := range xdsListener.ListenerFilters {
+		if filter.Name == wellknown.ProxyProtocol {
+			return nil
+		}
+	}
+
+	proxyProtocol := &proxy_protocol.ProxyProtocol{}
+	proxyProtocolAny, err := anypb.New(proxyProtocol)
+	if err != nil {
+		return err
+	}
+
+	filter := &listener.ListenerFilter{
+		Name: wellknown.ProxyProtocol,
+		ConfigType: &listener.ListenerFilter_TypedConfig{
+			TypedConfig: proxyProtocolAny,
 		},
 	}
 
-	tlsCtxAny, err := anypb.New(tlsCtx)
+	xdsListener.ListenerFilters = append([]*listener.ListenerFilter{filter}, xdsListener.ListenerFilters...)
+
+	return nil
+}
+
+func buildXdsDownstreamTLSSocket(listenerName string,
+	tlsConfig *ir.TLSListenerConfig) (*core.TransportSocket, error) {
+	tlsCtxAny, err := anypb.New(buildXdsDownstreamTLSContext(listenerName, tlsConfig))
 	if err != nil {
 		return nil, err
 	}
@@ -272,25 +571,164 @@ func buildXdsDownstreamTLSSocket(listenerName string,
 	}, nil
 }
 
-func buildXdsDownstreamTLSSecret(listenerName string,
-	tlsConfig *ir.TLSListenerConfig) (*tls.Secret, error) {
-	// Build the tls secret
-	return &tls.Secret{
-		Name: listenerName,
-		Type: &tls.Secret_TlsCertificate{
-			TlsCertificate: &tls.TlsCertificate{
-				CertificateChain: &core.DataSource{
-					Specifier: &core.DataSource_InlineBytes{InlineBytes: tlsConfig.ServerCertificate},
+// buildXdsDownstreamQuicSocket returns the transport socket of the QUIC listener,
+// which uses the TLS configuration of the listener with the h3 protocol.
+func buildXdsDownstreamQuicSocket(listenerName string,
+	tlsConfig *ir.TLSListenerConfig) (*core.TransportSocket, error) {
+	tlsCtx := buildXdsDownstreamTLSContext(listenerName, tlsConfig)
+	tlsCtx.CommonTlsContext.AlpnProtocols = []string{"h3"}
+	quicAny, err := anypb.New(&quic.QuicDownstreamTransport{
+		DownstreamTlsContext: tlsCtx,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.TransportSocket{
+		Name: wellknown.TransportSocketQuic,
+		ConfigType: &core.TransportSocket_TypedConfig{
+			TypedConfig: quicAny,
+		},
+	}, nil
+}
+
+// buildXdsDownstreamTLSContext returns the TLS context of the listener. The
+// certificates are delivered via SDS.
+func buildXdsDownstreamTLSContext(listenerName string, tlsConfig *ir.TLSListenerConfig) *tls.DownstreamTlsContext {
+	tlsCtx := &tls.DownstreamTlsContext{
+		CommonTlsContext: &tls.CommonTlsContext{
+			AlpnProtocols: tlsConfig.ALPNProtocols,
+		},
+	}
+	for i := range tlsConfig.Certificates {
+		// Generate key name for this listener. The actual key will be
+		// delivered to Envoy via SDS.
+		tlsCtx.CommonTlsContext.TlsCertificateSdsSecretConfigs = append(tlsCtx.CommonTlsContext.TlsCertificateSdsSecretConfigs,
+			&tls.SdsSecretConfig{
+				Name:      tlsCertificateSecretName(listenerName, i),
+				SdsConfig: makeConfigSource(),
+			})
+	}
+	if tlsConfig.MinVersion != nil || tlsConfig.MaxVersion != nil || len(tlsConfig.Ciphers) > 0 || len(tlsConfig.ECDHCurves) > 0 {
+		tlsCtx.CommonTlsContext.TlsParams = &tls.TlsParameters{
+			TlsMinimumProtocolVersion: buildXdsTLSVersion(tlsConfig.MinVersion),
+			TlsMaximumProtocolVersion: buildXdsTLSVersion(tlsConfig.MaxVersion),
+			CipherSuites:              tlsConfig.Ciphers,
+			EcdhCurves:                tlsConfig.ECDHCurves,
+		}
+	}
+
+	if validation := tlsConfig.ClientValidation; validation != nil {
+		tlsCtx.RequireClientCertificate = wrapperspb.Bool(!validation.Optional)
+		// The CA certificates are delivered via SDS, while the names accepted
+		// in the client certificates are part of the listener.
+		defaultValidationCtx := &tls.CertificateValidationContext{}
+		for _, san := range validation.SubjectAltNames {
+			defaultValidationCtx.MatchTypedSubjectAltNames = append(defaultValidationCtx.MatchTypedSubjectAltNames,
+				&tls.SubjectAltNameMatcher{
+					SanType: xdsSubjectAltNameTypes[san.Type],
+					Matcher: &matcherv3.StringMatcher{
+						MatchPattern: &matcherv3.StringMatcher_Exact{Exact: san.Value},
+					},
+				})
+		}
+		tlsCtx.CommonTlsContext.ValidationContextType = &tls.CommonTlsContext_CombinedValidationContext{
+			CombinedValidationContext: &tls.CommonTlsContext_CombinedCertificateValidationContext{
+				DefaultValidationContext: defaultValidationCtx,
+				ValidationContextSdsSecretConfig: &tls.SdsSecretConfig{
+					Name:      clientCASecretName(listenerName),
+					SdsConfig: makeConfigSource(),
 				},
-				PrivateKey: &core.DataSource{
-					Specifier: &core.DataSource_InlineBytes{InlineBytes: tlsConfig.PrivateKey},
+			},
+		}
+	}
+
+	return tlsCtx
+}
+
+// tlsCertificateSecretName returns the name of the secret holding the i-th
+// certificate of the listener. The first certificate is named after the listener.
+func tlsCertificateSecretName(listenerName string, i int) string {
+	if i == 0 {
+		return listenerName
+	}
+	return fmt.Sprintf("%s-%d", listenerName, i)
+}
+
+// buildXdsDownstreamTLSSecrets returns a secret for each certificate of the listener.
+func buildXdsDownstreamTLSSecrets(listenerName string,
+	tlsConfig *ir.TLSListenerConfig) []*tls.Secret {
+	var secrets []*tls.Secret
+	for i, certificate := range tlsConfig.Certificates {
+		secrets = append(secrets, &tls.Secret{
+			Name: tlsCertificateSecretName(listenerName, i),
+			Type: &tls.Secret_TlsCertificate{
+				TlsCertificate: &tls.TlsCertificate{
+					CertificateChain: &core.DataSource{
+						Specifier: &core.DataSource_InlineBytes{InlineBytes: certificate.ServerCertificate},
+					},
+					PrivateKey: &core.DataSource{
+						Specifier: &core.DataSource_InlineBytes{InlineBytes: certificate.PrivateKey},
+					},
 				},
 			},
+		})
+	}
+	return secrets
+}
+
+// xdsTLSVersions maps the IR TLS versions to the xDS ones.
+var xdsTLSVersions = map[ir.TLSVersion]tls.TlsParameters_TlsProtocol{
+	ir.TLSv10: tls.TlsParameters_TLSv1_0,
+	ir.TLSv11: tls.TlsParameters_TLSv1_1,
+	ir.TLSv12: tls.TlsParameters_TLSv1_2,
+	ir.TLSv13: tls.TlsParameters_TLSv1_3,
+}
+
+// buildXdsTLSVersion returns the xDS TLS version, or TLS_AUTO to use Envoy's
+// default when the version is nil.
+func buildXdsTLSVersion(version *ir.TLSVersion) tls.TlsParameters_TlsProtocol {
+	if version == nil {
+		return tls.TlsParameters_TLS_AUTO
+	}
+	return xdsTLSVersions[*version]
+}
+
+// xdsSubjectAltNameTypes maps the IR subject alternative name types to the xDS ones.
+var xdsSubjectAltNameTypes = map[ir.SubjectAltNameType]tls.SubjectAltNameMatcher_SanType{
+	ir.SubjectAltNameDNS:   tls.SubjectAltNameMatcher_DNS,
+	ir.SubjectAltNameURI:   tls.SubjectAltNameMatcher_URI,
+	ir.SubjectAltNameEmail: tls.SubjectAltNameMatcher_EMAIL,
+	ir.SubjectAltNameIP:    tls.SubjectAltNameMatcher_IP_ADDRESS,
+}
+
+// clientCASecretName returns the name of the secret holding the CA certificates
+// validating the client certificates of the listener.
+func clientCASecretName(listenerName string) string {
+	return listenerName + "-client-ca"
+}
+
+// buildXdsClientCASecret returns the secret holding the CA certificates and the
+// revocation list validating the client certificates of the listener.
+func buildXdsClientCASecret(listenerName string, validation *ir.TLSClientValidation) *tls.Secret {
+	validationCtx := &tls.CertificateValidationContext{
+		TrustedCa: &core.DataSource{
+			Specifier: &core.DataSource_InlineBytes{InlineBytes: validation.CACertificate},
 		},
-	}, nil
+	}
+	if len(validation.CRL) > 0 {
+		validationCtx.Crl = &core.DataSource{
+			Specifier: &core.DataSource_InlineBytes{InlineBytes: validation.CRL},
+		}
+	}
+
+	return &tls.Secret{
+		Name: clientCASecretName(listenerName),
+		Type: &tls.Secret_ValidationContext{ValidationContext: validationCtx},
+	}
 }
 
-func buildXdsUDPListener(clusterName string, udpListener *ir.UDPListener) (*listener.Listener, error) {
+func buildXdsUDPListener(clusterName string, udpListener *ir.UDPListener, accessLog *ir.AccessLog) (*listener.Listener, error) {
 	if udpListener == nil {
 		return nil, errors.New("udp listener is nil")
 	}
@@ -304,15 +742,13 @@ func buildXdsUDPListener(clusterName string, udpListener *ir.UDPListener) (*list
 	if err != nil {
 		return nil, err
 	}
-	accesslogAny, _ := anypb.New(stdoutFileAccessLog)
+	accessLogs, err := buildXdsAccessLogs(accessLog, tcpAccessLog)
+	if err != nil {
+		return nil, err
+	}
 	udpProxy := &udp.UdpProxyConfig{
 		StatPrefix: statPrefix,
-		AccessLog: []*accesslog.AccessLog{
-			{
-				Name:       wellknown.FileAccessLog,
-				ConfigType: &accesslog.AccessLog_TypedConfig{TypedConfig: accesslogAny},
-			},
-		},
+		AccessLog:  accessLogs,
 		RouteSpecifier: &udp.UdpProxyConfig_Matcher{
 			Matcher: &matcher.Matcher{
 				OnNoMatch: &matcher.Matcher_OnMatch{
@@ -325,6 +761,11 @@ func buildXdsUDPListener(clusterName string, udpListener *ir.UDPListener) (*list
 			},
 		},
 	}
+	if hasSourceIPHash(udpListener.LoadBalancer) {
+		udpProxy.HashPolicies = []*udp.UdpProxyConfig_HashPolicy{{
+			PolicySpecifier: &udp.UdpProxyConfig_HashPolicy_SourceIp{SourceIp: true},
+		}}
+	}
 	udpProxyAny, err := anypb.New(udpProxy)
 	if err != nil {
 		return nil, err
@@ -340,13 +781,8 @@ func buildXdsUDPListener(clusterName string, udpListener *ir.UDPListener) (*list
 	}
 
 	xdsListener := &listener.Listener{
-		Name: udpListener.Name,
-		AccessLog: []*accesslog.AccessLog{
-			{
-				Name:       wellknown.FileAccessLog,
-				ConfigType: &accesslog.AccessLog_TypedConfig{TypedConfig: accesslogAny},
-			},
-		},
+		Name:      udpListener.Name,
+		AccessLog: accessLogs,
 		Address: &core.Address{
 			Address: &core.Address_SocketAddress{
 				SocketAddress: &core.SocketAddress{
@@ -363,3 +799,8 @@ func buildXdsUDPListener(clusterName string, udpListener *ir.UDPListener) (*list
 
 	return xdsListener, nil
 }
+
+// hasSourceIPHash returns true if the load balancer hashes on the source IP of the client.
+func hasSourceIPHash(loadBalancer *ir.LoadBalancer) bool {
+	return loadBalancer != nil && loadBalancer.ConsistentHash != nil && loadBalancer.ConsistentHash.SourceIP
+}
@@ -0,0 +1,154 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	"fmt"
+
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	ratelimit "github.com/envoyproxy/go-control-plane/envoy/extensions/common/ratelimit/v3"
+	localratelimit "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/local_ratelimit/v3"
+	hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+const (
+	localRateLimitFilter     = "envoy.filters.http.local_ratelimit"
+	localRateLimitStatPrefix = "http_local_rate_limiter"
+	// localRateLimitDescriptorKey is the key of the descriptors generated for
+	// the requests matching the headers of a local rate limit rule.
+	localRateLimitDescriptorKey = "header_match"
+)
+
+// listenerContainsLocalRateLimit returns true if any route of the listener has a local rate limit.
+func listenerContainsLocalRateLimit(irListener *ir.HTTPListener) bool {
+	for _, route := range irListener.Routes {
+		if routeContainsLocalRateLimit(route) {
+			return true
+		}
+	}
+	return false
+}
+
+func routeContainsLocalRateLimit(irRoute *ir.HTTPRoute) bool {
+	return irRoute.RateLimit != nil && irRoute.RateLimit.Local != nil
+}
+
+// buildHCMLocalRateLimitFilter returns the local rate limit filter added to the
+// connection manager. It has no token bucket, so it only limits the routes
+// that configure one through their per filter config.
+func buildHCMLocalRateLimitFilter() (*hcm.HttpFilter, error) {
+	localRateLimitAny, err := anypb.New(&localratelimit.LocalRateLimit{
+		StatPrefix: localRateLimitStatPrefix,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &hcm.HttpFilter{
+		Name:       localRateLimitFilter,
+		ConfigType: &hcm.HttpFilter_TypedConfig{TypedConfig: localRateLimitAny},
+	}, nil
+}
+
+// patchRouteWithLocalRateLimit sets the per filter config holding the token
+// buckets of the route, and the rate limit actions generating the descriptors
+// of the requests matching the rules.
+func patchRouteWithLocalRateLimit(xdsRoute *route.Route, irRoute *ir.HTTPRoute) error {
+	if !routeContainsLocalRateLimit(irRoute) {
+		return nil
+	}
+	local := irRoute.RateLimit.Local
+
+	routeAction := xdsRoute.GetRoute()
+	if routeAction == nil && len(local.Rules) > 0 {
+		return fmt.Errorf("local rate limit rules require a route action, route %s has none", irRoute.Name)
+	}
+
+	config := &localratelimit.LocalRateLimit{
+		StatPrefix:  localRateLimitStatPrefix,
+		TokenBucket: buildXdsTokenBucket(local.Default),
+		FilterEnabled: &core.RuntimeFractionalPercent{
+			DefaultValue: &typev3.FractionalPercent{
+				Numerator:   100,
+				Denominator: typev3.FractionalPercent_HUNDRED,
+			},
+			RuntimeKey: "local_rate_limit_enabled",
+		},
+		FilterEnforced: &core.RuntimeFractionalPercent{
+			DefaultValue: &typev3.FractionalPercent{
+				Numerator:   100,
+				Denominator: typev3.FractionalPercent_HUNDRED,
+			},
+			RuntimeKey: "local_rate_limit_enforced",
+		},
+	}
+	if local.ResponseStatusCode != nil {
+		config.Status = &typev3.HttpStatus{Code: typev3.StatusCode(*local.ResponseStatusCode)}
+	}
+	if len(local.ResponseHeaders) > 0 {
+		config.ResponseHeadersToAdd = buildXdsAddedHeaders(local.ResponseHeaders)
+	}
+
+	for i, rule := range local.Rules {
+		descriptorValue := fmt.Sprintf("rule-%d", i)
+		action := &route.RateLimit_Action_HeaderValueMatch{
+			DescriptorValue: descriptorValue,
+			ExpectMatch:     &wrapperspb.BoolValue{Value: true},
+		}
+		for _, headerMatch := range rule.HeaderMatches {
+			action.Headers = append(action.Headers, &route.HeaderMatcher{
+				Name: headerMatch.Name,
+				HeaderMatchSpecifier: &route.HeaderMatcher_StringMatch{
+					StringMatch: buildXdsStringMatcher(headerMatch),
+				},
+			})
+		}
+		routeAction.RateLimits = append(routeAction.RateLimits, &route.RateLimit{
+			Actions: []*route.RateLimit_Action{{
+				ActionSpecifier: &route.RateLimit_Action_HeaderValueMatch_{HeaderValueMatch: action},
+			}},
+		})
+
+		config.Descriptors = append(config.Descriptors, &ratelimit.LocalRateLimitDescriptor{
+			Entries: []*ratelimit.RateLimitDescriptor_Entry{{
+				Key:   localRateLimitDescriptorKey,
+				Value: descriptorValue,
+			}},
+			TokenBucket: buildXdsTokenBucket(rule.Limit),
+		})
+	}
+
+	configAny, err := anypb.New(config)
+	if err != nil {
+		return err
+	}
+	if xdsRoute.TypedPerFilterConfig == nil {
+		xdsRoute.TypedPerFilterConfig = map[string]*anypb.Any{}
+	}
+	xdsRoute.TypedPerFilterConfig[localRateLimitFilter] = configAny
+
+	return nil
+}
+
+func buildXdsTokenBucket(limit ir.RateLimitValue) *typev3.TokenBucket {
+	maxTokens := limit.Requests
+	if limit.Burst != nil {
+		maxTokens = *limit.Burst
+	}
+
+	return &typev3.TokenBucket{
+		MaxTokens:     maxTokens,
+		TokensPerFill: &wrapperspb.UInt32Value{Value: limit.Requests},
+		FillInterval:  durationpb.New(limit.Unit.Duration()),
+	}
+}
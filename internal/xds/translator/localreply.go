@@ -0,0 +1,109 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	"encoding/json"
+	"fmt"
+
+	accesslog "github.com/envoyproxy/go-control-plane/envoy/config/accesslog/v3"
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+// localReplyStatusCodeRuntimeKey is the runtime key of the status codes matched
+// by the local reply mappers. The runtime is not expected to override them.
+const localReplyStatusCodeRuntimeKey = "local_reply_mapper.status_code"
+
+// patchHCMWithLocalReplies sets the local reply config of the connection manager,
+// rewriting the responses generated by Envoy with the mappers of the listener.
+func patchHCMWithLocalReplies(mgr *hcm.HttpConnectionManager, irListener *ir.HTTPListener) error {
+	if len(irListener.LocalReplies) == 0 {
+		return nil
+	}
+
+	localReplyConfig := &hcm.LocalReplyConfig{}
+	for _, irMapper := range irListener.LocalReplies {
+		mapper := &hcm.ResponseMapper{
+			Filter: buildXdsStatusCodesFilter(irMapper.StatusCodes),
+		}
+		if irMapper.StatusCode != nil {
+			mapper.StatusCode = wrapperspb.UInt32(*irMapper.StatusCode)
+		}
+		if irMapper.Body != nil {
+			bodyFormat, err := buildXdsLocalReplyBodyFormat(irMapper.Body)
+			if err != nil {
+				return err
+			}
+			mapper.BodyFormatOverride = bodyFormat
+		}
+		localReplyConfig.Mappers = append(localReplyConfig.Mappers, mapper)
+	}
+	mgr.LocalReplyConfig = localReplyConfig
+
+	return nil
+}
+
+// buildXdsStatusCodesFilter returns the filter matching the responses with any
+// of the status codes.
+func buildXdsStatusCodesFilter(statusCodes []uint32) *accesslog.AccessLogFilter {
+	var filters []*accesslog.AccessLogFilter
+	for _, statusCode := range statusCodes {
+		filters = append(filters, &accesslog.AccessLogFilter{
+			FilterSpecifier: &accesslog.AccessLogFilter_StatusCodeFilter{
+				StatusCodeFilter: &accesslog.StatusCodeFilter{
+					Comparison: &accesslog.ComparisonFilter{
+						Op: accesslog.ComparisonFilter_EQ,
+						Value: &core.RuntimeUInt32{
+							DefaultValue: statusCode,
+							RuntimeKey:   localReplyStatusCodeRuntimeKey,
+						},
+					},
+				},
+			},
+		})
+	}
+
+	// The or filter requires at least two filters.
+	if len(filters) == 1 {
+		return filters[0]
+	}
+	return &accesslog.AccessLogFilter{
+		FilterSpecifier: &accesslog.AccessLogFilter_OrFilter{
+			OrFilter: &accesslog.OrFilter{Filters: filters},
+		},
+	}
+}
+
+// buildXdsLocalReplyBodyFormat returns the format of the body of the local replies.
+func buildXdsLocalReplyBodyFormat(body *ir.LocalReplyBody) (*core.SubstitutionFormatString, error) {
+	bodyFormat := &core.SubstitutionFormatString{ContentType: body.ContentType}
+	switch body.Format {
+	case ir.LocalReplyBodyFormatText:
+		bodyFormat.Format = &core.SubstitutionFormatString_TextFormatSource{
+			TextFormatSource: &core.DataSource{
+				Specifier: &core.DataSource_InlineString{InlineString: body.Template},
+			},
+		}
+	case ir.LocalReplyBodyFormatJSON:
+		var object map[string]interface{}
+		if err := json.Unmarshal([]byte(body.Template), &object); err != nil {
+			return nil, fmt.Errorf("invalid JSON template of local reply body: %w", err)
+		}
+		jsonFormat, err := structpb.NewStruct(object)
+		if err != nil {
+			return nil, err
+		}
+		bodyFormat.Format = &core.SubstitutionFormatString_JsonFormat{JsonFormat: jsonFormat}
+	default:
+		return nil, fmt.Errorf("unsupported local reply body format %s", body.Format)
+	}
+	return bodyFormat, nil
+}
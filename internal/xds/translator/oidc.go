@@ -0,0 +1,246 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"time"
+
+	cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	endpoint "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	oauth2 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/oauth2/v3"
+	hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	tls "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	matcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+const (
+	// oauth2Filter is the name of the Envoy oauth2 HTTP filter.
+	oauth2Filter = "envoy.filters.http.oauth2"
+	// oidcTokenEndpointTimeout is the timeout of the requests to the token
+	// endpoint of an OIDC provider.
+	oidcTokenEndpointTimeout = 10 * time.Second
+	// systemTrustedCA is the bundle of the trusted CA certificates of the
+	// Envoy image, used to verify the token endpoints of the OIDC providers
+	// and the JWKS endpoints of the JWT providers.
+	systemTrustedCA = "/etc/ssl/certs/ca-certificates.crt"
+)
+
+// oidcFilterName returns the name of the oauth2 filter of the OIDC provider.
+func oidcFilterName(oidc *ir.OIDC) string {
+	return fmt.Sprintf("%s/%s", oauth2Filter, oidc.Name)
+}
+
+// oidcClusterName returns the name of the cluster of the token endpoint of the OIDC provider.
+func oidcClusterName(oidc *ir.OIDC) string {
+	return oidc.Name
+}
+
+// oidcClientSecretName returns the name of the SDS secret holding the client secret.
+func oidcClientSecretName(oidc *ir.OIDC) string {
+	return fmt.Sprintf("%s/client-secret", oidc.Name)
+}
+
+// oidcHMACSecretName returns the name of the SDS secret holding the HMAC secret.
+func oidcHMACSecretName(oidc *ir.OIDC) string {
+	return fmt.Sprintf("%s/hmac", oidc.Name)
+}
+
+// oidcFilters are the oauth2 filters of the OIDC providers. The oauth2 filter
+// has no per route config of its own, it is disabled by the generic one.
+var oidcFilters = &routeFilters[ir.OIDC]{
+	settings:       func(irRoute *ir.HTTPRoute) *ir.OIDC { return irRoute.OIDC },
+	name:           func(oidc *ir.OIDC) string { return oidc.Name },
+	filterNames:    func(oidc *ir.OIDC) []string { return []string{oidcFilterName(oidc)} },
+	buildFilters:   buildSingleFilter(buildHCMOAuth2Filter),
+	disabledConfig: &route.FilterConfig{Disabled: true},
+}
+
+// buildHCMOAuth2Filter returns the oauth2 filter logging the users in with the OIDC provider.
+func buildHCMOAuth2Filter(oidc *ir.OIDC) (*hcm.HttpFilter, error) {
+	config := &oauth2.OAuth2{
+		Config: &oauth2.OAuth2Config{
+			TokenEndpoint: &core.HttpUri{
+				Uri: oidc.TokenEndpoint,
+				HttpUpstreamType: &core.HttpUri_Cluster{
+					Cluster: oidcClusterName(oidc),
+				},
+				Timeout: durationpb.New(oidcTokenEndpointTimeout),
+			},
+			AuthorizationEndpoint: oidc.AuthorizationEndpoint,
+			Credentials: &oauth2.OAuth2Credentials{
+				ClientId: oidc.ClientID,
+				TokenSecret: &tls.SdsSecretConfig{
+					Name:      oidcClientSecretName(oidc),
+					SdsConfig: makeConfigSource(),
+				},
+				TokenFormation: &oauth2.OAuth2Credentials_HmacSecret{
+					HmacSecret: &tls.SdsSecretConfig{
+						Name:      oidcHMACSecretName(oidc),
+						SdsConfig: makeConfigSource(),
+					},
+				},
+				CookieNames: &oauth2.OAuth2Credentials_CookieNames{
+					BearerToken:  oidc.AccessTokenCookie,
+					OauthHmac:    oidc.HMACCookie,
+					OauthExpires: oidc.ExpiresCookie,
+				},
+			},
+			RedirectUri:         oidc.RedirectURL,
+			RedirectPathMatcher: buildXdsExactPathMatcher(oidc.RedirectPath),
+			SignoutPath:         buildXdsExactPathMatcher(oidc.LogoutPath),
+			ForwardBearerToken:  oidc.ForwardAccessToken,
+			AuthScopes:          oidc.Scopes,
+		},
+	}
+
+	configAny, err := anypb.New(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hcm.HttpFilter{
+		Name:       oidcFilterName(oidc),
+		ConfigType: &hcm.HttpFilter_TypedConfig{TypedConfig: configAny},
+	}, nil
+}
+
+// buildXdsExactPathMatcher returns a matcher matching exactly the path.
+func buildXdsExactPathMatcher(path string) *matcher.PathMatcher {
+	return &matcher.PathMatcher{
+		Rule: &matcher.PathMatcher_Path{
+			Path: &matcher.StringMatcher{
+				MatchPattern: &matcher.StringMatcher_Exact{Exact: path},
+			},
+		},
+	}
+}
+
+// buildXdsOIDCCluster returns the cluster of the token endpoint of the OIDC provider.
+func buildXdsOIDCCluster(oidc *ir.OIDC) (*cluster.Cluster, error) {
+	return buildXdsURLCluster(oidcClusterName(oidc), oidc.TokenEndpoint)
+}
+
+// buildXdsURLCluster returns a cluster resolving the host of the URL, verifying
+// the certificate of the host if the URL uses https.
+func buildXdsURLCluster(name, rawURL string) (*cluster.Cluster, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	host, port := u.Hostname(), u.Port()
+	if port == "" {
+		port = "80"
+		if u.Scheme == "https" {
+			port = "443"
+		}
+	}
+	portValue, err := strconv.ParseUint(port, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid port of %s: %w", rawURL, err)
+	}
+
+	xdsCluster := &cluster.Cluster{
+		Name:                 name,
+		ConnectTimeout:       durationpb.New(defaultConnectTimeout),
+		ClusterDiscoveryType: &cluster.Cluster_Type{Type: cluster.Cluster_STRICT_DNS},
+		LbPolicy:             cluster.Cluster_ROUND_ROBIN,
+		DnsLookupFamily:      cluster.Cluster_V4_ONLY,
+		LoadAssignment: &endpoint.ClusterLoadAssignment{
+			ClusterName: name,
+			Endpoints: []*endpoint.LocalityLbEndpoints{{
+				LbEndpoints: []*endpoint.LbEndpoint{{
+					HostIdentifier: &endpoint.LbEndpoint_Endpoint{
+						Endpoint: &endpoint.Endpoint{
+							Address: &core.Address{
+								Address: &core.Address_SocketAddress{
+									SocketAddress: &core.SocketAddress{
+										Protocol: core.SocketAddress_TCP,
+										Address:  host,
+										PortSpecifier: &core.SocketAddress_PortValue{
+											PortValue: uint32(portValue),
+										},
+									},
+								},
+							},
+						},
+					},
+				}},
+			}},
+		},
+	}
+
+	if u.Scheme == "https" {
+		// The certificate must be issued for the host, not only by a trusted CA.
+		// IP addresses are not valid server names, they are matched against the
+		// IP address subject alternative names instead.
+		sni, sanType := host, tls.SubjectAltNameMatcher_DNS
+		if net.ParseIP(host) != nil {
+			sni, sanType = "", tls.SubjectAltNameMatcher_IP_ADDRESS
+		}
+		tlsCtx := &tls.UpstreamTlsContext{
+			Sni: sni,
+			CommonTlsContext: &tls.CommonTlsContext{
+				ValidationContextType: &tls.CommonTlsContext_ValidationContext{
+					ValidationContext: &tls.CertificateValidationContext{
+						TrustedCa: &core.DataSource{
+							Specifier: &core.DataSource_Filename{Filename: systemTrustedCA},
+						},
+						MatchTypedSubjectAltNames: []*tls.SubjectAltNameMatcher{{
+							SanType: sanType,
+							Matcher: &matcher.StringMatcher{
+								MatchPattern: &matcher.StringMatcher_Exact{Exact: host},
+							},
+						}},
+					},
+				},
+			},
+		}
+		tlsCtxAny, err := anypb.New(tlsCtx)
+		if err != nil {
+			return nil, err
+		}
+		xdsCluster.TransportSocket = &core.TransportSocket{
+			Name:       wellknown.TransportSocketTls,
+			ConfigType: &core.TransportSocket_TypedConfig{TypedConfig: tlsCtxAny},
+		}
+	}
+
+	return xdsCluster, nil
+}
+
+// buildXdsOIDCSecrets returns the SDS secrets holding the client secret and
+// the HMAC secret of the OIDC provider.
+func buildXdsOIDCSecrets(oidc *ir.OIDC) []*tls.Secret {
+	return []*tls.Secret{
+		buildXdsGenericSecret(oidcClientSecretName(oidc), oidc.ClientSecret),
+		buildXdsGenericSecret(oidcHMACSecretName(oidc), oidc.HMACSecret),
+	}
+}
+
+// buildXdsGenericSecret returns an SDS secret holding the value.
+func buildXdsGenericSecret(name string, value []byte) *tls.Secret {
+	return &tls.Secret{
+		Name: name,
+		Type: &tls.Secret_GenericSecret{
+			GenericSecret: &tls.GenericSecret{
+				Secret: &core.DataSource{
+					Specifier: &core.DataSource_InlineBytes{InlineBytes: value},
+				},
+			},
+		},
+	}
+}
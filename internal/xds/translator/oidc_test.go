@@ -0,0 +1,75 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	"testing"
+
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	tls "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+// TestOIDCFiltersPatchRoute checks that the routes disable the oauth2 filters of
+// the other OIDC providers with the generic per route config.
+func TestOIDCFiltersPatchRoute(t *testing.T) {
+	oidcs := []*ir.OIDC{{Name: "first"}, {Name: "second"}}
+	xdsRoute := &route.Route{}
+	require.NoError(t, oidcFilters.patchRoute(xdsRoute, &ir.HTTPRoute{OIDC: oidcs[0]}, oidcs))
+	require.Len(t, xdsRoute.TypedPerFilterConfig, 1)
+
+	configAny := xdsRoute.TypedPerFilterConfig[oidcFilterName(oidcs[1])]
+	require.NotNil(t, configAny)
+	require.Equal(t, "type.googleapis.com/envoy.config.route.v3.FilterConfig", configAny.TypeUrl)
+	config := &route.FilterConfig{}
+	require.NoError(t, configAny.UnmarshalTo(config))
+	require.Nil(t, config.Config)
+	require.False(t, config.IsOptional)
+	require.True(t, config.Disabled)
+
+	xdsRoute = &route.Route{}
+	require.NoError(t, oidcFilters.patchRoute(xdsRoute, &ir.HTTPRoute{}, oidcs))
+	require.Len(t, xdsRoute.TypedPerFilterConfig, 2)
+}
+
+// TestBuildXdsURLClusterSubjectAltNames checks that the certificates of the https
+// hosts must be issued for the host, whether it is a domain name or an IP address.
+func TestBuildXdsURLClusterSubjectAltNames(t *testing.T) {
+	testCases := []struct {
+		url     string
+		sni     string
+		sanType tls.SubjectAltNameMatcher_SanType
+		san     string
+	}{
+		{url: "https://auth.example.com/token", sni: "auth.example.com", sanType: tls.SubjectAltNameMatcher_DNS, san: "auth.example.com"},
+		{url: "https://10.0.0.1:5556/token", sanType: tls.SubjectAltNameMatcher_IP_ADDRESS, san: "10.0.0.1"},
+		{url: "https://[2001:db8::1]/token", sanType: tls.SubjectAltNameMatcher_IP_ADDRESS, san: "2001:db8::1"},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.url, func(t *testing.T) {
+			xdsCluster, err := buildXdsURLCluster("test", tc.url)
+			require.NoError(t, err)
+
+			tlsCtx := &tls.UpstreamTlsContext{}
+			require.NoError(t, xdsCluster.TransportSocket.GetTypedConfig().UnmarshalTo(tlsCtx))
+			require.Equal(t, tc.sni, tlsCtx.Sni)
+			sans := tlsCtx.CommonTlsContext.GetValidationContext().MatchTypedSubjectAltNames
+			require.Len(t, sans, 1)
+			require.Equal(t, tc.sanType, sans[0].SanType)
+			require.Equal(t, tc.san, sans[0].Matcher.GetExact())
+		})
+	}
+}
+
+// TestBuildXdsURLClusterHTTP checks that the http hosts are reached without TLS.
+func TestBuildXdsURLClusterHTTP(t *testing.T) {
+	xdsCluster, err := buildXdsURLCluster("test", "http://10.0.0.1:5556/token")
+	require.NoError(t, err)
+	require.Nil(t, xdsCluster.TransportSocket)
+}
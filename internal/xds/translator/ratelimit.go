@@ -0,0 +1,200 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	"fmt"
+	"net"
+
+	cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	ratelimitconfig "github.com/envoyproxy/go-control-plane/envoy/config/ratelimit/v3"
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	ratelimitfilter "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ratelimit/v3"
+	hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+const (
+	// rateLimitClusterName is the name of the cluster of the rate limit service.
+	rateLimitClusterName = "ratelimit_cluster"
+	// globalRateLimitStage is the stage of the rate limit actions of the global
+	// rate limits, keeping them apart from the actions of the local rate limits.
+	globalRateLimitStage = 1
+)
+
+// listenerContainsGlobalRateLimit returns true if any route of the listener has a global rate limit.
+func listenerContainsGlobalRateLimit(irListener *ir.HTTPListener) bool {
+	for _, route := range irListener.Routes {
+		if routeContainsGlobalRateLimit(route) {
+			return true
+		}
+	}
+	return false
+}
+
+func routeContainsGlobalRateLimit(irRoute *ir.HTTPRoute) bool {
+	return irRoute.RateLimit != nil && irRoute.RateLimit.Global != nil
+}
+
+// buildHCMRateLimitFilter returns the rate limit filter added to the connection
+// manager, sending the descriptors of the requests to the rate limit service.
+func buildHCMRateLimitFilter(rateLimitService *ir.RateLimitService) (*hcm.HttpFilter, error) {
+	rateLimitAny, err := anypb.New(&ratelimitfilter.RateLimit{
+		Domain: rateLimitService.Domain,
+		Stage:  globalRateLimitStage,
+		// Keep serving the requests when the rate limit service is unavailable.
+		FailureModeDeny:         false,
+		EnableXRatelimitHeaders: ratelimitfilter.RateLimit_DRAFT_VERSION_03,
+		RateLimitService: &ratelimitconfig.RateLimitServiceConfig{
+			GrpcService: &core.GrpcService{
+				TargetSpecifier: &core.GrpcService_EnvoyGrpc_{
+					EnvoyGrpc: &core.GrpcService_EnvoyGrpc{ClusterName: rateLimitClusterName},
+				},
+			},
+			TransportApiVersion: core.ApiVersion_V3,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &hcm.HttpFilter{
+		Name:       wellknown.HTTPRateLimit,
+		ConfigType: &hcm.HttpFilter_TypedConfig{TypedConfig: rateLimitAny},
+	}, nil
+}
+
+// buildXdsRateLimitCluster returns the cluster of the rate limit service.
+func buildXdsRateLimitCluster(rateLimitService *ir.RateLimitService) *cluster.Cluster {
+	return buildXdsGRPCServiceCluster(rateLimitClusterName, rateLimitService.Host, rateLimitService.Port)
+}
+
+// patchRouteWithGlobalRateLimit adds a rate limit to the route for each global
+// rate limit rule. The actions of a rule generate the descriptor that the rate
+// limit service matches against the descriptors returned by
+// ir.Xds.RateLimitDescriptors.
+func patchRouteWithGlobalRateLimit(xdsRoute *route.Route, irRoute *ir.HTTPRoute) error {
+	if !routeContainsGlobalRateLimit(irRoute) {
+		return nil
+	}
+
+	routeAction := xdsRoute.GetRoute()
+	if routeAction == nil {
+		return fmt.Errorf("global rate limits require a route action, route %s has none", irRoute.Name)
+	}
+
+	for i, rule := range irRoute.RateLimit.Global.Rules {
+		actions := []*route.RateLimit_Action{{
+			ActionSpecifier: &route.RateLimit_Action_GenericKey_{
+				GenericKey: &route.RateLimit_Action_GenericKey{
+					DescriptorValue: ir.GlobalRateLimitRuleKey(irRoute.Name, i),
+				},
+			},
+		}}
+
+		if headerMatcher := buildGlobalRateLimitHeaderMatcher(rule); headerMatcher != nil {
+			actions = append(actions, &route.RateLimit_Action{
+				ActionSpecifier: &route.RateLimit_Action_HeaderValueMatch_{HeaderValueMatch: headerMatcher},
+			})
+		}
+
+		for j, headerMatch := range rule.HeaderMatches {
+			if !headerMatch.Distinct {
+				continue
+			}
+			actions = append(actions, &route.RateLimit_Action{
+				ActionSpecifier: &route.RateLimit_Action_RequestHeaders_{
+					RequestHeaders: &route.RateLimit_Action_RequestHeaders{
+						HeaderName:    headerMatch.Name,
+						DescriptorKey: ir.GlobalRateLimitHeaderKey(j),
+					},
+				},
+			})
+		}
+
+		if rule.ClientIP != nil {
+			action, err := buildGlobalRateLimitClientIPAction(rule.ClientIP)
+			if err != nil {
+				return err
+			}
+			actions = append(actions, action)
+		}
+
+		routeAction.RateLimits = append(routeAction.RateLimits, &route.RateLimit{
+			Stage:   &wrapperspb.UInt32Value{Value: globalRateLimitStage},
+			Actions: actions,
+		})
+	}
+
+	return nil
+}
+
+// buildGlobalRateLimitHeaderMatcher returns the action matching the headers and
+// path of the rule, or nil if the rule matches on neither.
+func buildGlobalRateLimitHeaderMatcher(rule *ir.GlobalRateLimitRule) *route.RateLimit_Action_HeaderValueMatch {
+	var headers []*route.HeaderMatcher
+	for _, headerMatch := range rule.HeaderMatches {
+		if headerMatch.Distinct {
+			continue
+		}
+		headers = append(headers, &route.HeaderMatcher{
+			Name: headerMatch.Name,
+			HeaderMatchSpecifier: &route.HeaderMatcher_StringMatch{
+				StringMatch: buildXdsStringMatcher(headerMatch),
+			},
+		})
+	}
+	if rule.PathMatch != nil {
+		headers = append(headers, &route.HeaderMatcher{
+			Name: ":path",
+			HeaderMatchSpecifier: &route.HeaderMatcher_StringMatch{
+				StringMatch: buildXdsStringMatcher(rule.PathMatch),
+			},
+		})
+	}
+	if len(headers) == 0 {
+		return nil
+	}
+
+	return &route.RateLimit_Action_HeaderValueMatch{
+		DescriptorValue: ir.RateLimitHeaderMatchDescriptorValue,
+		ExpectMatch:     &wrapperspb.BoolValue{Value: true},
+		Headers:         headers,
+	}
+}
+
+func buildGlobalRateLimitClientIPAction(clientIP *ir.ClientIPMatch) (*route.RateLimit_Action, error) {
+	if clientIP.CIDR == nil {
+		return &route.RateLimit_Action{
+			ActionSpecifier: &route.RateLimit_Action_RemoteAddress_{
+				RemoteAddress: &route.RateLimit_Action_RemoteAddress{},
+			},
+		}, nil
+	}
+
+	ip, ipNet, err := net.ParseCIDR(*clientIP.CIDR)
+	if err != nil {
+		return nil, err
+	}
+	// The action masks the client address with the prefix length of the CIDR,
+	// so the descriptor value only equals the CIDR for the clients within it.
+	maskLen, _ := ipNet.Mask.Size()
+	masked := &route.RateLimit_Action_MaskedRemoteAddress{}
+	if ip.To4() != nil {
+		masked.V4PrefixMaskLen = &wrapperspb.UInt32Value{Value: uint32(maskLen)}
+	} else {
+		masked.V6PrefixMaskLen = &wrapperspb.UInt32Value{Value: uint32(maskLen)}
+	}
+
+	return &route.RateLimit_Action{
+		ActionSpecifier: &route.RateLimit_Action_MaskedRemoteAddress_{MaskedRemoteAddress: masked},
+	}, nil
+}
@@ -0,0 +1,266 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	ratelimitv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/common/ratelimit/v3"
+	hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	rlsv3 "github.com/envoyproxy/go-control-plane/envoy/service/ratelimit/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+// fakeRateLimitService is a stand-in for the rate limit service, matching the
+// descriptors of the requests against the configured descriptors the same way
+// the service does, and counting the hits without expiring them.
+type fakeRateLimitService struct {
+	rlsv3.UnimplementedRateLimitServiceServer
+
+	domain      string
+	descriptors []*ir.RateLimitDescriptor
+
+	mu   sync.Mutex
+	hits map[string]uint32
+}
+
+func (s *fakeRateLimitService) ShouldRateLimit(_ context.Context, req *rlsv3.RateLimitRequest) (*rlsv3.RateLimitResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	resp := &rlsv3.RateLimitResponse{OverallCode: rlsv3.RateLimitResponse_OK}
+	if req.Domain != s.domain {
+		return resp, nil
+	}
+	for _, descriptor := range req.Descriptors {
+		limit := matchRateLimitDescriptor(s.descriptors, descriptor.Entries)
+		if limit == nil {
+			continue
+		}
+		var key []string
+		for _, entry := range descriptor.Entries {
+			key = append(key, entry.Key+"="+entry.Value)
+		}
+		counter := strings.Join(key, "/")
+		s.hits[counter]++
+		if s.hits[counter] > limit.RequestsPerUnit {
+			resp.OverallCode = rlsv3.RateLimitResponse_OVER_LIMIT
+		}
+	}
+	return resp, nil
+}
+
+// matchRateLimitDescriptor returns the rate limit of the descriptor matching
+// all the entries, preferring the descriptors with a value over the ones without.
+func matchRateLimitDescriptor(descriptors []*ir.RateLimitDescriptor, entries []*ratelimitv3.RateLimitDescriptor_Entry) *ir.RateLimitPolicy {
+	var current *ir.RateLimitDescriptor
+	for _, entry := range entries {
+		var next *ir.RateLimitDescriptor
+		for _, descriptor := range descriptors {
+			if descriptor.Key != entry.Key {
+				continue
+			}
+			if descriptor.Value == entry.Value {
+				next = descriptor
+				break
+			}
+			if descriptor.Value == "" {
+				next = descriptor
+			}
+		}
+		if next == nil {
+			return nil
+		}
+		current = next
+		descriptors = current.Descriptors
+	}
+	if current == nil {
+		return nil
+	}
+	return current.RateLimit
+}
+
+// startFakeRateLimitService starts a fake rate limit service configured with the
+// descriptors of the IR, and returns a client of the service.
+func startFakeRateLimitService(t *testing.T, xdsIR *ir.Xds) rlsv3.RateLimitServiceClient {
+	t.Helper()
+
+	lis := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	rlsv3.RegisterRateLimitServiceServer(server, &fakeRateLimitService{
+		domain:      xdsIR.RateLimitService.Domain,
+		descriptors: xdsIR.RateLimitDescriptors(),
+		hits:        map[string]uint32{},
+	})
+	go func() {
+		_ = server.Serve(lis)
+	}()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return rlsv3.NewRateLimitServiceClient(conn)
+}
+
+func TestGlobalRateLimitDescriptors(t *testing.T) {
+	xdsIR := requireXdsIRFromInputTestData(t, "xds-ir", "http-route-global-ratelimit.yaml")
+	require.Len(t, xdsIR.RateLimitDescriptors(), 4)
+	client := startFakeRateLimitService(t, xdsIR)
+
+	// The entries generated by the rate limit actions of the route for a request.
+	entry := func(key, value string) *ratelimitv3.RateLimitDescriptor_Entry {
+		return &ratelimitv3.RateLimitDescriptor_Entry{Key: key, Value: value}
+	}
+	testCases := []struct {
+		name    string
+		entries []*ratelimitv3.RateLimitDescriptor_Entry
+		allowed int
+	}{
+		{
+			name: "distinct header",
+			entries: []*ratelimitv3.RateLimitDescriptor_Entry{
+				entry(ir.RateLimitGenericKeyDescriptorKey, "second-route-rule-1"),
+				entry(ir.RateLimitHeaderMatchDescriptorKey, ir.RateLimitHeaderMatchDescriptorValue),
+				entry("header-0", "user-1"),
+			},
+			allowed: 10,
+		},
+		{
+			name: "another distinct header value",
+			entries: []*ratelimitv3.RateLimitDescriptor_Entry{
+				entry(ir.RateLimitGenericKeyDescriptorKey, "second-route-rule-1"),
+				entry(ir.RateLimitHeaderMatchDescriptorKey, ir.RateLimitHeaderMatchDescriptorValue),
+				entry("header-0", "user-2"),
+			},
+			allowed: 10,
+		},
+		{
+			name: "client ip",
+			entries: []*ratelimitv3.RateLimitDescriptor_Entry{
+				entry(ir.RateLimitGenericKeyDescriptorKey, "second-route-rule-2"),
+				entry(ir.RateLimitRemoteAddressDescriptorKey, "192.168.1.1"),
+			},
+			allowed: 5,
+		},
+		{
+			name: "client within cidr",
+			entries: []*ratelimitv3.RateLimitDescriptor_Entry{
+				entry(ir.RateLimitGenericKeyDescriptorKey, "second-route-rule-3"),
+				entry(ir.RateLimitMaskedRemoteAddressDescriptorKey, "10.0.0.0/8"),
+			},
+			allowed: 100,
+		},
+		{
+			name: "client outside cidr",
+			entries: []*ratelimitv3.RateLimitDescriptor_Entry{
+				entry(ir.RateLimitGenericKeyDescriptorKey, "second-route-rule-3"),
+				entry(ir.RateLimitMaskedRemoteAddressDescriptorKey, "192.0.0.0/8"),
+			},
+			allowed: -1,
+		},
+		{
+			name: "unknown route",
+			entries: []*ratelimitv3.RateLimitDescriptor_Entry{
+				entry(ir.RateLimitGenericKeyDescriptorKey, "first-route-rule-0"),
+			},
+			allowed: -1,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			req := &rlsv3.RateLimitRequest{
+				Domain:      xdsIR.RateLimitService.Domain,
+				Descriptors: []*ratelimitv3.RateLimitDescriptor{{Entries: tc.entries}},
+			}
+			// Requests not subject to a limit are always allowed.
+			requests := tc.allowed
+			if requests < 0 {
+				requests = 200
+			}
+			for i := 0; i < requests; i++ {
+				resp, err := client.ShouldRateLimit(context.Background(), req)
+				require.NoError(t, err)
+				require.Equal(t, rlsv3.RateLimitResponse_OK, resp.OverallCode)
+			}
+			if tc.allowed >= 0 {
+				resp, err := client.ShouldRateLimit(context.Background(), req)
+				require.NoError(t, err)
+				require.Equal(t, rlsv3.RateLimitResponse_OVER_LIMIT, resp.OverallCode)
+			}
+		})
+	}
+}
+
+// envoyClientAddress returns the client address determined by the connection
+// manager for a request received from the peer with the X-Forwarded-For header,
+// as documented for the use_remote_address and xff_num_trusted_hops fields.
+func envoyClientAddress(mgr *hcm.HttpConnectionManager, peer, xForwardedFor string) string {
+	var addresses []string
+	for _, address := range strings.Split(xForwardedFor, ",") {
+		if address = strings.TrimSpace(address); address != "" {
+			addresses = append(addresses, address)
+		}
+	}
+	if mgr.GetUseRemoteAddress().GetValue() {
+		addresses = append(addresses, peer)
+	}
+	index := len(addresses) - 1 - int(mgr.XffNumTrustedHops)
+	if index < 0 {
+		return peer
+	}
+	return addresses[index]
+}
+
+// TestGlobalRateLimitForgedXForwardedFor checks that a client cannot evade its
+// per client IP limit by forging the X-Forwarded-For header of its requests, when
+// the client IP detection is not configured.
+func TestGlobalRateLimitForgedXForwardedFor(t *testing.T) {
+	xdsIR := requireXdsIRFromInputTestData(t, "xds-ir", "http-route-global-ratelimit.yaml")
+	tCtx, err := Translate(xdsIR)
+	require.NoError(t, err)
+	xdsListener := findXdsListener(tCtx, xdsIR.HTTP[0].Address, xdsIR.HTTP[0].Port, core.SocketAddress_TCP)
+	require.NotNil(t, xdsListener)
+	mgr := &hcm.HttpConnectionManager{}
+	require.NoError(t, xdsListener.DefaultFilterChain.Filters[0].GetTypedConfig().UnmarshalTo(mgr))
+
+	client := startFakeRateLimitService(t, xdsIR)
+	shouldRateLimit := func(xForwardedFor string) rlsv3.RateLimitResponse_Code {
+		req := &rlsv3.RateLimitRequest{
+			Domain: xdsIR.RateLimitService.Domain,
+			Descriptors: []*ratelimitv3.RateLimitDescriptor{{Entries: []*ratelimitv3.RateLimitDescriptor_Entry{
+				{Key: ir.RateLimitGenericKeyDescriptorKey, Value: "second-route-rule-2"},
+				{Key: ir.RateLimitRemoteAddressDescriptorKey, Value: envoyClientAddress(mgr, "192.168.1.1", xForwardedFor)},
+			}}},
+		}
+		resp, err := client.ShouldRateLimit(context.Background(), req)
+		require.NoError(t, err)
+		return resp.OverallCode
+	}
+
+	// The rule allows 5 requests per client IP.
+	for i := 0; i < 5; i++ {
+		require.Equal(t, rlsv3.RateLimitResponse_OK, shouldRateLimit(fmt.Sprintf("203.0.113.%d", i)))
+	}
+	require.Equal(t, rlsv3.RateLimitResponse_OVER_LIMIT, shouldRateLimit("203.0.113.5"))
+}
@@ -6,14 +6,24 @@
 package translator
 
 import (
+	"strings"
+
 	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	matcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"github.com/envoyproxy/gateway/internal/ir"
 )
 
+const (
+	// defaultRetryOn retries on 5xx responses, which include connection failures and resets.
+	defaultRetryOn = "5xx"
+	// defaultNumRetries is the number of retries attempted when not specified.
+	defaultNumRetries = 2
+)
+
 func buildXdsRoute(httpRoute *ir.HTTPRoute) (*route.Route, error) {
 	ret := &route.Route{
 		Match: buildXdsRouteMatch(httpRoute.PathMatch, httpRoute.HeaderMatches, httpRoute.QueryParamMatches),
@@ -43,10 +53,17 @@ func buildXdsRoute(httpRoute *ir.HTTPRoute) (*route.Route, error) {
 			// If there are invalid backends then a weighted cluster is required for the route
 			ret.Action = &route.Route_Route{Route: buildXdsWeightedRouteAction(httpRoute)}
 		} else {
-			ret.Action = &route.Route_Route{Route: buildXdsRouteAction(httpRoute.Name)}
+			ret.Action = &route.Route_Route{Route: buildXdsRouteAction(httpRoute)}
 		}
 	}
 
+	if err := patchRouteWithLocalRateLimit(ret, httpRoute); err != nil {
+		return nil, err
+	}
+	if err := patchRouteWithGlobalRateLimit(ret, httpRoute); err != nil {
+		return nil, err
+	}
+
 	return ret, nil
 }
 
@@ -150,12 +167,16 @@ func buildXdsStringMatcher(irMatch *ir.StringMatch) *matcher.StringMatcher {
 	return stringMatcher
 }
 
-func buildXdsRouteAction(routeName string) *route.RouteAction {
-	return &route.RouteAction{
+func buildXdsRouteAction(httpRoute *ir.HTTPRoute) *route.RouteAction {
+	ret := &route.RouteAction{
 		ClusterSpecifier: &route.RouteAction_Cluster{
-			Cluster: routeName,
+			Cluster: httpRoute.Name,
 		},
 	}
+	setXdsRouteActionTimeoutAndRetry(ret, httpRoute)
+	setXdsRouteActionHashPolicy(ret, httpRoute)
+
+	return ret
 }
 
 func buildXdsWeightedRouteAction(httpRoute *ir.HTTPRoute) *route.RouteAction {
@@ -169,7 +190,7 @@ func buildXdsWeightedRouteAction(httpRoute *ir.HTTPRoute) *route.RouteAction {
 			Weight: &wrapperspb.UInt32Value{Value: httpRoute.BackendWeights.Valid},
 		},
 	}
-	return &route.RouteAction{
+	ret := &route.RouteAction{
 		// Intentionally route to a non-existent cluster and return a 500 error when it is not found
 		ClusterNotFoundResponseCode: route.RouteAction_INTERNAL_SERVER_ERROR,
 		ClusterSpecifier: &route.RouteAction_WeightedClusters{
@@ -178,6 +199,70 @@ func buildXdsWeightedRouteAction(httpRoute *ir.HTTPRoute) *route.RouteAction {
 			},
 		},
 	}
+	setXdsRouteActionTimeoutAndRetry(ret, httpRoute)
+	setXdsRouteActionHashPolicy(ret, httpRoute)
+
+	return ret
+}
+
+func setXdsRouteActionTimeoutAndRetry(action *route.RouteAction, httpRoute *ir.HTTPRoute) {
+	if timeout := httpRoute.Timeout; timeout != nil {
+		if timeout.Request != nil {
+			action.Timeout = durationpb.New(timeout.Request.Duration)
+		}
+		if timeout.Idle != nil {
+			action.IdleTimeout = durationpb.New(timeout.Idle.Duration)
+		}
+	}
+	if httpRoute.Retry != nil {
+		action.RetryPolicy = buildXdsRetryPolicy(httpRoute.Retry)
+	}
+}
+
+func buildXdsRetryPolicy(retry *ir.Retry) *route.RetryPolicy {
+	ret := &route.RetryPolicy{
+		RetryOn:    defaultRetryOn,
+		NumRetries: &wrapperspb.UInt32Value{Value: defaultNumRetries},
+	}
+
+	if retry.NumRetries != nil {
+		ret.NumRetries = &wrapperspb.UInt32Value{Value: *retry.NumRetries}
+	}
+
+	if retry.RetryOn != nil {
+		triggers := make([]string, 0, len(retry.RetryOn.Triggers)+1)
+		hasStatusCodesTrigger := false
+		for _, trigger := range retry.RetryOn.Triggers {
+			if trigger == ir.RetriableStatusCodes {
+				hasStatusCodesTrigger = true
+			}
+			triggers = append(triggers, string(trigger))
+		}
+		// Listing status codes implies retrying on them
+		if len(retry.RetryOn.HTTPStatusCodes) > 0 && !hasStatusCodesTrigger {
+			triggers = append(triggers, string(ir.RetriableStatusCodes))
+		}
+		if len(triggers) > 0 {
+			ret.RetryOn = strings.Join(triggers, ",")
+		}
+		ret.RetriableStatusCodes = retry.RetryOn.HTTPStatusCodes
+	}
+
+	if retry.PerRetry != nil {
+		if retry.PerRetry.Timeout != nil {
+			ret.PerTryTimeout = durationpb.New(retry.PerRetry.Timeout.Duration)
+		}
+		if backOff := retry.PerRetry.BackOff; backOff != nil && backOff.BaseInterval != nil {
+			ret.RetryBackOff = &route.RetryPolicy_RetryBackOff{
+				BaseInterval: durationpb.New(backOff.BaseInterval.Duration),
+			}
+			if backOff.MaxInterval != nil {
+				ret.RetryBackOff.MaxInterval = durationpb.New(backOff.MaxInterval.Duration)
+			}
+		}
+	}
+
+	return ret
 }
 
 func buildXdsRedirectAction(redirection *ir.Redirect) *route.RedirectAction {
@@ -248,3 +333,63 @@ func buildXdsAddedHeaders(headersToAdd []ir.AddHeader) []*core.HeaderValueOption
 
 	return ret
 }
+
+// setXdsRouteActionHashPolicy sets the hash policies used by the consistent
+// hashing load balancers of the route cluster.
+func setXdsRouteActionHashPolicy(action *route.RouteAction, httpRoute *ir.HTTPRoute) {
+	if httpRoute.LoadBalancer == nil || httpRoute.LoadBalancer.ConsistentHash == nil {
+		return
+	}
+	consistentHash := httpRoute.LoadBalancer.ConsistentHash
+
+	var ret []*route.RouteAction_HashPolicy
+
+	if consistentHash.SourceIP {
+		ret = append(ret, &route.RouteAction_HashPolicy{
+			PolicySpecifier: &route.RouteAction_HashPolicy_ConnectionProperties_{
+				ConnectionProperties: &route.RouteAction_HashPolicy_ConnectionProperties{
+					SourceIp: true,
+				},
+			},
+		})
+	}
+
+	if header := consistentHash.Header; header != nil {
+		ret = append(ret, &route.RouteAction_HashPolicy{
+			PolicySpecifier: &route.RouteAction_HashPolicy_Header_{
+				Header: &route.RouteAction_HashPolicy_Header{
+					HeaderName: header.Name,
+				},
+			},
+		})
+	}
+
+	if cookie := consistentHash.Cookie; cookie != nil {
+		hashCookie := &route.RouteAction_HashPolicy_Cookie{
+			Name: cookie.Name,
+		}
+		if cookie.TTL != nil {
+			hashCookie.Ttl = durationpb.New(cookie.TTL.Duration)
+		}
+		if cookie.Path != nil {
+			hashCookie.Path = *cookie.Path
+		}
+		ret = append(ret, &route.RouteAction_HashPolicy{
+			PolicySpecifier: &route.RouteAction_HashPolicy_Cookie_{
+				Cookie: hashCookie,
+			},
+		})
+	}
+
+	if queryParam := consistentHash.QueryParam; queryParam != nil {
+		ret = append(ret, &route.RouteAction_HashPolicy{
+			PolicySpecifier: &route.RouteAction_HashPolicy_QueryParameter_{
+				QueryParameter: &route.RouteAction_HashPolicy_QueryParameter{
+					Name: queryParam.Name,
+				},
+			},
+		})
+	}
+
+	action.HashPolicy = ret
+}
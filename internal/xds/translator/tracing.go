@@ -0,0 +1,149 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	"fmt"
+	"sort"
+
+	cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	trace "github.com/envoyproxy/go-control-plane/envoy/config/trace/v3"
+	hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	tracingtype "github.com/envoyproxy/go-control-plane/envoy/type/tracing/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+const (
+	tracingClusterName  = "tracing"
+	openTelemetryTracer = "envoy.tracers.opentelemetry"
+	// zipkinCollectorEndpoint is the path of the v2 span API of the Zipkin
+	// collectors.
+	zipkinCollectorEndpoint = "/api/v2/spans"
+)
+
+// buildXdsTracingCluster returns the cluster of the collector of the tracing
+// provider. The OpenTelemetry collectors are reached over OTLP gRPC, which
+// requires HTTP/2.
+func buildXdsTracingCluster(tracing *ir.Tracing) (*cluster.Cluster, error) {
+	return buildXdsCluster(&xdsClusterArgs{
+		name:         tracingClusterName,
+		destinations: []*ir.RouteDestination{tracing.Destination},
+		isHTTP2:      tracing.Provider == ir.TracingProviderOpenTelemetry,
+	})
+}
+
+// patchHCMWithTracing sets the tracing of the requests of the connection
+// manager, the spans are sent to the tracing cluster.
+func patchHCMWithTracing(mgr *hcm.HttpConnectionManager, tracing *ir.Tracing) error {
+	if tracing == nil {
+		return nil
+	}
+
+	name, config, err := buildXdsTracingProvider(tracing)
+	if err != nil {
+		return err
+	}
+	configAny, err := anypb.New(config)
+	if err != nil {
+		return err
+	}
+
+	mgr.Tracing = &hcm.HttpConnectionManager_Tracing{
+		RandomSampling: &typev3.Percent{Value: float64(tracing.SamplingRate)},
+		CustomTags:     buildXdsCustomTags(tracing.CustomTags),
+		Provider: &trace.Tracing_Http{
+			Name:       name,
+			ConfigType: &trace.Tracing_Http_TypedConfig{TypedConfig: configAny},
+		},
+	}
+	return nil
+}
+
+// buildXdsTracingProvider returns the name and the config of the tracer of the
+// provider.
+func buildXdsTracingProvider(tracing *ir.Tracing) (string, proto.Message, error) {
+	switch tracing.Provider {
+	case ir.TracingProviderOpenTelemetry:
+		return openTelemetryTracer, &trace.OpenTelemetryConfig{
+			GrpcService: &core.GrpcService{
+				TargetSpecifier: &core.GrpcService_EnvoyGrpc_{
+					EnvoyGrpc: &core.GrpcService_EnvoyGrpc{ClusterName: tracingClusterName},
+				},
+			},
+			ServiceName: tracing.ServiceName,
+		}, nil
+	case ir.TracingProviderZipkin:
+		return wellknown.Zipkin, &trace.ZipkinConfig{
+			CollectorCluster:         tracingClusterName,
+			CollectorEndpoint:        zipkinCollectorEndpoint,
+			CollectorEndpointVersion: trace.ZipkinConfig_HTTP_JSON,
+			TraceId_128Bit:           true,
+		}, nil
+	case ir.TracingProviderDatadog:
+		return wellknown.Datadog, &trace.DatadogConfig{
+			CollectorCluster: tracingClusterName,
+			ServiceName:      tracing.ServiceName,
+		}, nil
+	}
+	return "", nil, fmt.Errorf("unsupported tracing provider %s", tracing.Provider)
+}
+
+// buildXdsCustomTags returns the custom tags of the spans, sorted by name so
+// that the config does not change between translations.
+func buildXdsCustomTags(tags []*ir.CustomTag) []*tracingtype.CustomTag {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	xdsTags := make([]*tracingtype.CustomTag, 0, len(tags))
+	for _, tag := range tags {
+		xdsTag := &tracingtype.CustomTag{Tag: tag.Name}
+		switch {
+		case tag.Literal != nil:
+			xdsTag.Type = &tracingtype.CustomTag_Literal_{
+				Literal: &tracingtype.CustomTag_Literal{Value: *tag.Literal},
+			}
+		case tag.Environment != nil:
+			xdsTag.Type = &tracingtype.CustomTag_Environment_{
+				Environment: &tracingtype.CustomTag_Environment{
+					Name:         tag.Environment.Name,
+					DefaultValue: tag.Environment.DefaultValue,
+				},
+			}
+		case tag.RequestHeader != nil:
+			xdsTag.Type = &tracingtype.CustomTag_RequestHeader{
+				RequestHeader: &tracingtype.CustomTag_Header{
+					Name:         tag.RequestHeader.Name,
+					DefaultValue: tag.RequestHeader.DefaultValue,
+				},
+			}
+		}
+		xdsTags = append(xdsTags, xdsTag)
+	}
+	sort.Slice(xdsTags, func(i, j int) bool { return xdsTags[i].Tag < xdsTags[j].Tag })
+	return xdsTags
+}
+
+// patchHCMWithRequestID sets the handling of the x-request-id header by the
+// connection manager.
+func patchHCMWithRequestID(mgr *hcm.HttpConnectionManager, requestID *ir.RequestIDSettings) {
+	if requestID == nil {
+		return
+	}
+
+	if requestID.Disable {
+		mgr.GenerateRequestId = wrapperspb.Bool(false)
+	}
+	mgr.PreserveExternalRequestId = requestID.PreserveExternal
+	mgr.AlwaysSetRequestIdInResponse = requestID.SetInResponse
+}
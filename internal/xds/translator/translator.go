@@ -26,6 +26,16 @@ func Translate(ir *ir.Xds) (*types.ResourceVersionTable, error) {
 
 	tCtx := new(types.ResourceVersionTable)
 
+	// The filters of the route settings are shared by the routes of a connection
+	// manager, each route disables the ones of the settings it does not use.
+	extAuths := extAuthFilters.list(ir)
+	oidcs := oidcFilters.list(ir)
+	basicAuths := basicAuthFilters.list(ir)
+	compressions := compressionFilters.list(ir)
+	// The jwt_authn filters only verify JWTs on the routes enabling them, the
+	// JWT authentications are listed for the clusters of their remote JWKS.
+	jwts := jwtFilters.list(ir)
+
 	for _, httpListener := range ir.HTTP {
 		addFilterChain := true
 		var xdsRouteCfg *route.RouteConfiguration
@@ -33,7 +43,11 @@ func Translate(ir *ir.Xds) (*types.ResourceVersionTable, error) {
 		// Search for an existing listener, if it does not exist, create one.
 		xdsListener := findXdsListener(tCtx, httpListener.Address, httpListener.Port, core.SocketAddress_TCP)
 		if xdsListener == nil {
-			xdsListener = buildXdsTCPListener(httpListener.Name, httpListener.Address, httpListener.Port)
+			var err error
+			xdsListener, err = buildXdsTCPListener(httpListener.Name, httpListener.Address, httpListener.Port, ir.AccessLog)
+			if err != nil {
+				return nil, err
+			}
 			tCtx.AddXdsResource(resource.ListenerType, xdsListener)
 		} else if httpListener.TLS == nil {
 			// Find the route config associated with this listener that
@@ -48,11 +62,42 @@ func Translate(ir *ir.Xds) (*types.ResourceVersionTable, error) {
 				if xdsRouteCfg == nil {
 					return nil, errors.New("unable to find xds route config")
 				}
+				if err := patchXdsHTTPFilterChain(xdsListener.DefaultFilterChain, httpListener, ir.RateLimitService); err != nil {
+					return nil, err
+				}
+				if trustedFilterChain := findXdsFilterChain(xdsListener, trustedProxiesFilterChainName(routeName)); trustedFilterChain != nil {
+					if err := patchXdsHTTPFilterChain(trustedFilterChain, httpListener, ir.RateLimitService); err != nil {
+						return nil, err
+					}
+				}
 			}
 		}
 
 		if addFilterChain {
-			if err := addXdsHTTPFilterChain(xdsListener, httpListener); err != nil {
+			if err := addXdsHTTPFilterChain(xdsListener, httpListener, ir, false); err != nil {
+				return nil, err
+			}
+		}
+
+		if httpListener.EnableProxyProtocol {
+			if err := addXdsProxyProtocolFilter(xdsListener); err != nil {
+				return nil, err
+			}
+		}
+
+		// The HTTP/3 clients connect to the QUIC listener on the UDP port with
+		// the same number, which shares the route config of the TCP listener.
+		if httpListener.HTTP3 != nil {
+			quicListener := findXdsListener(tCtx, httpListener.Address, httpListener.Port, core.SocketAddress_UDP)
+			if quicListener == nil {
+				var err error
+				quicListener, err = buildXdsQuicListener(httpListener.Name, httpListener.Address, httpListener.Port, ir.AccessLog)
+				if err != nil {
+					return nil, err
+				}
+				tCtx.AddXdsResource(resource.ListenerType, quicListener)
+			}
+			if err := addXdsHTTPFilterChain(quicListener, httpListener, ir, true); err != nil {
 				return nil, err
 			}
 		}
@@ -65,13 +110,15 @@ func Translate(ir *ir.Xds) (*types.ResourceVersionTable, error) {
 			tCtx.AddXdsResource(resource.RouteType, xdsRouteCfg)
 		}
 
-		// 1:1 between IR TLSListenerConfig and xDS Secret
+		// 1:1 between IR TLSCertificate and xDS Secret, plus the secret
+		// of the CA certificates validating the client certificates, if any.
 		if httpListener.TLS != nil {
-			secret, err := buildXdsDownstreamTLSSecret(httpListener.Name, httpListener.TLS)
-			if err != nil {
-				return nil, multierror.Append(err, errors.New("error building xds listener tls secret"))
+			for _, secret := range buildXdsDownstreamTLSSecrets(httpListener.Name, httpListener.TLS) {
+				tCtx.AddXdsResource(resource.SecretType, secret)
+			}
+			if httpListener.TLS.ClientValidation != nil {
+				tCtx.AddXdsResource(resource.SecretType, buildXdsClientCASecret(httpListener.Name, httpListener.TLS.ClientValidation))
 			}
-			tCtx.AddXdsResource(resource.SecretType, secret)
 		}
 
 		// Allocate virtual host for this httpListener.
@@ -80,6 +127,9 @@ func Translate(ir *ir.Xds) (*types.ResourceVersionTable, error) {
 			Name:    httpListener.Name,
 			Domains: httpListener.Hostnames,
 		}
+		if httpListener.HTTP3 != nil {
+			vHost.ResponseHeadersToAdd = append(vHost.ResponseHeadersToAdd, buildXdsAltSvcHeader(httpListener))
+		}
 
 		for _, httpRoute := range httpListener.Routes {
 			// 1:1 between IR HTTPRoute and xDS config.route.v3.Route
@@ -87,13 +137,45 @@ func Translate(ir *ir.Xds) (*types.ResourceVersionTable, error) {
 			if err != nil {
 				return nil, multierror.Append(err, errors.New("error building xds route"))
 			}
+			if err := extAuthFilters.patchRoute(xdsRoute, httpRoute, extAuths); err != nil {
+				return nil, multierror.Append(err, errors.New("error building xds route"))
+			}
+			if err := oidcFilters.patchRoute(xdsRoute, httpRoute, oidcs); err != nil {
+				return nil, multierror.Append(err, errors.New("error building xds route"))
+			}
+			if err := basicAuthFilters.patchRoute(xdsRoute, httpRoute, basicAuths); err != nil {
+				return nil, multierror.Append(err, errors.New("error building xds route"))
+			}
+			if err := patchRouteWithJWT(xdsRoute, httpRoute); err != nil {
+				return nil, multierror.Append(err, errors.New("error building xds route"))
+			}
+			if err := patchRouteWithIPFilter(xdsRoute, httpRoute); err != nil {
+				return nil, multierror.Append(err, errors.New("error building xds route"))
+			}
+			if err := compressionFilters.patchRoute(xdsRoute, httpRoute, compressions); err != nil {
+				return nil, multierror.Append(err, errors.New("error building xds route"))
+			}
+			if err := patchRouteWithFaultInjection(xdsRoute, httpRoute); err != nil {
+				return nil, multierror.Append(err, errors.New("error building xds route"))
+			}
+			patchRouteWithCORS(xdsRoute, httpRoute)
 			vHost.Routes = append(vHost.Routes, xdsRoute)
 
 			// Skip trying to build an IR cluster if the httpRoute only has invalid backends
 			if len(httpRoute.Destinations) == 0 && httpRoute.BackendWeights.Invalid > 0 {
 				continue
 			}
-			xdsCluster, err := buildXdsCluster(httpRoute.Name, httpRoute.Destinations, httpListener.IsHTTP2)
+			xdsCluster, err := buildXdsCluster(&xdsClusterArgs{
+				name:           httpRoute.Name,
+				destinations:   httpRoute.Destinations,
+				isHTTP2:        httpListener.IsHTTP2,
+				timeout:        httpRoute.Timeout,
+				retry:          httpRoute.Retry,
+				healthCheck:    httpRoute.HealthCheck,
+				circuitBreaker: httpRoute.CircuitBreaker,
+				loadBalancer:   httpRoute.LoadBalancer,
+				http1:          httpListener.HTTP1,
+			})
 			if err != nil {
 				return nil, multierror.Append(err, errors.New("error building xds cluster"))
 			}
@@ -104,9 +186,64 @@ func Translate(ir *ir.Xds) (*types.ResourceVersionTable, error) {
 		xdsRouteCfg.VirtualHosts = append(xdsRouteCfg.VirtualHosts, vHost)
 	}
 
+	if ir.RateLimitService != nil {
+		tCtx.AddXdsResource(resource.ClusterType, buildXdsRateLimitCluster(ir.RateLimitService))
+	}
+
+	for _, service := range listAccessLogServices(ir.AccessLog) {
+		tCtx.AddXdsResource(resource.ClusterType, buildXdsAccessLogCluster(service))
+	}
+
+	if ir.Tracing != nil {
+		xdsCluster, err := buildXdsTracingCluster(ir.Tracing)
+		if err != nil {
+			return nil, multierror.Append(err, errors.New("error building xds tracing cluster"))
+		}
+		tCtx.AddXdsResource(resource.ClusterType, xdsCluster)
+	}
+
+	for _, extAuth := range extAuths {
+		xdsCluster, err := buildXdsExtAuthCluster(extAuth)
+		if err != nil {
+			return nil, multierror.Append(err, errors.New("error building xds ext auth cluster"))
+		}
+		tCtx.AddXdsResource(resource.ClusterType, xdsCluster)
+	}
+
+	for _, oidc := range oidcs {
+		xdsCluster, err := buildXdsOIDCCluster(oidc)
+		if err != nil {
+			return nil, multierror.Append(err, errors.New("error building xds oidc cluster"))
+		}
+		tCtx.AddXdsResource(resource.ClusterType, xdsCluster)
+		for _, secret := range buildXdsOIDCSecrets(oidc) {
+			tCtx.AddXdsResource(resource.SecretType, secret)
+		}
+	}
+
+	for _, jwt := range jwts {
+		xdsClusters, err := buildXdsJWTClusters(jwt)
+		if err != nil {
+			return nil, multierror.Append(err, errors.New("error building xds jwks cluster"))
+		}
+		for _, xdsCluster := range xdsClusters {
+			tCtx.AddXdsResource(resource.ClusterType, xdsCluster)
+		}
+	}
+
+	for _, tlsConfig := range listUpstreamTLS(ir) {
+		for _, secret := range buildXdsUpstreamTLSSecrets(tlsConfig) {
+			tCtx.AddXdsResource(resource.SecretType, secret)
+		}
+	}
+
 	for _, tcpListener := range ir.TCP {
 		// 1:1 between IR TCPListener and xDS Cluster
-		xdsCluster, err := buildXdsCluster(tcpListener.Name, tcpListener.Destinations, false /*isHTTP2 */)
+		xdsCluster, err := buildXdsCluster(&xdsClusterArgs{
+			name:         tcpListener.Name,
+			destinations: tcpListener.Destinations,
+			loadBalancer: tcpListener.LoadBalancer,
+		})
 		if err != nil {
 			return nil, multierror.Append(err, errors.New("error building xds cluster"))
 		}
@@ -115,18 +252,31 @@ func Translate(ir *ir.Xds) (*types.ResourceVersionTable, error) {
 		// Search for an existing listener, if it does not exist, create one.
 		xdsListener := findXdsListener(tCtx, tcpListener.Address, tcpListener.Port, core.SocketAddress_TCP)
 		if xdsListener == nil {
-			xdsListener = buildXdsTCPListener(tcpListener.Name, tcpListener.Address, tcpListener.Port)
+			xdsListener, err = buildXdsTCPListener(tcpListener.Name, tcpListener.Address, tcpListener.Port, ir.AccessLog)
+			if err != nil {
+				return nil, err
+			}
 			tCtx.AddXdsResource(resource.ListenerType, xdsListener)
 		}
 
-		if err := addXdsTCPFilterChain(xdsListener, tcpListener, xdsCluster.Name); err != nil {
+		if err := addXdsTCPFilterChain(xdsListener, tcpListener, xdsCluster.Name, ir.AccessLog); err != nil {
 			return nil, err
 		}
+
+		if tcpListener.EnableProxyProtocol {
+			if err := addXdsProxyProtocolFilter(xdsListener); err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	for _, udpListener := range ir.UDP {
 		// 1:1 between IR UDPListener and xDS Cluster
-		xdsCluster, err := buildXdsCluster(udpListener.Name, udpListener.Destinations, false /*isHTTP2 */)
+		xdsCluster, err := buildXdsCluster(&xdsClusterArgs{
+			name:         udpListener.Name,
+			destinations: udpListener.Destinations,
+			loadBalancer: udpListener.LoadBalancer,
+		})
 		if err != nil {
 			return nil, multierror.Append(err, errors.New("error building xds cluster"))
 		}
@@ -134,7 +284,7 @@ func Translate(ir *ir.Xds) (*types.ResourceVersionTable, error) {
 
 		// There won't be multiple UDP listeners on the same port since it's already been checked at the gateway api
 		// translator
-		xdsListener, err := buildXdsUDPListener(xdsCluster.Name, udpListener)
+		xdsListener, err := buildXdsUDPListener(xdsCluster.Name, udpListener, ir.AccessLog)
 		if err != nil {
 			return nil, multierror.Append(err, errors.New("error building xds cluster"))
 		}
@@ -77,6 +77,93 @@ func TestTranslate(t *testing.T) {
 		{
 			name: "http2-route",
 		},
+		{
+			name: "http-route-timeout-retry",
+		},
+		{
+			name: "http-route-health-check",
+		},
+		{
+			name: "http-route-circuit-breaker",
+		},
+		{
+			name: "http-route-load-balancer",
+		},
+		{
+			name: "tcp-udp-route-source-ip-hash",
+		},
+		{
+			name: "http-route-local-ratelimit",
+		},
+		{
+			name: "http-route-global-ratelimit",
+		},
+		{
+			name: "http-route-ext-auth",
+		},
+		{
+			name:           "http-route-oidc",
+			requireSecrets: true,
+		},
+		{
+			name: "http-route-basic-auth",
+		},
+		{
+			name: "http-route-jwt",
+		},
+		{
+			name: "http-route-ip-filter",
+		},
+		{
+			name: "tls-route-passthrough-ip-filter",
+		},
+		{
+			name: "http-route-cors",
+		},
+		{
+			name:           "http-route-backend-tls",
+			requireSecrets: true,
+		},
+		{
+			name:           "tls-client-validation",
+			requireSecrets: true,
+		},
+		{
+			name:           "tls-listener-settings",
+			requireSecrets: true,
+		},
+		{
+			name:           "http3",
+			requireSecrets: true,
+		},
+		{
+			name: "proxy-protocol",
+		},
+		{
+			name:           "client-ip-detection",
+			requireSecrets: true,
+		},
+		{
+			name: "http-connection-settings",
+		},
+		{
+			name: "http-connection-settings-same-port",
+		},
+		{
+			name: "http-route-compression",
+		},
+		{
+			name: "http-route-fault-injection",
+		},
+		{
+			name: "local-reply",
+		},
+		{
+			name: "accesslog",
+		},
+		{
+			name: "tracing",
+		},
 	}
 
 	for _, tc := range testCases {
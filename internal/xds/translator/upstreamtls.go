@@ -0,0 +1,187 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	tls "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	matcherv3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+const (
+	// transportSocketMatchKey is the endpoint metadata namespace matched against
+	// the transport socket matches of a cluster.
+	transportSocketMatchKey = "envoy.transport_socket_match"
+	// transportSocketMatchField is the field of the endpoint metadata holding the
+	// name of the TLS configuration of the destination.
+	transportSocketMatchField = "name"
+)
+
+// upstreamTLSCASecretName returns the name of the secret holding the CA
+// certificates validating the certificates of the destinations.
+func upstreamTLSCASecretName(tlsConfig *ir.TLSUpstreamConfig) string {
+	return tlsConfig.Name + "/ca"
+}
+
+// upstreamTLSClientCertificateSecretName returns the name of the secret holding
+// the client certificate presented to the destinations.
+func upstreamTLSClientCertificateSecretName(tlsConfig *ir.TLSUpstreamConfig) string {
+	return tlsConfig.Name + "/client-certificate"
+}
+
+// listUpstreamTLS returns the TLS configurations of the destinations of the
+// routes of the IR, without duplicates, in the order in which they first appear.
+func listUpstreamTLS(xdsIR *ir.Xds) []*ir.TLSUpstreamConfig {
+	var tlsConfigs []*ir.TLSUpstreamConfig
+	found := map[string]bool{}
+	for _, httpListener := range xdsIR.HTTP {
+		for _, httpRoute := range httpListener.Routes {
+			for _, destination := range httpRoute.Destinations {
+				if destination.TLS != nil && !found[destination.TLS.Name] {
+					found[destination.TLS.Name] = true
+					tlsConfigs = append(tlsConfigs, destination.TLS)
+				}
+			}
+		}
+	}
+	return tlsConfigs
+}
+
+// buildXdsEndpointTransportSocketMatch returns the metadata of the endpoint of
+// the destination selecting the transport socket of its TLS configuration.
+func buildXdsEndpointTransportSocketMatch(tlsConfig *ir.TLSUpstreamConfig) *core.Metadata {
+	return &core.Metadata{
+		FilterMetadata: map[string]*structpb.Struct{
+			transportSocketMatchKey: {
+				Fields: map[string]*structpb.Value{
+					transportSocketMatchField: structpb.NewStringValue(tlsConfig.Name),
+				},
+			},
+		},
+	}
+}
+
+// setXdsClusterTransportSocketMatches adds a transport socket match to the
+// cluster for each TLS configuration of its destinations. The endpoints of the
+// destinations without TLS configuration keep using plaintext connections.
+func setXdsClusterTransportSocketMatches(xdsCluster *cluster.Cluster, destinations []*ir.RouteDestination, isHTTP2 bool) error {
+	found := map[string]bool{}
+	for _, destination := range destinations {
+		if destination.TLS == nil || found[destination.TLS.Name] {
+			continue
+		}
+		found[destination.TLS.Name] = true
+
+		transportSocket, err := buildXdsUpstreamTLSSocket(destination.TLS, isHTTP2)
+		if err != nil {
+			return err
+		}
+		xdsCluster.TransportSocketMatches = append(xdsCluster.TransportSocketMatches, &cluster.Cluster_TransportSocketMatch{
+			Name: destination.TLS.Name,
+			Match: &structpb.Struct{
+				Fields: map[string]*structpb.Value{
+					transportSocketMatchField: structpb.NewStringValue(destination.TLS.Name),
+				},
+			},
+			TransportSocket: transportSocket,
+		})
+	}
+	return nil
+}
+
+// buildXdsUpstreamTLSSocket returns the transport socket originating the TLS
+// connections to the destinations. The certificates are delivered via SDS.
+func buildXdsUpstreamTLSSocket(tlsConfig *ir.TLSUpstreamConfig, isHTTP2 bool) (*core.TransportSocket, error) {
+	tlsCtx := &tls.UpstreamTlsContext{
+		Sni:              tlsConfig.SNI,
+		CommonTlsContext: &tls.CommonTlsContext{},
+	}
+	if isHTTP2 {
+		tlsCtx.CommonTlsContext.AlpnProtocols = []string{"h2"}
+	}
+
+	if tlsConfig.ClientCertificate != nil {
+		tlsCtx.CommonTlsContext.TlsCertificateSdsSecretConfigs = []*tls.SdsSecretConfig{{
+			Name:      upstreamTLSClientCertificateSecretName(tlsConfig),
+			SdsConfig: makeConfigSource(),
+		}}
+	}
+
+	if len(tlsConfig.CACertificate) > 0 {
+		// The CA certificates are delivered via SDS, while the name the
+		// certificates must be valid for is part of the cluster.
+		defaultValidationCtx := &tls.CertificateValidationContext{}
+		if tlsConfig.SNI != "" {
+			defaultValidationCtx.MatchTypedSubjectAltNames = []*tls.SubjectAltNameMatcher{{
+				SanType: tls.SubjectAltNameMatcher_DNS,
+				Matcher: &matcherv3.StringMatcher{
+					MatchPattern: &matcherv3.StringMatcher_Exact{Exact: tlsConfig.SNI},
+				},
+			}}
+		}
+		tlsCtx.CommonTlsContext.ValidationContextType = &tls.CommonTlsContext_CombinedValidationContext{
+			CombinedValidationContext: &tls.CommonTlsContext_CombinedCertificateValidationContext{
+				DefaultValidationContext: defaultValidationCtx,
+				ValidationContextSdsSecretConfig: &tls.SdsSecretConfig{
+					Name:      upstreamTLSCASecretName(tlsConfig),
+					SdsConfig: makeConfigSource(),
+				},
+			},
+		}
+	}
+
+	tlsCtxAny, err := anypb.New(tlsCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.TransportSocket{
+		Name: wellknown.TransportSocketTls,
+		ConfigType: &core.TransportSocket_TypedConfig{
+			TypedConfig: tlsCtxAny,
+		},
+	}, nil
+}
+
+// buildXdsUpstreamTLSSecrets returns the secrets holding the CA certificates
+// and the client certificate of the TLS configuration, when they are set.
+func buildXdsUpstreamTLSSecrets(tlsConfig *ir.TLSUpstreamConfig) []*tls.Secret {
+	var secrets []*tls.Secret
+	if len(tlsConfig.CACertificate) > 0 {
+		secrets = append(secrets, &tls.Secret{
+			Name: upstreamTLSCASecretName(tlsConfig),
+			Type: &tls.Secret_ValidationContext{
+				ValidationContext: &tls.CertificateValidationContext{
+					TrustedCa: &core.DataSource{
+						Specifier: &core.DataSource_InlineBytes{InlineBytes: tlsConfig.CACertificate},
+					},
+				},
+			},
+		})
+	}
+	if tlsConfig.ClientCertificate != nil {
+		secrets = append(secrets, &tls.Secret{
+			Name: upstreamTLSClientCertificateSecretName(tlsConfig),
+			Type: &tls.Secret_TlsCertificate{
+				TlsCertificate: &tls.TlsCertificate{
+					CertificateChain: &core.DataSource{
+						Specifier: &core.DataSource_InlineBytes{InlineBytes: tlsConfig.ClientCertificate.ServerCertificate},
+					},
+					PrivateKey: &core.DataSource{
+						Specifier: &core.DataSource_InlineBytes{InlineBytes: tlsConfig.ClientCertificate.PrivateKey},
+					},
+				},
+			},
+		})
+	}
+	return secrets
+}